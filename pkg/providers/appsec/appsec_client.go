@@ -0,0 +1,141 @@
+package appsec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// APPSEC is the APPSEC interface used by the provider. It extends the edgegrid client
+	// with the activation options which the client does not support yet
+	APPSEC interface {
+		appsec.APPSEC
+		ComplianceActivations
+	}
+
+	// ComplianceActivations contains the activation operations the edgegrid client does not support
+	ComplianceActivations interface {
+		// CreateComplianceActivations activates configuration versions with a compliance record and the acknowledged
+		// invalid host warnings, which the edgegrid client cannot send
+		CreateComplianceActivations(context.Context, CreateComplianceActivationsRequest) (*appsec.CreateActivationsResponse, error)
+	}
+
+	// CreateComplianceActivationsRequest is the request of CreateComplianceActivations
+	CreateComplianceActivationsRequest struct {
+		appsec.CreateActivationsRequest
+		AcknowledgedInvalidHosts []string          `json:"acknowledgedInvalidHosts,omitempty"`
+		ComplianceRecord         *ComplianceRecord `json:"complianceRecord,omitempty"`
+	}
+
+	// ComplianceRecord records the change management compliance of an activation
+	ComplianceRecord struct {
+		NoncomplianceReason      string `json:"noncomplianceReason"`
+		OtherNoncomplianceReason string `json:"otherNoncomplianceReason,omitempty"`
+		TicketID                 string `json:"ticketId,omitempty"`
+		PeerReviewedBy           string `json:"peerReviewedBy,omitempty"`
+		CustomerEmail            string `json:"customerEmail,omitempty"`
+		UnitTested               bool   `json:"unitTested"`
+	}
+
+	appsecClient struct {
+		appsec.APPSEC
+		session session.Session
+	}
+)
+
+const (
+	// NoncomplianceReasonNone is used when the activation complies with change management
+	NoncomplianceReasonNone = "NONE"
+	// NoncomplianceReasonOther is used with a custom reason in OtherNoncomplianceReason
+	NoncomplianceReasonOther = "OTHER"
+	// NoncomplianceReasonNoProductionTraffic is used when the configuration serves no production traffic
+	NoncomplianceReasonNoProductionTraffic = "NO_PRODUCTION_TRAFFIC"
+	// NoncomplianceReasonEmergency is used for emergency changes
+	NoncomplianceReasonEmergency = "EMERGENCY"
+)
+
+var (
+	// ErrCreateComplianceActivations is returned when CreateComplianceActivations fails
+	ErrCreateComplianceActivations = errors.New("creating activation")
+
+	// NoncomplianceReasons lists the accepted noncompliance reasons
+	NoncomplianceReasons = []string{
+		NoncomplianceReasonNone,
+		NoncomplianceReasonOther,
+		NoncomplianceReasonNoProductionTraffic,
+		NoncomplianceReasonEmergency,
+	}
+)
+
+// NewClient returns a new APPSEC instance with the specified session
+func NewClient(sess session.Session) APPSEC {
+	return &appsecClient{
+		APPSEC:  appsec.Client(sess),
+		session: sess,
+	}
+}
+
+// Validate validates CreateComplianceActivationsRequest
+func (r CreateComplianceActivationsRequest) Validate() error {
+	errs := validation.Errors{
+		"Action":            validation.Validate(r.Action, validation.Required),
+		"Network":           validation.Validate(r.Network, validation.In(string(appsec.NetworkStaging), string(appsec.NetworkProduction))),
+		"ActivationConfigs": validation.Validate(r.ActivationConfigs, validation.Required),
+	}
+	if r.ComplianceRecord != nil {
+		errs["ComplianceRecord.NoncomplianceReason"] = validation.Validate(r.ComplianceRecord.NoncomplianceReason,
+			validation.Required,
+			validation.In(NoncomplianceReasonNone, NoncomplianceReasonOther, NoncomplianceReasonNoProductionTraffic, NoncomplianceReasonEmergency))
+		errs["ComplianceRecord.OtherNoncomplianceReason"] = validation.Validate(r.ComplianceRecord.OtherNoncomplianceReason,
+			validation.When(r.ComplianceRecord.NoncomplianceReason == NoncomplianceReasonOther, validation.Required))
+	}
+	return errs.Filter()
+}
+
+func (c *appsecClient) CreateComplianceActivations(ctx context.Context, params CreateComplianceActivationsRequest) (*appsec.CreateActivationsResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateComplianceActivations, appsec.ErrStructValidation, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/appsec/v1/activations", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateComplianceActivations, err)
+	}
+
+	var rval appsec.CreateActivationsResponse
+	resp, err := c.session.Exec(req, &rval, params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateComplianceActivations, err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("%s: %w", ErrCreateComplianceActivations, appsecResponseError(resp))
+	}
+
+	return &rval, nil
+}
+
+// appsecResponseError parses the APPSEC error from the response
+func appsecResponseError(r *http.Response) error {
+	e := appsec.Error{StatusCode: r.StatusCode}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		e.Title = "Failed to unmarshal error body"
+		e.Detail = fmt.Sprintf("%s: %s", err, body)
+	}
+	e.StatusCode = r.StatusCode
+	return &e
+}
@@ -0,0 +1,142 @@
+package appsec
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockAppsecClient(t *testing.T, mockServer *httptest.Server) APPSEC {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+
+	s, err := session.New(
+		session.WithClient(httpClient),
+		session.WithSigner(&edgegrid.Config{Host: serverURL.Host}),
+	)
+	require.NoError(t, err)
+	return NewClient(s)
+}
+
+func TestCreateComplianceActivations(t *testing.T) {
+	activation := appsec.CreateActivationsRequest{
+		Action:             "ACTIVATE",
+		Network:            "PRODUCTION",
+		Note:               "note",
+		NotificationEmails: []string{"user@example.com"},
+		ActivationConfigs: []struct {
+			ConfigID      int `json:"configId"`
+			ConfigVersion int `json:"configVersion"`
+		}{{ConfigID: 43253, ConfigVersion: 7}},
+	}
+	tests := map[string]struct {
+		params           CreateComplianceActivationsRequest
+		responseStatus   int
+		responseBody     string
+		expectedBody     string
+		expectedResponse *appsec.CreateActivationsResponse
+		withError        error
+	}{
+		"200 OK": {
+			params: CreateComplianceActivationsRequest{
+				CreateActivationsRequest: activation,
+				AcknowledgedInvalidHosts: []string{"www.example.com"},
+				ComplianceRecord: &ComplianceRecord{
+					NoncomplianceReason: NoncomplianceReasonNone,
+					TicketID:            "JIRA-1234",
+					UnitTested:          true,
+				},
+			},
+			responseStatus: http.StatusOK,
+			responseBody:   `{"activationId": 547694, "action": "ACTIVATE", "status": "RECEIVED", "network": "PRODUCTION"}`,
+			expectedBody: `{
+    "action": "ACTIVATE",
+    "network": "PRODUCTION",
+    "note": "note",
+    "notificationEmails": ["user@example.com"],
+    "activationConfigs": [{"configId": 43253, "configVersion": 7}],
+    "acknowledgedInvalidHosts": ["www.example.com"],
+    "complianceRecord": {"noncomplianceReason": "NONE", "ticketId": "JIRA-1234", "unitTested": true}
+}`,
+			expectedResponse: &appsec.CreateActivationsResponse{
+				ActivationID: 547694,
+				Action:       "ACTIVATE",
+				Status:       appsec.StatusPending,
+				Network:      appsec.NetworkProduction,
+			},
+		},
+		"400 Bad Request": {
+			params: CreateComplianceActivationsRequest{
+				CreateActivationsRequest: activation,
+				ComplianceRecord:         &ComplianceRecord{NoncomplianceReason: NoncomplianceReasonEmergency},
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "/appsec/problem-types/bad-request", "title": "Bad request", "detail": "Missing ticket ID"}`,
+			withError: &appsec.Error{
+				Type:       "/appsec/problem-types/bad-request",
+				Title:      "Bad request",
+				Detail:     "Missing ticket ID",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		"other reason without description": {
+			params: CreateComplianceActivationsRequest{
+				CreateActivationsRequest: activation,
+				ComplianceRecord:         &ComplianceRecord{NoncomplianceReason: NoncomplianceReasonOther},
+			},
+			withError: appsec.ErrStructValidation,
+		},
+		"missing activation configs": {
+			params: CreateComplianceActivationsRequest{
+				CreateActivationsRequest: appsec.CreateActivationsRequest{Action: "ACTIVATE", Network: "STAGING"},
+			},
+			withError: appsec.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/appsec/v1/activations", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				if test.expectedBody != "" {
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					assert.JSONEq(t, test.expectedBody, string(body))
+				}
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAppsecClient(t, mockServer)
+			result, err := client.CreateComplianceActivations(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
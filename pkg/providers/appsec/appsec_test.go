@@ -91,6 +91,16 @@ func (p *mockappsec) CreateActivations(ctx context.Context, params appsec.Create
 	return args.Get(0).(*appsec.CreateActivationsResponse), args.Error(1)
 }
 
+func (p *mockappsec) CreateComplianceActivations(ctx context.Context, params CreateComplianceActivationsRequest) (*appsec.CreateActivationsResponse, error) {
+	args := p.Called(ctx, params)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*appsec.CreateActivationsResponse), args.Error(1)
+}
+
 func (p *mockappsec) CreateConfigurationVersionClone(ctx context.Context, params appsec.CreateConfigurationVersionCloneRequest) (*appsec.CreateConfigurationVersionCloneResponse, error) {
 	args := p.Called(ctx, params)
 
@@ -5,7 +5,6 @@ import (
 
 	"github.com/apex/log"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/config"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
@@ -18,7 +17,7 @@ type (
 	provider struct {
 		*schema.Provider
 
-		client appsec.APPSEC
+		client APPSEC
 	}
 	// Option is a appsec provider option
 	Option func(p *provider)
@@ -155,18 +154,18 @@ func Provider() *schema.Provider {
 }
 
 // WithClient sets the client interface function, used for mocking and testing
-func WithClient(c appsec.APPSEC) Option {
+func WithClient(c APPSEC) Option {
 	return func(p *provider) {
 		p.client = c
 	}
 }
 
-// Client returns the PAPI interface
-func (p *provider) Client(meta akamai.OperationMeta) appsec.APPSEC {
+// Client returns the APPSEC interface
+func (p *provider) Client(meta akamai.OperationMeta) APPSEC {
 	if p.client != nil {
 		return p.client
 	}
-	return appsec.Client(meta.Session())
+	return NewClient(meta.Session())
 }
 
 func getAPPSECV1Service(d *schema.ResourceData) (interface{}, error) {
@@ -10,7 +10,6 @@ import (
 	"sync"
 	"testing"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -47,7 +46,7 @@ func testAccPreCheck(_ *testing.T) {
 var clientLock sync.Mutex
 
 // useClient swaps out the client on the global instance for the duration of the given func
-func useClient(client appsec.APPSEC, f func()) {
+func useClient(client APPSEC, f func()) {
 	clientLock.Lock()
 	orig := inst.client
 	inst.client = client
@@ -16,6 +16,7 @@ import (
 	// "github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // appsec v1
@@ -58,6 +59,51 @@ func resourceActivations() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"acknowledge_warnings": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Hostnames of the invalid host warnings to acknowledge so the activation can continue",
+			},
+			"compliance_record": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Change management compliance record of the activation",
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"noncompliance_reason": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(NoncomplianceReasons, false)),
+						Description:      "Reason the activation does not comply with change management, or NONE",
+					},
+					"other_noncompliance_reason": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Reason when noncompliance_reason is OTHER",
+					},
+					"ticket_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Ticket tracking the change",
+					},
+					"peer_reviewed_by": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Email of the person who reviewed the change",
+					},
+					"customer_email": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Email of the customer who requested the change",
+					},
+					"unit_tested": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether the change was tested",
+					},
+				}},
+			},
 		},
 	}
 }
@@ -120,7 +166,7 @@ func resourceActivationsCreate(ctx context.Context, d *schema.ResourceData, m in
 		ConfigVersion: version,
 	})
 
-	postresp, err := client.CreateActivations(ctx, createActivationRequest, true)
+	postresp, err := createActivations(ctx, client, d, createActivationRequest)
 	if err != nil {
 		logger.Errorf("calling 'createActivations': %s", err.Error())
 		return diag.FromErr(err)
@@ -233,7 +279,7 @@ func resourceActivationsUpdate(ctx context.Context, d *schema.ResourceData, m in
 		ConfigVersion: version,
 	})
 
-	postresp, err := client.CreateActivations(ctx, createActivationRequest, true)
+	postresp, err := createActivations(ctx, client, d, createActivationRequest)
 	if err != nil {
 		logger.Errorf("calling 'createActivations': %s", err.Error())
 		return diag.FromErr(err)
@@ -364,6 +410,55 @@ func resourceActivationsDelete(ctx context.Context, d *schema.ResourceData, m in
 	return nil
 }
 
+// createActivations creates the activation, with the compliance record and acknowledged warnings when they are set
+func createActivations(ctx context.Context, client APPSEC, d *schema.ResourceData, req appsec.CreateActivationsRequest) (*appsec.CreateActivationsResponse, error) {
+	acknowledgeWarningsSet, err := tools.GetSetValue("acknowledge_warnings", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	var acknowledgeWarnings []string
+	if acknowledgeWarningsSet != nil {
+		acknowledgeWarnings = tools.SetToStringSlice(acknowledgeWarningsSet)
+	}
+
+	record, err := complianceRecord(d)
+	if err != nil {
+		return nil, err
+	}
+
+	if record == nil && len(acknowledgeWarnings) == 0 {
+		return client.CreateActivations(ctx, req, true)
+	}
+	return client.CreateComplianceActivations(ctx, CreateComplianceActivationsRequest{
+		CreateActivationsRequest: req,
+		AcknowledgedInvalidHosts: acknowledgeWarnings,
+		ComplianceRecord:         record,
+	})
+}
+
+// complianceRecord returns the compliance record of the activation, or nil when compliance_record is not set
+func complianceRecord(d *schema.ResourceData) (*ComplianceRecord, error) {
+	records, err := tools.GetListValue("compliance_record", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	if len(records) == 0 || records[0] == nil {
+		return nil, nil
+	}
+	record, ok := records[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: compliance_record, %q", tools.ErrInvalidType, "map[string]interface{}")
+	}
+	return &ComplianceRecord{
+		NoncomplianceReason:      record["noncompliance_reason"].(string),
+		OtherNoncomplianceReason: record["other_noncompliance_reason"].(string),
+		TicketID:                 record["ticket_id"].(string),
+		PeerReviewedBy:           record["peer_reviewed_by"].(string),
+		CustomerEmail:            record["customer_email"].(string),
+		UnitTested:               record["unit_tested"].(bool),
+	}, nil
+}
+
 func lookupActivation(ctx context.Context, client appsec.APPSEC, query appsec.GetActivationsRequest) (*appsec.GetActivationsResponse, error) {
 	activations, err := client.GetActivations(ctx, query)
 	if err != nil {
@@ -1,12 +1,16 @@
 package appsec
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccAkamaiActivations_res_basic(t *testing.T) {
@@ -76,3 +80,59 @@ func TestAccAkamaiActivations_res_basic(t *testing.T) {
 	})
 
 }
+
+func TestCreateActivations(t *testing.T) {
+	req := appsec.CreateActivationsRequest{
+		Action:             "ACTIVATE",
+		Network:            "STAGING",
+		NotificationEmails: []string{"user@example.com"},
+		ActivationConfigs: []struct {
+			ConfigID      int `json:"configId"`
+			ConfigVersion int `json:"configVersion"`
+		}{{ConfigID: 43253, ConfigVersion: 7}},
+	}
+	tests := map[string]struct {
+		attrs map[string]interface{}
+		init  func(*mockappsec)
+	}{
+		"without compliance record": {
+			attrs: map[string]interface{}{},
+			init: func(m *mockappsec) {
+				m.On("CreateActivations", mock.Anything, req).Return(&appsec.CreateActivationsResponse{ActivationID: 1}, nil).Once()
+			},
+		},
+		"with compliance record and acknowledged warnings": {
+			attrs: map[string]interface{}{
+				"acknowledge_warnings": []interface{}{"www.example.com"},
+				"compliance_record": []interface{}{map[string]interface{}{
+					"noncompliance_reason":       "OTHER",
+					"other_noncompliance_reason": "no change window",
+					"ticket_id":                  "JIRA-1234",
+				}},
+			},
+			init: func(m *mockappsec) {
+				m.On("CreateComplianceActivations", mock.Anything, CreateComplianceActivationsRequest{
+					CreateActivationsRequest: req,
+					AcknowledgedInvalidHosts: []string{"www.example.com"},
+					ComplianceRecord: &ComplianceRecord{
+						NoncomplianceReason:      NoncomplianceReasonOther,
+						OtherNoncomplianceReason: "no change window",
+						TicketID:                 "JIRA-1234",
+					},
+				}).Return(&appsec.CreateActivationsResponse{ActivationID: 1}, nil).Once()
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockappsec{}
+			test.init(client)
+			d := schema.TestResourceDataRaw(t, resourceActivations().Schema, test.attrs)
+			resp, err := createActivations(context.Background(), client, d, req)
+			require.NoError(t, err)
+			assert.Equal(t, 1, resp.ActivationID)
+			client.AssertExpectations(t)
+		})
+	}
+}
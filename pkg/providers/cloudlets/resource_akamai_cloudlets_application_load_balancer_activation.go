@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cloudlets"
@@ -27,6 +28,9 @@ func resourceCloudletsApplicationLoadBalancerActivation() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: &ApplicationLoadBalancerActivationResourceTimeout,
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApplicationLoadBalancerActivationImport,
+		},
 	}
 }
 
@@ -228,6 +232,57 @@ func resourceApplicationLoadBalancerActivationRead(ctx context.Context, rd *sche
 	return diag.Errorf("%v: cannot find the given application load balancer activation version '%d' for network '%s'", ErrApplicationLoadBalancerActivation, version, net)
 }
 
+func resourceApplicationLoadBalancerActivationImport(ctx context.Context, rd *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Cloudlets", "resourceApplicationLoadBalancerActivationImport")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+	client := inst.Client(meta)
+
+	logger.Debug("Importing application load balancer activation")
+
+	parts := strings.Split(rd.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("%w: import id should be of format 'origin_id:network', got: '%s'", ErrApplicationLoadBalancerActivation, rd.Id())
+	}
+	originID := parts[0]
+	network, err := getALBActivationNetwork(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s': %s", ErrApplicationLoadBalancerActivation, parts[1], err.Error())
+	}
+
+	activations, err := client.ListLoadBalancerActivations(ctx, cloudlets.ListLoadBalancerActivationsRequest{OriginID: originID})
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot list activations for the given origin ('%s'): %s", ErrApplicationLoadBalancerActivation, originID, err.Error())
+	}
+
+	// the most recent active activation determines the version which is currently deployed on the given network
+	var activeActivation *cloudlets.LoadBalancerActivation
+	for i, act := range activations {
+		if act.Network != network || act.Status != cloudlets.LoadBalancerActivationStatusActive {
+			continue
+		}
+		if activeActivation == nil || act.ActivatedDate > activeActivation.ActivatedDate {
+			activeActivation = &activations[i]
+		}
+	}
+	if activeActivation == nil {
+		return nil, fmt.Errorf("%w: cannot find any active version for the given origin ('%s') and network ('%s')", ErrApplicationLoadBalancerActivation, originID, network)
+	}
+
+	if err := rd.Set("origin_id", originID); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := rd.Set("network", string(network)); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := rd.Set("version", activeActivation.Version); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	rd.SetId(fmt.Sprintf("%s:%s", originID, network))
+
+	return []*schema.ResourceData{rd}, nil
+}
+
 func getApplicationLoadBalancerActivation(ctx context.Context, client cloudlets.Cloudlets, originID string, version int64, network cloudlets.LoadBalancerActivationNetwork) (*cloudlets.LoadBalancerActivation, error) {
 	activations, err := client.ListLoadBalancerActivations(ctx, cloudlets.ListLoadBalancerActivationsRequest{OriginID: originID})
 	filteredActivations := make([]cloudlets.LoadBalancerActivation, 0, len(activations))
@@ -402,6 +402,55 @@ func TestResourceCloudletsApplicationLoadBalancerActivation(t *testing.T) {
 				},
 			},
 		},
+		"create and import activation": {
+			init: func(m *mockcloudlets) {
+				// create, alb active so no need to activate
+				expectListLoadBalancerActivations(m, "org_1", 1, "STAGING", cloudlets.LoadBalancerActivationStatusActive, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("./testdata/TestResourceCloudletsApplicationLoadBalancerActivation/alb_activation_version1.tf"),
+				},
+				{
+					ImportState:       true,
+					ImportStateId:     "org_1:staging",
+					ResourceName:      "akamai_cloudlets_application_load_balancer_activation.test",
+					ImportStateVerify: true,
+				},
+			},
+		},
+		"import activation - invalid id": {
+			init: func(m *mockcloudlets) {
+				expectListLoadBalancerActivations(m, "org_1", 1, "STAGING", cloudlets.LoadBalancerActivationStatusActive, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("./testdata/TestResourceCloudletsApplicationLoadBalancerActivation/alb_activation_version1.tf"),
+				},
+				{
+					ImportState:   true,
+					ImportStateId: "org_1",
+					ResourceName:  "akamai_cloudlets_application_load_balancer_activation.test",
+					ExpectError:   regexp.MustCompile("import id should be of format 'origin_id:network', got: 'org_1'"),
+				},
+			},
+		},
+		"import activation - no active version on network": {
+			init: func(m *mockcloudlets) {
+				expectListLoadBalancerActivations(m, "org_1", 1, "STAGING", cloudlets.LoadBalancerActivationStatusActive, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("./testdata/TestResourceCloudletsApplicationLoadBalancerActivation/alb_activation_version1.tf"),
+				},
+				{
+					ImportState:   true,
+					ImportStateId: "org_1:production",
+					ResourceName:  "akamai_cloudlets_application_load_balancer_activation.test",
+					ExpectError:   regexp.MustCompile(`cannot find any active version for the given origin \('org_1'\) and network \('PRODUCTION'\)`),
+				},
+			},
+		},
 	}
 
 	// redefining times to run the tests faster
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,6 +30,9 @@ func resourceCloudletsPolicyActivation() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: &PolicyActivationResourceTimeout,
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePolicyActivationImport,
+		},
 	}
 }
 
@@ -395,6 +399,65 @@ func resourcePolicyActivationRead(ctx context.Context, rd *schema.ResourceData,
 	return nil
 }
 
+func resourcePolicyActivationImport(ctx context.Context, rd *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Cloudlets", "resourcePolicyActivationImport")
+	ctx = session.ContextWithOptions(ctx, session.WithContextLog(logger))
+	client := inst.Client(meta)
+
+	logger.Debug("Importing policy activation")
+
+	parts := strings.Split(rd.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("%w: import id should be of format 'policy_id:network', got: '%s'", ErrPolicyActivation, rd.Id())
+	}
+	policyID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid policy id '%s': %s", ErrPolicyActivation, parts[0], err.Error())
+	}
+	network, err := getPolicyActivationNetwork(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s': %s", ErrPolicyActivation, parts[1], err.Error())
+	}
+
+	activations, err := client.ListPolicyActivations(ctx, cloudlets.ListPolicyActivationsRequest{
+		PolicyID: policyID,
+		Network:  network,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot list activations for the given policy (%d): %s", ErrPolicyActivation, policyID, err.Error())
+	}
+	activations = sortPolicyActivationsByDate(activations)
+
+	// the most recent active activation determines the version which is currently deployed on the given network
+	var version int64
+	for _, act := range activations {
+		if act.PolicyInfo.Status == cloudlets.PolicyActivationStatusActive {
+			version = act.PolicyInfo.Version
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("%w: cannot find any active version for the given policy (%d) and network ('%s')", ErrPolicyActivation, policyID, network)
+	}
+
+	if err := rd.Set("policy_id", policyID); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := rd.Set("network", string(network)); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := rd.Set("version", version); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := rd.Set("associated_properties", getActiveProperties(activations)); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	rd.SetId(formatPolicyActivationID(policyID, network))
+
+	return []*schema.ResourceData{rd}, nil
+}
+
 func formatPolicyActivationID(policyID int64, network cloudlets.PolicyActivationNetwork) string {
 	return fmt.Sprintf("%d:%s", policyID, network)
 }
@@ -638,6 +638,67 @@ func TestResourceCloudletsPolicyActivation(t *testing.T) {
 				},
 			},
 		},
+		"create and import activation": {
+			init: func(m *mockcloudlets) {
+				staging, properties, policyID, v1, active := cloudlets.PolicyActivationNetworkStaging, []string{"prp_0", "prp_1"}, int64(1234), int64(1), cloudlets.PolicyActivationStatusActive
+				expectFullActivation(m, policyID, v1, properties, staging, 1)
+				// import and read
+				expectListPolicyActivations(m, policyID, v1, staging, properties, active, "", 1, nil)
+				// delete
+				expectDeletePhase(m, policyID, properties, nil, staging, nil, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("./testdata/TestResCloudletsPolicyActivation/policy_activation_version1.tf"),
+				},
+				{
+					ImportState:       true,
+					ImportStateId:     "1234:staging",
+					ResourceName:      "akamai_cloudlets_policy_activation.test",
+					ImportStateVerify: true,
+				},
+			},
+		},
+		"import activation - invalid id": {
+			init: func(m *mockcloudlets) {
+				staging, properties, policyID, v1 := cloudlets.PolicyActivationNetworkStaging, []string{"prp_0", "prp_1"}, int64(1234), int64(1)
+				expectFullActivation(m, policyID, v1, properties, staging, 1)
+				// delete
+				expectDeletePhase(m, policyID, properties, nil, staging, nil, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("./testdata/TestResCloudletsPolicyActivation/policy_activation_version1.tf"),
+				},
+				{
+					ImportState:   true,
+					ImportStateId: "abc:staging",
+					ResourceName:  "akamai_cloudlets_policy_activation.test",
+					ExpectError:   regexp.MustCompile("policy activation: invalid policy id 'abc'"),
+				},
+			},
+		},
+		"import activation - no active version on network": {
+			init: func(m *mockcloudlets) {
+				staging, properties, policyID, v1 := cloudlets.PolicyActivationNetworkStaging, []string{"prp_0", "prp_1"}, int64(1234), int64(1)
+				expectFullActivation(m, policyID, v1, properties, staging, 1)
+				// import
+				expectListPolicyActivations(m, policyID, v1, cloudlets.PolicyActivationNetworkProduction, properties, cloudlets.PolicyActivationStatusInactive, "", 1, nil).Once()
+				// delete
+				expectDeletePhase(m, policyID, properties, nil, staging, nil, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("./testdata/TestResCloudletsPolicyActivation/policy_activation_version1.tf"),
+				},
+				{
+					ImportState:   true,
+					ImportStateId: "1234:production",
+					ResourceName:  "akamai_cloudlets_policy_activation.test",
+					ExpectError:   regexp.MustCompile(`cannot find any active version for the given policy \(1234\) and network \('prod'\)`),
+				},
+			},
+		},
 	}
 
 	// redefining times to accelerate tests
@@ -0,0 +1,418 @@
+package cps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// CPS is the CPS API interface used by the provider. It extends the edgegrid client
+	// with the change management requests which the client does not support yet
+	CPS interface {
+		cps.CPS
+
+		// GetChangeManagementInfo returns the details of a change which was deployed to staging and waits for the change management acknowledgement
+		GetChangeManagementInfo(context.Context, cps.GetChangeRequest) (*ChangeManagementInfo, error)
+
+		// AcknowledgeChangeManagement acknowledges a change which was deployed to staging, so that it is deployed to production
+		AcknowledgeChangeManagement(context.Context, AcknowledgeChangeManagementRequest) (*cps.UpdateChangeResponse, error)
+
+		// GetThirdPartyCSR returns the CSRs generated by CPS for a third-party enrollment change waiting for the signed certificate
+		GetThirdPartyCSR(context.Context, cps.GetChangeRequest) (*ThirdPartyCSR, error)
+
+		// UploadThirdPartyCertificate uploads the signed certificate and its trust chain for a third-party enrollment change
+		UploadThirdPartyCertificate(context.Context, UploadThirdPartyCertificateRequest) (*cps.UpdateChangeResponse, error)
+
+		// ListEnrollments returns the enrollments of the given contract
+		ListEnrollments(context.Context, ListEnrollmentsRequest) (*ListEnrollmentsResponse, error)
+
+		// GetProductionDeployment returns the certificates of the enrollment deployed to the production network
+		GetProductionDeployment(context.Context, GetDeploymentRequest) (*Deployment, error)
+	}
+
+	// ChangeManagementInfo contains the details of a change deployed to staging
+	ChangeManagementInfo struct {
+		AcknowledgementDeadline string                  `json:"acknowledgementDeadline"`
+		ValidationResultHash    string                  `json:"validationResultHash"`
+		PendingState            ChangeManagementPending `json:"pendingState"`
+	}
+
+	// ChangeManagementPending contains the certificates deployed to staging
+	ChangeManagementPending struct {
+		PendingCertificates []StagingCertificate `json:"pendingCertificates"`
+	}
+
+	// StagingCertificate is a certificate deployed to staging
+	StagingCertificate struct {
+		CertificateType    string `json:"certificateType"`
+		FullCertificate    string `json:"fullCertificate"`
+		KeyAlgorithm       string `json:"keyAlgorithm"`
+		SignatureAlgorithm string `json:"signatureAlgorithm"`
+	}
+
+	// AcknowledgeChangeManagementRequest contains params required to acknowledge a change deployed to staging.
+	// Hash is the validation result hash of the change management info the acknowledgement refers to
+	AcknowledgeChangeManagementRequest struct {
+		EnrollmentID int
+		ChangeID     int
+		Hash         string
+	}
+
+	// ThirdPartyCSR contains the CSRs generated by CPS for a third-party enrollment change
+	ThirdPartyCSR struct {
+		CSRs []CertSigningRequest `json:"csrs"`
+	}
+
+	// CertSigningRequest is a CSR in PEM format with the algorithm of its key
+	CertSigningRequest struct {
+		CSR          string `json:"csr"`
+		KeyAlgorithm string `json:"keyAlgorithm"`
+	}
+
+	// UploadThirdPartyCertificateRequest contains params required to upload the certificates signed by a third-party CA
+	UploadThirdPartyCertificateRequest struct {
+		EnrollmentID int
+		ChangeID     int
+		Certificates []ThirdPartyCertificate
+	}
+
+	// ThirdPartyCertificate is a signed certificate and its trust chain, both in PEM format
+	ThirdPartyCertificate struct {
+		Certificate  string `json:"certificate"`
+		TrustChain   string `json:"trustChain,omitempty"`
+		KeyAlgorithm string `json:"keyAlgorithm,omitempty"`
+	}
+
+	// ListEnrollmentsRequest contains params required to list the enrollments of a contract
+	ListEnrollmentsRequest struct {
+		ContractID string
+	}
+
+	// ListEnrollmentsResponse contains the enrollments of a contract
+	ListEnrollmentsResponse struct {
+		Enrollments []cps.Enrollment `json:"enrollments"`
+	}
+
+	// GetDeploymentRequest contains params required to fetch the certificates deployed to a network
+	GetDeploymentRequest struct {
+		EnrollmentID int
+	}
+
+	// Deployment contains the certificates of an enrollment deployed to a network
+	Deployment struct {
+		PrimaryCertificate       DeployedCertificate   `json:"primaryCertificate"`
+		MultiStackedCertificates []DeployedCertificate `json:"multiStackedCertificates"`
+	}
+
+	// DeployedCertificate is a deployed certificate and its trust chain, both in PEM format
+	DeployedCertificate struct {
+		Certificate  string `json:"certificate"`
+		Expiry       string `json:"expiry"`
+		KeyAlgorithm string `json:"keyAlgorithm"`
+		TrustChain   string `json:"trustChain"`
+	}
+
+	certificatesAndTrustChains struct {
+		CertificatesAndTrustChains []ThirdPartyCertificate `json:"certificatesAndTrustChains"`
+	}
+
+	acknowledgementWithHash struct {
+		Acknowledgement string `json:"acknowledgement"`
+		Hash            string `json:"hash"`
+	}
+
+	client struct {
+		cps.CPS
+		session session.Session
+	}
+)
+
+var (
+	// ErrGetChangeManagementInfo is returned when GetChangeManagementInfo fails
+	ErrGetChangeManagementInfo = errors.New("fetching change management info")
+	// ErrAcknowledgeChangeManagement is returned when AcknowledgeChangeManagement fails
+	ErrAcknowledgeChangeManagement = errors.New("acknowledging change management")
+	// ErrGetThirdPartyCSR is returned when GetThirdPartyCSR fails
+	ErrGetThirdPartyCSR = errors.New("fetching third-party CSR")
+	// ErrUploadThirdPartyCertificate is returned when UploadThirdPartyCertificate fails
+	ErrUploadThirdPartyCertificate = errors.New("uploading third-party certificate")
+	// ErrListEnrollments is returned when ListEnrollments fails
+	ErrListEnrollments = errors.New("listing enrollments")
+	// ErrGetProductionDeployment is returned when GetProductionDeployment fails
+	ErrGetProductionDeployment = errors.New("fetching production deployment")
+)
+
+// Client returns a new CPS instance with the specified session
+func Client(sess session.Session) CPS {
+	return &client{
+		CPS:     cps.Client(sess),
+		session: sess,
+	}
+}
+
+// Validate validates AcknowledgeChangeManagementRequest
+func (r AcknowledgeChangeManagementRequest) Validate() error {
+	return validation.Errors{
+		"enrollmentId": validation.Validate(r.EnrollmentID, validation.Required),
+		"changeId":     validation.Validate(r.ChangeID, validation.Required),
+		"hash":         validation.Validate(r.Hash, validation.Required),
+	}.Filter()
+}
+
+// Validate validates UploadThirdPartyCertificateRequest
+func (r UploadThirdPartyCertificateRequest) Validate() error {
+	return validation.Errors{
+		"enrollmentId": validation.Validate(r.EnrollmentID, validation.Required),
+		"changeId":     validation.Validate(r.ChangeID, validation.Required),
+		"certificates": validation.Validate(r.Certificates, validation.Required),
+	}.Filter()
+}
+
+// Validate validates ThirdPartyCertificate
+func (c ThirdPartyCertificate) Validate() error {
+	return validation.Errors{
+		"certificate": validation.Validate(c.Certificate, validation.Required),
+	}.Filter()
+}
+
+// Validate validates ListEnrollmentsRequest
+func (r ListEnrollmentsRequest) Validate() error {
+	return validation.Errors{
+		"contractId": validation.Validate(r.ContractID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates GetDeploymentRequest
+func (r GetDeploymentRequest) Validate() error {
+	return validation.Errors{
+		"enrollmentId": validation.Validate(r.EnrollmentID, validation.Required),
+	}.Filter()
+}
+
+func (c *client) GetChangeManagementInfo(ctx context.Context, params cps.GetChangeRequest) (*ChangeManagementInfo, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetChangeManagementInfo, cps.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf(
+		"/cps/v2/enrollments/%d/changes/%d/input/info/change-management-info",
+		params.EnrollmentID,
+		params.ChangeID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetChangeManagementInfo, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetChangeManagementInfo, err)
+	}
+	req.Header.Set("Accept", "application/vnd.akamai.cps.change-management-info.v5+json")
+
+	var rval ChangeManagementInfo
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetChangeManagementInfo, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetChangeManagementInfo, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) AcknowledgeChangeManagement(ctx context.Context, params AcknowledgeChangeManagementRequest) (*cps.UpdateChangeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrAcknowledgeChangeManagement, cps.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf(
+		"/cps/v2/enrollments/%d/changes/%d/input/update/%s",
+		params.EnrollmentID,
+		params.ChangeID,
+		cps.AllowedInputTypeChangeManagementACK),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrAcknowledgeChangeManagement, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrAcknowledgeChangeManagement, err)
+	}
+	req.Header.Set("Accept", "application/vnd.akamai.cps.change-id.v1+json")
+	req.Header.Set("Content-Type", cps.AllowedInputContentTypeHeader[cps.AllowedInputTypeChangeManagementACK])
+
+	var rval cps.UpdateChangeResponse
+	resp, err := c.session.Exec(req, &rval, acknowledgementWithHash{
+		Acknowledgement: cps.AcknowledgementAcknowledge,
+		Hash:            params.Hash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrAcknowledgeChangeManagement, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrAcknowledgeChangeManagement, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) GetThirdPartyCSR(ctx context.Context, params cps.GetChangeRequest) (*ThirdPartyCSR, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetThirdPartyCSR, cps.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf(
+		"/cps/v2/enrollments/%d/changes/%d/input/info/third-party-csr",
+		params.EnrollmentID,
+		params.ChangeID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetThirdPartyCSR, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetThirdPartyCSR, err)
+	}
+	req.Header.Set("Accept", "application/vnd.akamai.cps.csr.v2+json")
+
+	var rval ThirdPartyCSR
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetThirdPartyCSR, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetThirdPartyCSR, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+// UploadThirdPartyCertificate is implemented here, because UpdateChange of the edgegrid client does not send the request body
+func (c *client) UploadThirdPartyCertificate(ctx context.Context, params UploadThirdPartyCertificateRequest) (*cps.UpdateChangeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrUploadThirdPartyCertificate, cps.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf(
+		"/cps/v2/enrollments/%d/changes/%d/input/update/%s",
+		params.EnrollmentID,
+		params.ChangeID,
+		cps.AllowedInputTypeThirdPartyCertAndTrustChain),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUploadThirdPartyCertificate, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUploadThirdPartyCertificate, err)
+	}
+	req.Header.Set("Accept", "application/vnd.akamai.cps.change-id.v1+json")
+	req.Header.Set("Content-Type", cps.AllowedInputContentTypeHeader[cps.AllowedInputTypeThirdPartyCertAndTrustChain])
+
+	var rval cps.UpdateChangeResponse
+	resp, err := c.session.Exec(req, &rval, certificatesAndTrustChains{
+		CertificatesAndTrustChains: params.Certificates,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUploadThirdPartyCertificate, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUploadThirdPartyCertificate, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) ListEnrollments(ctx context.Context, params ListEnrollmentsRequest) (*ListEnrollmentsResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrListEnrollments, cps.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse("/cps/v2/enrollments")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListEnrollments, err)
+	}
+	q := uri.Query()
+	q.Add("contractId", params.ContractID)
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListEnrollments, err)
+	}
+	req.Header.Set("Accept", "application/vnd.akamai.cps.enrollments.v11+json")
+
+	var rval ListEnrollmentsResponse
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListEnrollments, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListEnrollments, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) GetProductionDeployment(ctx context.Context, params GetDeploymentRequest) (*Deployment, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetProductionDeployment, cps.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf("/cps/v2/enrollments/%d/deployments/production", params.EnrollmentID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetProductionDeployment, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetProductionDeployment, err)
+	}
+	req.Header.Set("Accept", "application/vnd.akamai.cps.deployment.v7+json")
+
+	var rval Deployment
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetProductionDeployment, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetProductionDeployment, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+// responseError parses the CPS API error from the response
+func responseError(r *http.Response) error {
+	e := cps.Error{StatusCode: r.StatusCode}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		e.Title = string(body)
+	}
+	e.StatusCode = r.StatusCode
+	return &e
+}
@@ -0,0 +1,477 @@
+package cps
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockAPIClient(t *testing.T, mockServer *httptest.Server) CPS {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+
+	s, err := session.New(
+		session.WithClient(httpClient),
+		session.WithSigner(&edgegrid.Config{Host: serverURL.Host}),
+	)
+	require.NoError(t, err)
+	return Client(s)
+}
+
+func TestGetChangeManagementInfo(t *testing.T) {
+	tests := map[string]struct {
+		params           cps.GetChangeRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse *ChangeManagementInfo
+		withError        error
+	}{
+		"200 OK": {
+			params:         cps.GetChangeRequest{EnrollmentID: 1, ChangeID: 2},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "acknowledgementDeadline": "2022-03-08T00:00:00Z",
+    "validationResultHash": "7a5b36d1",
+    "pendingState": {
+        "pendingCertificates": [
+            {
+                "certificateType": "san",
+                "fullCertificate": "-----BEGIN CERTIFICATE-----",
+                "keyAlgorithm": "RSA",
+                "signatureAlgorithm": "SHA-256"
+            }
+        ]
+    }
+}`,
+			expectedPath: "/cps/v2/enrollments/1/changes/2/input/info/change-management-info",
+			expectedResponse: &ChangeManagementInfo{
+				AcknowledgementDeadline: "2022-03-08T00:00:00Z",
+				ValidationResultHash:    "7a5b36d1",
+				PendingState: ChangeManagementPending{
+					PendingCertificates: []StagingCertificate{{
+						CertificateType:    "san",
+						FullCertificate:    "-----BEGIN CERTIFICATE-----",
+						KeyAlgorithm:       "RSA",
+						SignatureAlgorithm: "SHA-256",
+					}},
+				},
+			},
+		},
+		"404 Not Found": {
+			params:         cps.GetChangeRequest{EnrollmentID: 1, ChangeID: 2},
+			responseStatus: http.StatusNotFound,
+			responseBody: `{
+    "type": "not-found",
+    "title": "Not Found",
+    "detail": "Change does not exist"
+}`,
+			expectedPath: "/cps/v2/enrollments/1/changes/2/input/info/change-management-info",
+			withError: &cps.Error{
+				Type:       "not-found",
+				Title:      "Not Found",
+				Detail:     "Change does not exist",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		"validation error": {
+			params:    cps.GetChangeRequest{EnrollmentID: 1},
+			withError: cps.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "application/vnd.akamai.cps.change-management-info.v5+json", r.Header.Get("Accept"))
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetChangeManagementInfo(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestAcknowledgeChangeManagement(t *testing.T) {
+	tests := map[string]struct {
+		params           AcknowledgeChangeManagementRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *cps.UpdateChangeResponse
+		withError        error
+	}{
+		"200 OK": {
+			params:           AcknowledgeChangeManagementRequest{EnrollmentID: 1, ChangeID: 2, Hash: "7a5b36d1"},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"change": "/cps/v2/enrollments/1/changes/2"}`,
+			expectedResponse: &cps.UpdateChangeResponse{Change: "/cps/v2/enrollments/1/changes/2"},
+		},
+		"500 Internal Server Error": {
+			params:         AcknowledgeChangeManagementRequest{EnrollmentID: 1, ChangeID: 2, Hash: "7a5b36d1"},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error updating change"
+}`,
+			withError: &cps.Error{
+				Type:       "internal_error",
+				Title:      "Internal Server Error",
+				Detail:     "Error updating change",
+				StatusCode: http.StatusInternalServerError,
+			},
+		},
+		"validation error": {
+			params:    AcknowledgeChangeManagementRequest{EnrollmentID: 1, ChangeID: 2},
+			withError: cps.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/cps/v2/enrollments/1/changes/2/input/update/change-management-ack", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/vnd.akamai.cps.acknowledgement-with-hash.v1+json", r.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"acknowledgement": "acknowledge", "hash": "7a5b36d1"}`, string(body))
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.AcknowledgeChangeManagement(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetThirdPartyCSR(t *testing.T) {
+	tests := map[string]struct {
+		params           cps.GetChangeRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *ThirdPartyCSR
+		withError        error
+	}{
+		"200 OK": {
+			params:         cps.GetChangeRequest{EnrollmentID: 1, ChangeID: 2},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "csrs": [
+        {
+            "csr": "-----BEGIN CERTIFICATE REQUEST-----",
+            "keyAlgorithm": "RSA"
+        }
+    ]
+}`,
+			expectedResponse: &ThirdPartyCSR{
+				CSRs: []CertSigningRequest{{
+					CSR:          "-----BEGIN CERTIFICATE REQUEST-----",
+					KeyAlgorithm: "RSA",
+				}},
+			},
+		},
+		"404 Not Found": {
+			params:         cps.GetChangeRequest{EnrollmentID: 1, ChangeID: 2},
+			responseStatus: http.StatusNotFound,
+			responseBody: `{
+    "type": "not-found",
+    "title": "Not Found",
+    "detail": "Change does not exist"
+}`,
+			withError: &cps.Error{
+				Type:       "not-found",
+				Title:      "Not Found",
+				Detail:     "Change does not exist",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		"validation error": {
+			params:    cps.GetChangeRequest{ChangeID: 2},
+			withError: cps.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/cps/v2/enrollments/1/changes/2/input/info/third-party-csr", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "application/vnd.akamai.cps.csr.v2+json", r.Header.Get("Accept"))
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetThirdPartyCSR(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestUploadThirdPartyCertificate(t *testing.T) {
+	tests := map[string]struct {
+		params           UploadThirdPartyCertificateRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *cps.UpdateChangeResponse
+		withError        error
+	}{
+		"200 OK": {
+			params: UploadThirdPartyCertificateRequest{
+				EnrollmentID: 1,
+				ChangeID:     2,
+				Certificates: []ThirdPartyCertificate{{
+					Certificate:  "-----BEGIN CERTIFICATE-----",
+					TrustChain:   "-----BEGIN CERTIFICATE----- chain",
+					KeyAlgorithm: "RSA",
+				}},
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"change": "/cps/v2/enrollments/1/changes/2"}`,
+			expectedResponse: &cps.UpdateChangeResponse{Change: "/cps/v2/enrollments/1/changes/2"},
+		},
+		"400 Bad Request": {
+			params: UploadThirdPartyCertificateRequest{
+				EnrollmentID: 1,
+				ChangeID:     2,
+				Certificates: []ThirdPartyCertificate{{
+					Certificate:  "-----BEGIN CERTIFICATE-----",
+					TrustChain:   "-----BEGIN CERTIFICATE----- chain",
+					KeyAlgorithm: "RSA",
+				}},
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody: `{
+    "type": "bad-request",
+    "title": "Bad Request",
+    "detail": "Certificate does not match the CSR"
+}`,
+			withError: &cps.Error{
+				Type:       "bad-request",
+				Title:      "Bad Request",
+				Detail:     "Certificate does not match the CSR",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		"validation error": {
+			params:    UploadThirdPartyCertificateRequest{EnrollmentID: 1, ChangeID: 2},
+			withError: cps.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/cps/v2/enrollments/1/changes/2/input/update/third-party-cert-and-trust-chain", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/vnd.akamai.cps.certificate-and-trust-chain.v1+json", r.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"certificatesAndTrustChains": [{"certificate": "-----BEGIN CERTIFICATE-----", "trustChain": "-----BEGIN CERTIFICATE----- chain", "keyAlgorithm": "RSA"}]}`, string(body))
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.UploadThirdPartyCertificate(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestListEnrollments(t *testing.T) {
+	tests := map[string]struct {
+		params           ListEnrollmentsRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *ListEnrollmentsResponse
+		withError        error
+	}{
+		"200 OK": {
+			params:         ListEnrollmentsRequest{ContractID: "1-AB123"},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "enrollments": [
+        {
+            "location": "/cps/v2/enrollments/1",
+            "ra": "lets-encrypt",
+            "validationType": "dv",
+            "certificateType": "san",
+            "pendingChanges": []
+        }
+    ]
+}`,
+			expectedResponse: &ListEnrollmentsResponse{
+				Enrollments: []cps.Enrollment{{
+					Location:        "/cps/v2/enrollments/1",
+					RA:              "lets-encrypt",
+					ValidationType:  "dv",
+					CertificateType: "san",
+					PendingChanges:  []string{},
+				}},
+			},
+		},
+		"403 Forbidden": {
+			params:         ListEnrollmentsRequest{ContractID: "1-AB123"},
+			responseStatus: http.StatusForbidden,
+			responseBody: `{
+    "type": "forbidden",
+    "title": "Forbidden",
+    "detail": "No access to the contract"
+}`,
+			withError: &cps.Error{
+				Type:       "forbidden",
+				Title:      "Forbidden",
+				Detail:     "No access to the contract",
+				StatusCode: http.StatusForbidden,
+			},
+		},
+		"validation error": {
+			params:    ListEnrollmentsRequest{},
+			withError: cps.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/cps/v2/enrollments?contractId=1-AB123", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "application/vnd.akamai.cps.enrollments.v11+json", r.Header.Get("Accept"))
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.ListEnrollments(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetProductionDeployment(t *testing.T) {
+	tests := map[string]struct {
+		params           GetDeploymentRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *Deployment
+		withError        error
+	}{
+		"200 OK": {
+			params:         GetDeploymentRequest{EnrollmentID: 1},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "primaryCertificate": {
+        "certificate": "-----BEGIN CERTIFICATE-----",
+        "expiry": "2022-06-01T00:00:00Z",
+        "keyAlgorithm": "RSA",
+        "trustChain": "-----BEGIN CERTIFICATE----- chain"
+    },
+    "multiStackedCertificates": []
+}`,
+			expectedResponse: &Deployment{
+				PrimaryCertificate: DeployedCertificate{
+					Certificate:  "-----BEGIN CERTIFICATE-----",
+					Expiry:       "2022-06-01T00:00:00Z",
+					KeyAlgorithm: "RSA",
+					TrustChain:   "-----BEGIN CERTIFICATE----- chain",
+				},
+				MultiStackedCertificates: []DeployedCertificate{},
+			},
+		},
+		"404 Not Found": {
+			params:         GetDeploymentRequest{EnrollmentID: 1},
+			responseStatus: http.StatusNotFound,
+			responseBody: `{
+    "type": "not-found",
+    "title": "Not Found",
+    "detail": "No deployment found"
+}`,
+			withError: &cps.Error{
+				Type:       "not-found",
+				Title:      "Not Found",
+				Detail:     "No deployment found",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		"validation error": {
+			params:    GetDeploymentRequest{},
+			withError: cps.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/cps/v2/enrollments/1/deployments/production", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "application/vnd.akamai.cps.deployment.v7+json", r.Header.Get("Accept"))
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetProductionDeployment(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
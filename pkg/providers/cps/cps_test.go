@@ -3,6 +3,7 @@ package cps
 import (
 	"context"
 
+	dns "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configdns"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
 	"github.com/stretchr/testify/mock"
 )
@@ -120,3 +121,81 @@ func (m *mockcps) AcknowledgePreVerificationWarnings(ctx context.Context, r cps.
 
 	return args.Error(0)
 }
+
+func (m *mockcps) GetChangeManagementInfo(ctx context.Context, r cps.GetChangeRequest) (*ChangeManagementInfo, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ChangeManagementInfo), args.Error(1)
+}
+
+func (m *mockcps) AcknowledgeChangeManagement(ctx context.Context, r AcknowledgeChangeManagementRequest) (*cps.UpdateChangeResponse, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*cps.UpdateChangeResponse), args.Error(1)
+}
+
+func (m *mockcps) GetThirdPartyCSR(ctx context.Context, r cps.GetChangeRequest) (*ThirdPartyCSR, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ThirdPartyCSR), args.Error(1)
+}
+
+func (m *mockcps) UploadThirdPartyCertificate(ctx context.Context, r UploadThirdPartyCertificateRequest) (*cps.UpdateChangeResponse, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*cps.UpdateChangeResponse), args.Error(1)
+}
+
+func (m *mockcps) ListEnrollments(ctx context.Context, r ListEnrollmentsRequest) (*ListEnrollmentsResponse, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ListEnrollmentsResponse), args.Error(1)
+}
+
+func (m *mockcps) GetProductionDeployment(ctx context.Context, r GetDeploymentRequest) (*Deployment, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*Deployment), args.Error(1)
+}
+
+// mockdns implements the Edge DNS record operations used by the CPS provider
+type mockdns struct {
+	mock.Mock
+	dns.DNS
+}
+
+func (m *mockdns) CreateRecord(ctx context.Context, record *dns.RecordBody, zone string, recLock ...bool) error {
+	args := m.Called(ctx, record, zone)
+
+	return args.Error(0)
+}
+
+func (m *mockdns) DeleteRecord(ctx context.Context, record *dns.RecordBody, zone string, recLock ...bool) error {
+	args := m.Called(ctx, record, zone)
+
+	return args.Error(0)
+}
@@ -0,0 +1,127 @@
+package cps
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCPSEnrollment() *schema.Resource {
+	dataSchema := enrollmentDataSchema()
+	dataSchema["enrollment_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "The unique identifier of the enrollment",
+	}
+	dataSchema["pending_change_status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The status of the current pending change, empty if there are no pending changes",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceCPSEnrollmentRead,
+		Schema:      dataSchema,
+	}
+}
+
+// enrollmentDataSchema returns the computed schema of an enrollment read by the enrollment data sources
+func enrollmentDataSchema() map[string]*schema.Schema {
+	dataSchema := tools.ComputedSchema(enrollmentSchema(nil))
+	// attributes which are only used as input when managing the enrollment
+	delete(dataSchema, "contract_id")
+	delete(dataSchema, "acknowledge_pre_verification_warnings")
+	delete(dataSchema, "acknowledge_change_management")
+	delete(dataSchema, "deployment_schedule")
+
+	dataSchema["change_management"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether deployment of the certificate to production is paused until it is tested on staging",
+	}
+	dataSchema["auto_renewal_start_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time when CPS starts the automatic renewal of the certificate",
+	}
+	dataSchema["max_allowed_san_names"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The maximum number of SAN names allowed for the certificate",
+	}
+	dataSchema["max_allowed_wildcard_san_names"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The maximum number of wildcard SAN names allowed for the certificate",
+	}
+	dataSchema["pending_changes"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Locations of the changes pending on the enrollment",
+	}
+	dataSchema["deployed_certificate"] = deployedCertificateSchema()
+	return dataSchema
+}
+
+func dataSourceCPSEnrollmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "dataSourceCPSEnrollmentRead")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Reading enrollment")
+
+	enrollmentID, err := tools.GetIntValue("enrollment_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	enrollment, err := client.GetEnrollment(ctx, cps.GetEnrollmentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		return diag.Errorf("could not fetch enrollment %d: %s", enrollmentID, err)
+	}
+
+	attrs, err := enrollmentDataAttrs(ctx, client, enrollmentID, enrollment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	changeStatus, err := getPendingChangeStatus(ctx, client, enrollmentID, enrollment.PendingChanges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	attrs["pending_change_status"] = changeStatus
+
+	if err = tools.SetAttrs(d, attrs); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	d.SetId(strconv.Itoa(enrollmentID))
+	return nil
+}
+
+// enrollmentDataAttrs converts the enrollment object to a map of attributes of the enrollment data sources,
+// including the certificates deployed to production
+func enrollmentDataAttrs(ctx context.Context, client CPS, enrollmentID int, enrollment *cps.Enrollment) (map[string]interface{}, error) {
+	attrs := enrollmentToAttrs(enrollment)
+	attrs["auto_renewal_start_time"] = enrollment.AutoRenewalStartTime
+	attrs["max_allowed_san_names"] = enrollment.MaxAllowedSanNames
+	attrs["max_allowed_wildcard_san_names"] = enrollment.MaxAllowedWildcardSanNames
+	attrs["pending_changes"] = enrollment.PendingChanges
+
+	deployedCertificates, err := getDeployedCertificates(ctx, client, enrollmentID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch deployed certificates of enrollment %d: %w", enrollmentID, err)
+	}
+	attrs["deployed_certificate"] = deployedCertificates
+	return attrs, nil
+}
@@ -0,0 +1,143 @@
+package cps
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataEnrollment(t *testing.T) {
+	certificate := testCertificate(t, time.Now().AddDate(0, 0, 30).Add(time.Hour))
+	tests := map[string]struct {
+		init       func(*mockcps)
+		checkFuncs []resource.TestCheckFunc
+		withError  *regexp.Regexp
+	}{
+		"enrollment with pending change": {
+			init: func(client *mockcps) {
+				enrollment := newTestEnrollment("san", "dv", "lets-encrypt")
+				enrollment.ChangeManagement = true
+				enrollment.MaxAllowedSanNames = 100
+				enrollment.PendingChanges = []string{"/cps/v2/enrollments/1/changes/2"}
+				client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+					Return(&enrollment, nil)
+				client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{
+					EnrollmentID: 1,
+					ChangeID:     2,
+				}).Return(&cps.Change{
+					StatusInfo: &cps.StatusInfo{
+						State:  "awaiting-input",
+						Status: "coordinate-domain-validation",
+					},
+				}, nil)
+				client.On("GetProductionDeployment", mock.Anything, GetDeploymentRequest{EnrollmentID: 1}).
+					Return(&Deployment{
+						PrimaryCertificate: DeployedCertificate{
+							Certificate:  certificate,
+							KeyAlgorithm: "ECDSA",
+						},
+					}, nil)
+			},
+			checkFuncs: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "id", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "common_name", "test.akamai.com"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "sans.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "validation_type", "dv"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "secure_network", "enhanced-tls"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "network_configuration.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "admin_contact.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "change_management", "true"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "max_allowed_san_names", "100"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "pending_changes.0", "/cps/v2/enrollments/1/changes/2"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "pending_change_status", "coordinate-domain-validation"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "deployed_certificate.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "deployed_certificate.0.key_algorithm", "ECDSA"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "deployed_certificate.0.issuer", "CN=test.akamai.com"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "deployed_certificate.0.serial_number", "1a2b"),
+				resource.TestCheckResourceAttrSet("data.akamai_cps_enrollment.test", "deployed_certificate.0.not_after"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "deployed_certificate.0.days_to_expiry", "30"),
+			},
+		},
+		"enrollment without pending changes": {
+			init: func(client *mockcps) {
+				enrollment := newTestEnrollment("third-party", "third-party", "third-party")
+				client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+					Return(&enrollment, nil)
+				client.On("GetProductionDeployment", mock.Anything, GetDeploymentRequest{EnrollmentID: 1}).
+					Return(nil, &cps.Error{StatusCode: http.StatusNotFound})
+			},
+			checkFuncs: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "certificate_type", "third-party"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "pending_changes.#", "0"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "pending_change_status", ""),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollment.test", "deployed_certificate.#", "0"),
+			},
+		},
+		"fetching deployment fails": {
+			init: func(client *mockcps) {
+				enrollment := newTestEnrollment("third-party", "third-party", "third-party")
+				client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+					Return(&enrollment, nil)
+				client.On("GetProductionDeployment", mock.Anything, GetDeploymentRequest{EnrollmentID: 1}).
+					Return(nil, errors.New("oops"))
+			},
+			withError: regexp.MustCompile("could not fetch deployed certificates of enrollment 1: oops"),
+		},
+		"fetching enrollment fails": {
+			init: func(client *mockcps) {
+				client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+					Return(nil, errors.New("oops"))
+			},
+			withError: regexp.MustCompile("could not fetch enrollment 1: oops"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockcps{}
+			test.init(client)
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:      loadFixtureString("testdata/TestDataEnrollment/enrollment.tf"),
+							Check:       resource.ComposeAggregateTestCheckFunc(test.checkFuncs...),
+							ExpectError: test.withError,
+						},
+					},
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+// testCertificate returns a self-signed certificate in PEM format, which expires at notAfter
+func testCertificate(t *testing.T, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(0x1a2b),
+		Subject:      pkix.Name{CommonName: "test.akamai.com"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -0,0 +1,83 @@
+package cps
+
+import (
+	"context"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCPSEnrollments() *schema.Resource {
+	enrollmentSchema := enrollmentDataSchema()
+	enrollmentSchema["enrollment_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The unique identifier of the enrollment",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceCPSEnrollmentsRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: tools.FieldPrefixSuppress("ctr_"),
+				Description:      "The contract the enrollments belong to",
+			},
+			"enrollments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The enrollments of the contract",
+				Elem:        &schema.Resource{Schema: enrollmentSchema},
+			},
+		},
+	}
+}
+
+func dataSourceCPSEnrollmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "dataSourceCPSEnrollmentsRead")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Listing enrollments")
+
+	contractID, err := tools.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contractID = strings.TrimPrefix(contractID, "ctr_")
+	res, err := client.ListEnrollments(ctx, ListEnrollmentsRequest{ContractID: contractID})
+	if err != nil {
+		return diag.Errorf("could not list enrollments of contract %s: %s", contractID, err)
+	}
+
+	enrollments := make([]interface{}, 0, len(res.Enrollments))
+	for i := range res.Enrollments {
+		enrollment := res.Enrollments[i]
+		enrollmentID, err := cps.GetIDFromLocation(enrollment.Location)
+		if err != nil {
+			return diag.Errorf("invalid location of enrollment: %s", err)
+		}
+		attrs, err := enrollmentDataAttrs(ctx, client, enrollmentID, &enrollment)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		attrs["enrollment_id"] = enrollmentID
+		enrollments = append(enrollments, attrs)
+	}
+
+	if err = d.Set("enrollments", enrollments); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	d.SetId(contractID)
+	return nil
+}
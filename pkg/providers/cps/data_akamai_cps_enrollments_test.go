@@ -0,0 +1,88 @@
+package cps
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataEnrollments(t *testing.T) {
+	certificate := testCertificate(t, time.Now().AddDate(0, 0, 10).Add(time.Hour))
+	tests := map[string]struct {
+		init       func(*mockcps)
+		checkFuncs []resource.TestCheckFunc
+		withError  *regexp.Regexp
+	}{
+		"enrollments of contract": {
+			init: func(client *mockcps) {
+				deployed := newTestEnrollment("san", "dv", "lets-encrypt")
+				deployed.Location = "/cps/v2/enrollments/1"
+				// enrollments without the details, for example the ones which are still being created
+				pending := cps.Enrollment{
+					Location:        "/cps/v2/enrollments/2",
+					CertificateType: "third-party",
+					PendingChanges:  []string{"/cps/v2/enrollments/2/changes/3"},
+				}
+				client.On("ListEnrollments", mock.Anything, ListEnrollmentsRequest{ContractID: "1"}).
+					Return(&ListEnrollmentsResponse{Enrollments: []cps.Enrollment{deployed, pending}}, nil)
+				client.On("GetProductionDeployment", mock.Anything, GetDeploymentRequest{EnrollmentID: 1}).
+					Return(&Deployment{
+						PrimaryCertificate: DeployedCertificate{
+							Certificate:  certificate,
+							KeyAlgorithm: "ECDSA",
+						},
+					}, nil)
+				client.On("GetProductionDeployment", mock.Anything, GetDeploymentRequest{EnrollmentID: 2}).
+					Return(nil, &cps.Error{StatusCode: http.StatusNotFound})
+			},
+			checkFuncs: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "id", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.#", "2"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.0.enrollment_id", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.0.common_name", "test.akamai.com"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.0.sans.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.0.network_configuration.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.0.deployed_certificate.0.serial_number", "1a2b"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.0.deployed_certificate.0.days_to_expiry", "10"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.1.enrollment_id", "2"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.1.certificate_type", "third-party"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.1.admin_contact.#", "0"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.1.pending_changes.0", "/cps/v2/enrollments/2/changes/3"),
+				resource.TestCheckResourceAttr("data.akamai_cps_enrollments.test", "enrollments.1.deployed_certificate.#", "0"),
+			},
+		},
+		"listing enrollments fails": {
+			init: func(client *mockcps) {
+				client.On("ListEnrollments", mock.Anything, ListEnrollmentsRequest{ContractID: "1"}).
+					Return(nil, errors.New("oops"))
+			},
+			withError: regexp.MustCompile("could not list enrollments of contract 1: oops"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockcps{}
+			test.init(client)
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:      loadFixtureString("testdata/TestDataEnrollments/enrollments.tf"),
+							Check:       resource.ComposeAggregateTestCheckFunc(test.checkFuncs...),
+							ExpectError: test.withError,
+						},
+					},
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
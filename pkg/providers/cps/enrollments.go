@@ -0,0 +1,797 @@
+package cps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	cpstools "github.com/akamai/terraform-provider-akamai/v2/pkg/providers/cps/tools"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	statusCompleted                       = "complete"
+	statusPreVerificationSafetyChecks     = "pre-verification-safety-checks"
+	statusWaitUploadThirdParty            = "wait-upload-third-party"
+	statusWaitAckChangeManagement         = "wait-ack-change-management"
+	allowedInputTypeLetsEncryptChallenges = "lets-encrypt-challenges"
+)
+
+// enrollmentSchema returns schema attributes shared by all enrollment resources, merged with the ones specific for given resource.
+// Attributes from resourceSchema take precedence over the common ones.
+func enrollmentSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	enrollmentSchema := map[string]*schema.Schema{
+		"common_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"sans": {
+			Type:     schema.TypeSet,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+		},
+		"secure_network": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"sni_only": {
+			Type:     schema.TypeBool,
+			Required: true,
+			ForceNew: true,
+		},
+		"acknowledge_pre_verification_warnings": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"admin_contact": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem:     contact,
+		},
+		"certificate_chain_type": {
+			Type:     schema.TypeString,
+			Default:  "default",
+			Optional: true,
+		},
+		"csr": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"country_code": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"city": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"organization": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"organizational_unit": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"state": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"enable_multi_stacked_certificates": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"network_configuration": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"client_mutual_authentication": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						MinItems: 1,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"send_ca_list_to_client": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+								"ocsp_enabled": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+								"set_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"disallowed_tls_versions": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"clone_dns_names": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"geography": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"must_have_ciphers": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"ocsp_stapling": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"preferred_ciphers": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"quic_enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"signature_algorithm": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"tech_contact": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem:     contact,
+		},
+		"organization": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"phone": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"address_line_one": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"address_line_two": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"city": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"region": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"postal_code": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"country_code": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"acknowledge_change_management": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether to acknowledge the change waiting for the change management acknowledgement when this is set to true, so that it is deployed to production",
+		},
+		"deployment_schedule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"not_before": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+						Description:      "The time after which the change is deployed, in RFC 3339 format",
+					},
+					"not_after": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+						Description:      "The time before which the change is deployed, in RFC 3339 format",
+					},
+				},
+			},
+		},
+		"contract_id": {
+			Type:             schema.TypeString,
+			ForceNew:         true,
+			Required:         true,
+			DiffSuppressFunc: tools.FieldPrefixSuppress("ctr_"),
+		},
+		"certificate_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"validation_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"registration_authority": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for name, attr := range resourceSchema {
+		enrollmentSchema[name] = attr
+	}
+	return enrollmentSchema
+}
+
+var contact = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"first_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"last_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"title": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"organization": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"email": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"phone": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"address_line_one": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"address_line_two": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"city": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"postal_code": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"country_code": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+// getEnrollment builds the enrollment object from the attributes common for all enrollment types
+func getEnrollment(d *schema.ResourceData) (*cps.Enrollment, error) {
+	var enrollment cps.Enrollment
+
+	adminContactSet, err := tools.GetSetValue("admin_contact", d)
+	if err != nil {
+		return nil, err
+	}
+	adminContact, err := cpstools.GetContactInfo(adminContactSet)
+	if err != nil {
+		return nil, fmt.Errorf("'admin_contact' - %s", err)
+	}
+	enrollment.AdminContact = adminContact
+	techContactSet, err := tools.GetSetValue("tech_contact", d)
+	if err != nil {
+		return nil, err
+	}
+	techContact, err := cpstools.GetContactInfo(techContactSet)
+	if err != nil {
+		return nil, fmt.Errorf("'tech_contact' - %s", err)
+	}
+	enrollment.TechContact = techContact
+
+	certificateChainType, err := tools.GetStringValue("certificate_chain_type", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	enrollment.CertificateChainType = certificateChainType
+
+	csr, err := cpstools.GetCSR(d)
+	if err != nil {
+		return nil, err
+	}
+	enrollment.CSR = csr
+
+	enableMultiStacked, err := tools.GetBoolValue("enable_multi_stacked_certificates", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	enrollment.EnableMultiStackedCertificates = enableMultiStacked
+
+	networkConfig, err := cpstools.GetNetworkConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	enrollment.NetworkConfiguration = networkConfig
+	signatureAlgorithm, err := tools.GetStringValue("signature_algorithm", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	enrollment.SignatureAlgorithm = signatureAlgorithm
+
+	organization, err := cpstools.GetOrg(d)
+	if err != nil {
+		return nil, err
+	}
+	enrollment.Org = organization
+
+	changeManagement, err := tools.GetBoolValue("change_management", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	enrollment.ChangeManagement = changeManagement
+
+	return &enrollment, nil
+}
+
+// getDeploymentSchedule returns the deployment window of the change, an empty schedule is returned if it is not configured
+func getDeploymentSchedule(d *schema.ResourceData) (*cps.DeploymentSchedule, error) {
+	var schedule cps.DeploymentSchedule
+	scheduleList, err := tools.GetListValue("deployment_schedule", d)
+	if err != nil {
+		if errors.Is(err, tools.ErrNotFound) {
+			return &schedule, nil
+		}
+		return nil, err
+	}
+	if len(scheduleList) == 0 || scheduleList[0] == nil {
+		return &schedule, nil
+	}
+	scheduleMap, ok := scheduleList[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("'deployment_schedule' is of invalid type")
+	}
+	schedule.NotBefore = scheduleMap["not_before"].(string)
+	schedule.NotAfter = scheduleMap["not_after"].(string)
+	return &schedule, nil
+}
+
+// enrollmentToAttrs converts the enrollment object to a map of attributes common for all enrollment types
+func enrollmentToAttrs(enrollment *cps.Enrollment) map[string]interface{} {
+	attrs := make(map[string]interface{})
+	attrs["admin_contact"] = []interface{}{}
+	if enrollment.AdminContact != nil {
+		attrs["admin_contact"] = []interface{}{cpstools.ContactInfoToMap(*enrollment.AdminContact)}
+	}
+	attrs["tech_contact"] = []interface{}{}
+	if enrollment.TechContact != nil {
+		attrs["tech_contact"] = []interface{}{cpstools.ContactInfoToMap(*enrollment.TechContact)}
+	}
+	sans := make([]string, 0)
+	attrs["csr"] = []interface{}{}
+	if enrollment.CSR != nil {
+		attrs["common_name"] = enrollment.CSR.CN
+		for _, san := range enrollment.CSR.SANS {
+			if san == enrollment.CSR.CN {
+				continue
+			}
+			sans = append(sans, san)
+		}
+		attrs["csr"] = []interface{}{cpstools.CSRToMap(*enrollment.CSR)}
+	}
+	attrs["sans"] = sans
+	attrs["network_configuration"] = []interface{}{}
+	if enrollment.NetworkConfiguration != nil {
+		attrs["sni_only"] = enrollment.NetworkConfiguration.SNIOnly
+		attrs["secure_network"] = enrollment.NetworkConfiguration.SecureNetwork
+		attrs["network_configuration"] = []interface{}{cpstools.NetworkConfigToMap(*enrollment.NetworkConfiguration)}
+	}
+	attrs["organization"] = []interface{}{}
+	if enrollment.Org != nil {
+		attrs["organization"] = []interface{}{cpstools.OrgToMap(*enrollment.Org)}
+	}
+	attrs["certificate_chain_type"] = enrollment.CertificateChainType
+	attrs["enable_multi_stacked_certificates"] = enrollment.EnableMultiStackedCertificates
+	attrs["signature_algorithm"] = enrollment.SignatureAlgorithm
+	attrs["certificate_type"] = enrollment.CertificateType
+	attrs["validation_type"] = enrollment.ValidationType
+	attrs["registration_authority"] = enrollment.RA
+	attrs["change_management"] = enrollment.ChangeManagement
+
+	return attrs
+}
+
+func resourceCPSEnrollmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceEnrollment")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Deleting enrollment")
+	enrollmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allowCancelPendingChanges := true
+	req := cps.RemoveEnrollmentRequest{
+		EnrollmentID:              enrollmentID,
+		AllowCancelPendingChanges: &allowCancelPendingChanges,
+	}
+	if _, err = client.RemoveEnrollment(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceCPSEnrollmentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceEnrollment")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	logger.Debug("Importing enrollment")
+	parts := strings.Split(d.Id(), ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("import id has to be a comma separated list of enrollment id and contract id")
+	}
+	enrollmentID := parts[0]
+	contractID := parts[1]
+	if enrollmentID == "" || contractID == "" {
+		return nil, fmt.Errorf("enrollment and contract IDs must have non empty values")
+	}
+	if _, err := strconv.Atoi(enrollmentID); err != nil {
+		return nil, fmt.Errorf("enrollment ID must be a number: %s", err)
+	}
+	if err := d.Set("contract_id", contractID); err != nil {
+		return nil, fmt.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	d.SetId(enrollmentID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getPendingChangeStatus returns the status of the first pending change of the enrollment or an empty string if there are no pending changes
+func getPendingChangeStatus(ctx context.Context, client cps.CPS, enrollmentID int, pendingChanges []string) (string, error) {
+	changeID, err := cpstools.GetChangeIDFromPendingChanges(pendingChanges)
+	if err != nil {
+		if errors.Is(err, cpstools.ErrNoPendingChanges) {
+			return "", nil
+		}
+		return "", err
+	}
+	status, err := client.GetChangeStatus(ctx, cps.GetChangeStatusRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return "", err
+	}
+	if status.StatusInfo == nil {
+		return "", nil
+	}
+	return status.StatusInfo.Status, nil
+}
+
+// acknowledgeChangeManagement acknowledges the pending change which was deployed to staging, so that CPS continues with the deployment to production.
+// The change is acknowledged only in the apply which sets 'acknowledge_change_management' to true, so changes made later are not
+// acknowledged without testing them on staging first.
+func acknowledgeChangeManagement(ctx context.Context, d *schema.ResourceData, logger log.Interface, client CPS, enrollmentID int) error {
+	acknowledge, err := tools.GetBoolValue("acknowledge_change_management", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return err
+	}
+	if !acknowledge || !d.HasChange("acknowledge_change_management") {
+		return nil
+	}
+	enrollment, err := client.GetEnrollment(ctx, cps.GetEnrollmentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		return err
+	}
+	changeID, err := cpstools.GetChangeIDFromPendingChanges(enrollment.PendingChanges)
+	if err != nil {
+		if errors.Is(err, cpstools.ErrNoPendingChanges) {
+			return nil
+		}
+		return err
+	}
+	status, err := client.GetChangeStatus(ctx, cps.GetChangeStatusRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return err
+	}
+	if status.StatusInfo == nil || status.StatusInfo.Status != statusWaitAckChangeManagement {
+		logger.Debug("Change is not waiting for change management acknowledgement")
+		return nil
+	}
+	info, err := client.GetChangeManagementInfo(ctx, cps.GetChangeRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Debug("Acknowledging change deployed to staging")
+	_, err = client.AcknowledgeChangeManagement(ctx, AcknowledgeChangeManagementRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+		Hash:         info.ValidationResultHash,
+	})
+	return err
+}
+
+// getStagingCertificates returns the certificates deployed to staging if the change waits for the change management acknowledgement
+func getStagingCertificates(ctx context.Context, client CPS, enrollmentID, changeID int, status *cps.Change) ([]interface{}, error) {
+	certificates := make([]interface{}, 0)
+	if status.StatusInfo == nil || status.StatusInfo.Status != statusWaitAckChangeManagement {
+		return certificates, nil
+	}
+	info, err := client.GetChangeManagementInfo(ctx, cps.GetChangeRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, certificate := range info.PendingState.PendingCertificates {
+		certificates = append(certificates, map[string]interface{}{
+			"certificate_type":    certificate.CertificateType,
+			"certificate":         certificate.FullCertificate,
+			"key_algorithm":       certificate.KeyAlgorithm,
+			"signature_algorithm": certificate.SignatureAlgorithm,
+		})
+	}
+	return certificates, nil
+}
+
+// getPendingChangeAttrs returns the status of the first pending change of the enrollment and the certificates deployed to staging
+// if the change waits for the change management acknowledgement. The status of the change is returned as well, it is nil if there are no pending changes
+func getPendingChangeAttrs(ctx context.Context, client CPS, enrollmentID int, pendingChanges []string) (map[string]interface{}, *cps.Change, error) {
+	attrs := map[string]interface{}{
+		"pending_change_status": "",
+		"staging_certificate":   make([]interface{}, 0),
+	}
+	changeID, err := cpstools.GetChangeIDFromPendingChanges(pendingChanges)
+	if err != nil {
+		if errors.Is(err, cpstools.ErrNoPendingChanges) {
+			return attrs, nil, nil
+		}
+		return nil, nil, err
+	}
+	status, err := client.GetChangeStatus(ctx, cps.GetChangeStatusRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if status.StatusInfo != nil {
+		attrs["pending_change_status"] = status.StatusInfo.Status
+	}
+	if attrs["staging_certificate"], err = getStagingCertificates(ctx, client, enrollmentID, changeID, status); err != nil {
+		return nil, nil, err
+	}
+	return attrs, status, nil
+}
+
+// stagingCertificateSchema returns the schema of the certificates deployed to staging while the change waits for the change management acknowledgement
+func stagingCertificateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The certificates deployed to staging, while the change waits for the change management acknowledgement",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"certificate": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"key_algorithm": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"signature_algorithm": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// getDeployedCertificates returns the certificates of the enrollment deployed to production, starting with the primary one.
+// The list is empty if no certificate has been deployed yet
+func getDeployedCertificates(ctx context.Context, client CPS, enrollmentID int) ([]interface{}, error) {
+	certificates := make([]interface{}, 0)
+	deployment, err := client.GetProductionDeployment(ctx, GetDeploymentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		var apiErr *cps.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return certificates, nil
+		}
+		return nil, err
+	}
+	now := time.Now()
+	for _, deployed := range append([]DeployedCertificate{deployment.PrimaryCertificate}, deployment.MultiStackedCertificates...) {
+		if deployed.Certificate == "" {
+			continue
+		}
+		certificate, err := cpstools.DeployedCertificateToMap(deployed.Certificate, deployed.KeyAlgorithm, now)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	return certificates, nil
+}
+
+// deployedCertificateSchema returns the schema of the certificates deployed to production
+func deployedCertificateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The certificates deployed to production, starting with the primary one",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_algorithm": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"issuer": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"serial_number": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"not_after": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The expiration time of the certificate in RFC 3339 format",
+				},
+				"days_to_expiry": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of whole days left until the certificate expires",
+				},
+			},
+		},
+	}
+}
+
+// waitForVerification waits until the DV enrollment change reaches domain validation stage
+func waitForVerification(ctx context.Context, logger log.Interface, client cps.CPS, enrollmentID int, acknowledgeWarnings bool) error {
+	return waitForChangeStatus(ctx, logger, client, enrollmentID, acknowledgeWarnings, func(status *cps.Change) bool {
+		return status.StatusInfo.Status == statusCoordinateDomainValidation && len(status.AllowedInput) > 0
+	})
+}
+
+// waitForChangeStatus polls the status of the enrollment's pending change until either isReady returns true or the change is completed.
+// Pre-verification warnings are acknowledged on the way if acknowledgeWarnings is set, otherwise they result in an error.
+func waitForChangeStatus(ctx context.Context, logger log.Interface, client cps.CPS, enrollmentID int, acknowledgeWarnings bool, isReady func(*cps.Change) bool) error {
+	getEnrollmentReq := cps.GetEnrollmentRequest{EnrollmentID: enrollmentID}
+	enrollmentGet, err := client.GetEnrollment(ctx, getEnrollmentReq)
+	if err != nil {
+		return err
+	}
+	changeID, err := cpstools.GetChangeIDFromPendingChanges(enrollmentGet.PendingChanges)
+	if err != nil {
+		if errors.Is(err, cpstools.ErrNoPendingChanges) {
+			logger.Debug("No pending changes found on the enrollment")
+			return nil
+		}
+		return err
+	}
+
+	changeStatusReq := cps.GetChangeStatusRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	}
+	status, err := client.GetChangeStatus(ctx, changeStatusReq)
+	if err != nil {
+		return err
+	}
+	for !isReady(status) && status.StatusInfo.Status != statusCompleted {
+		select {
+		case <-time.After(PollForChangeStatusInterval):
+			status, err = client.GetChangeStatus(ctx, changeStatusReq)
+			if err != nil {
+				return err
+			}
+			if status.StatusInfo != nil && status.StatusInfo.Status == statusVerificationWarnings {
+				warnings, err := client.GetChangePreVerificationWarnings(ctx, cps.GetChangeRequest{
+					EnrollmentID: enrollmentID,
+					ChangeID:     changeID,
+				})
+				if err != nil {
+					return err
+				}
+				logger.Debugf("Pre-verification warnings: %s", warnings.Warnings)
+				if acknowledgeWarnings {
+					err = client.AcknowledgePreVerificationWarnings(ctx, cps.AcknowledgementRequest{
+						Acknowledgement: cps.Acknowledgement{Acknowledgement: cps.AcknowledgementAcknowledge},
+						EnrollmentID:    enrollmentID,
+						ChangeID:        changeID,
+					})
+					if err != nil {
+						return err
+					}
+					continue
+				}
+				return fmt.Errorf("enrollment pre-verification returned warnings and the enrollment cannot be validated. Please fix the issues or set acknowledge_pre_validation_warnings flag to true then run 'terraform apply' again: %s",
+					warnings.Warnings)
+			}
+			log.Debugf("Change status: %s", status.StatusInfo.Status)
+			if status.StatusInfo != nil && status.StatusInfo.Error != nil && status.StatusInfo.Error.Description != "" {
+				return fmt.Errorf(status.StatusInfo.Error.Description)
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("change status context terminated: %w", ctx.Err())
+		}
+	}
+	return nil
+}
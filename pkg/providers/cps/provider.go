@@ -7,7 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	dns "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configdns"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 )
 
@@ -15,7 +15,8 @@ type (
 	provider struct {
 		*schema.Provider
 
-		client cps.CPS
+		client    CPS
+		dnsClient dns.DNS
 	}
 
 	// Option is a cps provider option
@@ -44,29 +45,50 @@ func Subprovider(opts ...Option) akamai.Subprovider {
 // Provider returns the Akamai terraform.Resource provider.
 func Provider() *schema.Provider {
 	provider := &schema.Provider{
-		Schema:         map[string]*schema.Schema{},
-		DataSourcesMap: map[string]*schema.Resource{},
+		Schema: map[string]*schema.Schema{},
+		DataSourcesMap: map[string]*schema.Resource{
+			"akamai_cps_enrollment":  dataSourceCPSEnrollment(),
+			"akamai_cps_enrollments": dataSourceCPSEnrollments(),
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"akamai_cps_dv_enrollment": resourceCPSDVEnrollment(),
-			"akamai_cps_dv_validation": resourceCPSDVValidation(),
+			"akamai_cps_dv_enrollment":          resourceCPSDVEnrollment(),
+			"akamai_cps_dv_validation":          resourceCPSDVValidation(),
+			"akamai_cps_dv_dns_validation":      resourceCPSDVDNSValidation(),
+			"akamai_cps_ov_ev_enrollment":       resourceCPSOVEVEnrollment(),
+			"akamai_cps_third_party_enrollment": resourceCPSThirdPartyEnrollment(),
 		},
 	}
 	return provider
 }
 
 // WithClient sets the client interface function, used for mocking and testing
-func WithClient(c cps.CPS) Option {
+func WithClient(c CPS) Option {
 	return func(p *provider) {
 		p.client = c
 	}
 }
 
 // Client returns the CPS interface
-func (p *provider) Client(meta akamai.OperationMeta) cps.CPS {
+func (p *provider) Client(meta akamai.OperationMeta) CPS {
 	if p.client != nil {
 		return p.client
 	}
-	return cps.Client(meta.Session())
+	return Client(meta.Session())
+}
+
+// WithDNSClient sets the Edge DNS client interface function, used for mocking and testing
+func WithDNSClient(c dns.DNS) Option {
+	return func(p *provider) {
+		p.dnsClient = c
+	}
+}
+
+// DNSClient returns the Edge DNS interface used to fulfil DNS challenges
+func (p *provider) DNSClient(meta akamai.OperationMeta) dns.DNS {
+	if p.dnsClient != nil {
+		return p.dnsClient
+	}
+	return dns.Client(meta.Session())
 }
 
 func (p *provider) Name() string {
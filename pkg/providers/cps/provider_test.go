@@ -10,7 +10,7 @@ import (
 
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	dns "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configdns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -43,7 +43,7 @@ func TestProvider(t *testing.T) {
 var clientLock sync.Mutex
 
 // useClient swaps out the client on the global instance for the duration of the given func
-func useClient(client cps.CPS, f func()) {
+func useClient(client CPS, f func()) {
 	clientLock.Lock()
 	orig := inst.client
 	inst.client = client
@@ -56,6 +56,17 @@ func useClient(client cps.CPS, f func()) {
 	f()
 }
 
+// useDNSClient swaps out the Edge DNS client on the global instance for the duration of the given func.
+// It has to be called within useClient, which holds the client lock.
+func useDNSClient(client dns.DNS, f func()) {
+	orig := inst.dnsClient
+	inst.dnsClient = client
+	defer func() {
+		inst.dnsClient = orig
+	}()
+	f()
+}
+
 // loadFixtureBytes returns the entire contents of the given file as a byte slice
 func loadFixtureBytes(path string) []byte {
 	contents, err := ioutil.ReadFile(path)
@@ -0,0 +1,356 @@
+package cps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dns "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configdns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	cpstools "github.com/akamai/terraform-provider-akamai/v2/pkg/providers/cps/tools"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const challengeTypeDNS = "dns-01"
+
+var (
+	// DVDNSValidationResourceTimeout is the default timeout for the DV DNS validation, which includes waiting for the domain validation
+	DVDNSValidationResourceTimeout = 90 * time.Minute
+
+	// challengeRecordsCleanupTimeout limits the removal of the challenge records once the validation is done
+	challengeRecordsCleanupTimeout = 5 * time.Minute
+)
+
+// challengeRecord is a TXT record which has to be created in one of the Edge DNS zones to fulfil DNS challenges
+type challengeRecord struct {
+	zone   string
+	name   string
+	values []string
+}
+
+func resourceCPSDVDNSValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCPSDVDNSValidationCreate,
+		ReadContext:   resourceCPSDVDNSValidationRead,
+		DeleteContext: resourceCPSDVDNSValidationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &DVDNSValidationResourceTimeout,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enrollment_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"zones": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "Edge DNS zones in which the challenge TXT records are created",
+			},
+			"ttl": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          60,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(30, 86400)),
+				Description:      "TTL of the challenge TXT records",
+			},
+			"propagation_delay": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          120,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Number of seconds to wait after the challenge records are created before validation is triggered. The propagation of the records is not checked",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Challenge TXT records created to fulfil the validation",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCPSDVDNSValidationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceDVDNSValidation")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	dnsClient := inst.DNSClient(meta)
+	logger.Debug("Creating dv dns validation")
+
+	enrollmentID, err := tools.GetIntValue("enrollment_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zonesSet, err := tools.GetSetValue("zones", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var zones []string
+	for _, zone := range zonesSet.List() {
+		zones = append(zones, strings.TrimSuffix(zone.(string), "."))
+	}
+	ttl, err := tools.GetIntValue("ttl", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	propagationDelay, err := tools.GetIntValue("propagation_delay", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enrollment, err := client.GetEnrollment(ctx, cps.GetEnrollmentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	changeID, err := cpstools.GetChangeIDFromPendingChanges(enrollment.PendingChanges)
+	if err != nil {
+		if errors.Is(err, cpstools.ErrNoPendingChanges) {
+			logger.Debug("No pending changes found on the enrollment")
+			d.SetId(strconv.Itoa(enrollmentID))
+			return resourceCPSDVDNSValidationRead(ctx, d, m)
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = waitForVerification(ctx, logger, client, enrollmentID, false); err != nil {
+		return diag.FromErr(err)
+	}
+	challenges, err := client.GetChangeLetsEncryptChallenges(ctx, cps.GetChangeRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	records, err := getChallengeRecords(challenges, zones)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var created []challengeRecord
+	// challenge records are not needed once the validation is done, so they are removed regardless of the result.
+	// The removal gets its own context, so that the records are also removed when ctx is cancelled or times out
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(
+			session.ContextWithOptions(context.Background(), session.WithContextLog(logger)),
+			challengeRecordsCleanupTimeout,
+		)
+		defer cancel()
+		for _, record := range created {
+			if err := dnsClient.DeleteRecord(cleanupCtx, newChallengeRecordBody(record, ttl), record.zone); err != nil {
+				logger.Warnf("could not remove challenge record '%s' from zone '%s': %s", record.name, record.zone, err)
+			}
+		}
+	}()
+	for _, record := range records {
+		logger.Debugf("Creating challenge record '%s' in zone '%s'", record.name, record.zone)
+		if err := dnsClient.CreateRecord(ctx, newChallengeRecordBody(record, ttl), record.zone); err != nil {
+			return diag.Errorf("could not create challenge record '%s' in zone '%s': %s", record.name, record.zone, err)
+		}
+		created = append(created, record)
+	}
+
+	if len(records) > 0 {
+		logger.Debugf("Waiting %d seconds before triggering the validation", propagationDelay)
+		select {
+		case <-time.After(time.Duration(propagationDelay) * time.Second):
+		case <-ctx.Done():
+			return diag.Errorf("waiting before triggering the validation terminated: %s", ctx.Err())
+		}
+	}
+
+	err = client.AcknowledgeDVChallenges(ctx, cps.AcknowledgementRequest{
+		Acknowledgement: cps.Acknowledgement{Acknowledgement: cps.AcknowledgementAcknowledge},
+		EnrollmentID:    enrollmentID,
+		ChangeID:        changeID,
+	})
+	if err != nil {
+		return diag.Errorf("error sending acknowledgement request: %s", err)
+	}
+	if err = waitForDomainValidation(ctx, logger, client, enrollmentID, changeID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("records", challengeRecordsToList(records)); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	d.SetId(strconv.Itoa(enrollmentID))
+	return resourceCPSDVDNSValidationRead(ctx, d, m)
+}
+
+func resourceCPSDVDNSValidationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceDVDNSValidation")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Reading dv dns validation")
+	enrollmentID, err := tools.GetIntValue("enrollment_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	enrollment, err := client.GetEnrollment(ctx, cps.GetEnrollmentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	status, err := getPendingChangeStatus(ctx, client, enrollmentID, enrollment.PendingChanges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if status == "" {
+		status = statusCompleted
+	}
+	if err := d.Set("status", status); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	return nil
+}
+
+func resourceCPSDVDNSValidationDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// getChallengeRecords returns the TXT records fulfilling pending DNS challenges, grouped by record name.
+// Each record is assigned the most specific zone from the given ones.
+func getChallengeRecords(challenges *cps.DVArray, zones []string) ([]challengeRecord, error) {
+	recordsByName := make(map[string]*challengeRecord)
+	for _, dv := range challenges.DV {
+		if dv.ValidationStatus == "VALIDATED" {
+			continue
+		}
+		for _, challenge := range dv.Challenges {
+			if challenge.Type != challengeTypeDNS || challenge.Status != "pending" {
+				continue
+			}
+			name := strings.TrimSuffix(challenge.FullPath, ".")
+			record, ok := recordsByName[name]
+			if !ok {
+				zone := findZone(name, zones)
+				if zone == "" {
+					return nil, fmt.Errorf("none of the zones %v matches the challenge record '%s' of domain '%s'", zones, name, dv.Domain)
+				}
+				record = &challengeRecord{zone: zone, name: name}
+				recordsByName[name] = record
+			}
+			record.values = append(record.values, challenge.ResponseBody)
+		}
+	}
+
+	records := make([]challengeRecord, 0, len(recordsByName))
+	for _, record := range recordsByName {
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].name < records[j].name
+	})
+	return records, nil
+}
+
+// findZone returns the longest zone containing the given record name or an empty string if there is none
+func findZone(name string, zones []string) string {
+	var result string
+	for _, zone := range zones {
+		if (name == zone || strings.HasSuffix(name, "."+zone)) && len(zone) > len(result) {
+			result = zone
+		}
+	}
+	return result
+}
+
+func newChallengeRecordBody(record challengeRecord, ttl int) *dns.RecordBody {
+	targets := make([]string, 0, len(record.values))
+	for _, value := range record.values {
+		targets = append(targets, `"`+value+`"`)
+	}
+	return &dns.RecordBody{
+		Name:       record.name,
+		RecordType: "TXT",
+		TTL:        ttl,
+		Target:     targets,
+	}
+}
+
+func challengeRecordsToList(records []challengeRecord) []interface{} {
+	result := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		result = append(result, map[string]interface{}{
+			"zone":   record.zone,
+			"name":   record.name,
+			"values": record.values,
+		})
+	}
+	return result
+}
+
+// waitForDomainValidation waits until the change leaves the domain validation stage after the challenges were acknowledged
+func waitForDomainValidation(ctx context.Context, logger log.Interface, client cps.CPS, enrollmentID, changeID int) error {
+	changeStatusReq := cps.GetChangeStatusRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	}
+	for {
+		status, err := client.GetChangeStatus(ctx, changeStatusReq)
+		if err != nil {
+			return err
+		}
+		if status.StatusInfo == nil {
+			return nil
+		}
+		if status.StatusInfo.Error != nil && status.StatusInfo.Error.Description != "" {
+			return fmt.Errorf("domain validation failed: %s", status.StatusInfo.Error.Description)
+		}
+		if status.StatusInfo.Status != statusCoordinateDomainValidation {
+			return nil
+		}
+		logger.Debugf("Change status: %s", status.StatusInfo.Status)
+		select {
+		case <-time.After(PollForChangeStatusInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("change status context terminated: %w", ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package cps
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	dns "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configdns"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDVDNSValidation(t *testing.T) {
+	challenges := &cps.DVArray{DV: []cps.DV{
+		{
+			Domain: "test.akamai.com",
+			Challenges: []cps.Challenges{
+				{Type: "dns-01", Status: "pending", FullPath: "_acme-challenge.test.akamai.com", ResponseBody: "abc"},
+				{Type: "http-01", Status: "pending", FullPath: "http://test.akamai.com/.well-known/acme-challenge/1", ResponseBody: "def"},
+			},
+		},
+		{
+			Domain: "san.test.akamai.com",
+			Challenges: []cps.Challenges{
+				{Type: "dns-01", Status: "pending", FullPath: "_acme-challenge.san.test.akamai.com", ResponseBody: "ghi"},
+			},
+		},
+		{
+			Domain:           "validated.akamai.com",
+			ValidationStatus: "VALIDATED",
+			Challenges: []cps.Challenges{
+				{Type: "dns-01", Status: "pending", FullPath: "_acme-challenge.validated.akamai.com", ResponseBody: "jkl"},
+			},
+		},
+	}}
+	sanRecord := &dns.RecordBody{Name: "_acme-challenge.san.test.akamai.com", RecordType: "TXT", TTL: 60, Target: []string{`"ghi"`}}
+	cnRecord := &dns.RecordBody{Name: "_acme-challenge.test.akamai.com", RecordType: "TXT", TTL: 60, Target: []string{`"abc"`}}
+
+	mockVerification := func(client *mockcps) {
+		client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+			Return(&cps.Enrollment{PendingChanges: []string{"/cps/v2/enrollments/1/changes/2"}}, nil)
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{EnrollmentID: 1, ChangeID: 2}).
+			Return(&cps.Change{
+				AllowedInput: []cps.AllowedInput{{Type: "lets-encrypt-challenges"}},
+				StatusInfo: &cps.StatusInfo{
+					State:  "awaiting-input",
+					Status: "coodinate-domain-validation",
+				},
+			}, nil).Once()
+		client.On("GetChangeLetsEncryptChallenges", mock.Anything, cps.GetChangeRequest{EnrollmentID: 1, ChangeID: 2}).
+			Return(challenges, nil).Once()
+	}
+
+	t.Run("lifecycle test", func(t *testing.T) {
+		PollForChangeStatusInterval = 1 * time.Millisecond
+		client := &mockcps{}
+		dnsClient := &mockdns{}
+		mockVerification(client)
+
+		dnsClient.On("CreateRecord", mock.Anything, sanRecord, "akamai.com").Return(nil).Once()
+		dnsClient.On("CreateRecord", mock.Anything, cnRecord, "akamai.com").Return(nil).Once()
+		client.On("AcknowledgeDVChallenges", mock.Anything, cps.AcknowledgementRequest{
+			Acknowledgement: cps.Acknowledgement{Acknowledgement: "acknowledge"},
+			EnrollmentID:    1,
+			ChangeID:        2,
+		}).Return(nil).Once()
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{EnrollmentID: 1, ChangeID: 2}).
+			Return(&cps.Change{StatusInfo: &cps.StatusInfo{
+				State:  "awaiting-input",
+				Status: "coodinate-domain-validation",
+			}}, nil).Once()
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{EnrollmentID: 1, ChangeID: 2}).
+			Return(&cps.Change{StatusInfo: &cps.StatusInfo{
+				State:  "running",
+				Status: "wait-review-cert-warning",
+			}}, nil)
+		dnsClient.On("DeleteRecord", mock.Anything, sanRecord, "akamai.com").Return(nil).Once()
+		dnsClient.On("DeleteRecord", mock.Anything, cnRecord, "akamai.com").Return(nil).Once()
+
+		useClient(client, func() {
+			useDNSClient(dnsClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString("testdata/TestResDVDNSValidation/create_validation.tf"),
+							Check: resource.ComposeTestCheckFunc(
+								resource.TestCheckResourceAttr("akamai_cps_dv_dns_validation.dns_validation", "id", "1"),
+								resource.TestCheckResourceAttr("akamai_cps_dv_dns_validation.dns_validation", "status", "wait-review-cert-warning"),
+								resource.TestCheckResourceAttr("akamai_cps_dv_dns_validation.dns_validation", "records.#", "2"),
+								resource.TestCheckResourceAttr("akamai_cps_dv_dns_validation.dns_validation", "records.0.name", "_acme-challenge.san.test.akamai.com"),
+								resource.TestCheckResourceAttr("akamai_cps_dv_dns_validation.dns_validation", "records.0.zone", "akamai.com"),
+								resource.TestCheckResourceAttr("akamai_cps_dv_dns_validation.dns_validation", "records.1.values.0", "abc"),
+							),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		dnsClient.AssertExpectations(t)
+	})
+
+	t.Run("no zone matches challenge record", func(t *testing.T) {
+		PollForChangeStatusInterval = 1 * time.Millisecond
+		client := &mockcps{}
+		dnsClient := &mockdns{}
+		mockVerification(client)
+
+		useClient(client, func() {
+			useDNSClient(dnsClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:      loadFixtureString("testdata/TestResDVDNSValidation/invalid_zone.tf"),
+							ExpectError: regexp.MustCompile("none of the zones \\[example.com\\] matches the challenge record '_acme-challenge.test.akamai.com'"),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		dnsClient.AssertExpectations(t)
+	})
+
+	t.Run("challenge records are removed when validation fails", func(t *testing.T) {
+		PollForChangeStatusInterval = 1 * time.Millisecond
+		client := &mockcps{}
+		dnsClient := &mockdns{}
+		mockVerification(client)
+
+		dnsClient.On("CreateRecord", mock.Anything, sanRecord, "akamai.com").Return(nil).Once()
+		dnsClient.On("CreateRecord", mock.Anything, cnRecord, "akamai.com").Return(nil).Once()
+		client.On("AcknowledgeDVChallenges", mock.Anything, mock.Anything).Return(nil).Once()
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{EnrollmentID: 1, ChangeID: 2}).
+			Return(&cps.Change{StatusInfo: &cps.StatusInfo{
+				State:  "error",
+				Status: "coodinate-domain-validation",
+				Error:  &cps.StatusInfoError{Description: "validation failed"},
+			}}, nil).Once()
+		dnsClient.On("DeleteRecord", mock.Anything, sanRecord, "akamai.com").Return(nil).Once()
+		dnsClient.On("DeleteRecord", mock.Anything, cnRecord, "akamai.com").Return(nil).Once()
+
+		useClient(client, func() {
+			useDNSClient(dnsClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:      loadFixtureString("testdata/TestResDVDNSValidation/create_validation.tf"),
+							ExpectError: regexp.MustCompile("domain validation failed: validation failed"),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		dnsClient.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,233 @@
+package cps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCPSOVEVEnrollment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCPSOVEVEnrollmentCreate,
+		ReadContext:   resourceCPSOVEVEnrollmentRead,
+		UpdateContext: resourceCPSOVEVEnrollmentUpdate,
+		DeleteContext: resourceCPSEnrollmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCPSEnrollmentImport,
+		},
+		Schema: enrollmentSchema(map[string]*schema.Schema{
+			"validation_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: tools.ValidateStringInSlice([]string{"ov", "ev"}),
+			},
+			"change_management": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"pending_change_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staging_certificate": stagingCertificateSchema(),
+		}),
+	}
+}
+
+func resourceCPSOVEVEnrollmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceOVEVEnrollment")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Creating OV/EV enrollment")
+
+	enrollment, err := getOVEVEnrollment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contractID, err := tools.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule, err := getDeploymentSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := cps.CreateEnrollmentRequest{
+		Enrollment:      *enrollment,
+		ContractID:      strings.TrimPrefix(contractID, "ctr_"),
+		DeployNotBefore: schedule.NotBefore,
+		DeployNotAfter:  schedule.NotAfter,
+	}
+	res, err := client.CreateEnrollment(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.Itoa(res.ID))
+
+	acknowledgeWarnings, err := tools.GetBoolValue("acknowledge_pre_verification_warnings", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if err = waitForPreVerification(ctx, logger, client, res.ID, acknowledgeWarnings); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = acknowledgeChangeManagement(ctx, d, logger, client, res.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCPSOVEVEnrollmentRead(ctx, d, m)
+}
+
+func resourceCPSOVEVEnrollmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceOVEVEnrollment")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Reading OV/EV enrollment")
+	enrollmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	enrollment, err := client.GetEnrollment(ctx, cps.GetEnrollmentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	attrs := enrollmentToAttrs(enrollment)
+
+	changeAttrs, _, err := getPendingChangeAttrs(ctx, client, enrollmentID, enrollment.PendingChanges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for name, value := range changeAttrs {
+		attrs[name] = value
+	}
+
+	if err = tools.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceCPSOVEVEnrollmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceOVEVEnrollment")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Updating OV/EV enrollment")
+
+	acknowledgeWarnings, err := tools.GetBoolValue("acknowledge_pre_verification_warnings", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	enrollmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !d.HasChanges(
+		"sans",
+		"admin_contact",
+		"tech_contact",
+		"certificate_chain_type",
+		"csr",
+		"enable_multi_stacked_certificates",
+		"network_configuration",
+		"signature_algorithm",
+		"organization",
+		"change_management",
+	) {
+		logger.Debug("Enrollment does not have to be updated. Verifying status.")
+		if err = waitForPreVerification(ctx, logger, client, enrollmentID, acknowledgeWarnings); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = acknowledgeChangeManagement(ctx, d, logger, client, enrollmentID); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceCPSOVEVEnrollmentRead(ctx, d, m)
+	}
+
+	enrollment, err := getOVEVEnrollment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule, err := getDeploymentSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowCancel := true
+	req := cps.UpdateEnrollmentRequest{
+		Enrollment:                *enrollment,
+		EnrollmentID:              enrollmentID,
+		AllowCancelPendingChanges: &allowCancel,
+		DeployNotBefore:           schedule.NotBefore,
+		DeployNotAfter:            schedule.NotAfter,
+	}
+	if _, err := client.UpdateEnrollment(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = waitForPreVerification(ctx, logger, client, enrollmentID, acknowledgeWarnings); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = acknowledgeChangeManagement(ctx, d, logger, client, enrollmentID); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCPSOVEVEnrollmentRead(ctx, d, m)
+}
+
+// getOVEVEnrollment builds the OV/EV enrollment object from ResourceData and sets the attributes which are fixed for this enrollment type
+func getOVEVEnrollment(d *schema.ResourceData) (*cps.Enrollment, error) {
+	enrollment, err := getEnrollment(d)
+	if err != nil {
+		return nil, err
+	}
+	validationType, err := tools.GetStringValue("validation_type", d)
+	if err != nil {
+		return nil, err
+	}
+	enrollment.CertificateType = "san"
+	enrollment.ValidationType = validationType
+	enrollment.RA = "symantec"
+
+	if err := d.Set("certificate_type", enrollment.CertificateType); err != nil {
+		return nil, fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := d.Set("registration_authority", enrollment.RA); err != nil {
+		return nil, fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	return enrollment, nil
+}
+
+// waitForPreVerification waits until the enrollment change passes the pre-verification safety checks.
+// Organization validation of OV/EV certificates is done by the certificate authority and is not awaited.
+func waitForPreVerification(ctx context.Context, logger log.Interface, client cps.CPS, enrollmentID int, acknowledgeWarnings bool) error {
+	return waitForChangeStatus(ctx, logger, client, enrollmentID, acknowledgeWarnings, func(status *cps.Change) bool {
+		return status.StatusInfo.Status != statusPreVerificationSafetyChecks && status.StatusInfo.Status != statusVerificationWarnings
+	})
+}
@@ -0,0 +1,201 @@
+package cps
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResourceOVEVEnrollment(t *testing.T) {
+	t.Run("lifecycle test with pre-verification warnings acknowledged", func(t *testing.T) {
+		PollForChangeStatusInterval = 1 * time.Millisecond
+		client := &mockcps{}
+		enrollment := newTestEnrollment("san", "ov", "symantec")
+
+		client.On("CreateEnrollment",
+			mock.Anything,
+			cps.CreateEnrollmentRequest{
+				Enrollment: enrollment,
+				ContractID: "1",
+			},
+		).Return(&cps.CreateEnrollmentResponse{
+			ID:         1,
+			Enrollment: "/cps/v2/enrollments/1",
+			Changes:    []string{"/cps/v2/enrollments/1/changes/2"},
+		}, nil).Once()
+
+		enrollment.Location = "/cps/v2/enrollments/1"
+		enrollment.PendingChanges = []string{"/cps/v2/enrollments/1/changes/2"}
+		client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+			Return(&enrollment, nil)
+
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&cps.Change{
+			AllowedInput: []cps.AllowedInput{},
+			StatusInfo: &cps.StatusInfo{
+				State:  "running",
+				Status: "pre-verification-safety-checks",
+			},
+		}, nil).Once()
+
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&cps.Change{
+			AllowedInput: []cps.AllowedInput{{Type: "pre-verification-warnings"}},
+			StatusInfo: &cps.StatusInfo{
+				State:  "awaiting-input",
+				Status: "wait-review-pre-verification-safety-checks",
+			},
+		}, nil).Once()
+
+		client.On("GetChangePreVerificationWarnings", mock.Anything, cps.GetChangeRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&cps.PreVerificationWarnings{
+			Warnings: "some warning",
+		}, nil).Once()
+
+		client.On("AcknowledgePreVerificationWarnings", mock.Anything, cps.AcknowledgementRequest{
+			Acknowledgement: cps.Acknowledgement{Acknowledgement: "acknowledge"},
+			EnrollmentID:    1,
+			ChangeID:        2,
+		}).Return(nil).Once()
+
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&cps.Change{
+			AllowedInput: []cps.AllowedInput{},
+			StatusInfo: &cps.StatusInfo{
+				State:  "running",
+				Status: "verify-organization",
+			},
+		}, nil)
+
+		allowCancel := true
+		client.On("RemoveEnrollment", mock.Anything, cps.RemoveEnrollmentRequest{
+			EnrollmentID:              1,
+			AllowCancelPendingChanges: &allowCancel,
+		}).Return(&cps.RemoveEnrollmentResponse{
+			Enrollment: "1",
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResOVEVEnrollment/lifecycle/create_enrollment.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "contract_id", "ctr_1"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "certificate_type", "san"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "validation_type", "ov"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "registration_authority", "symantec"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "pending_change_status", "verify-organization"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+	t.Run("change management with deployment schedule", func(t *testing.T) {
+		PollForChangeStatusInterval = 1 * time.Millisecond
+		client := &mockcps{}
+		enrollment := newTestEnrollment("san", "ev", "symantec")
+		enrollment.ChangeManagement = true
+
+		client.On("CreateEnrollment",
+			mock.Anything,
+			cps.CreateEnrollmentRequest{
+				Enrollment:      enrollment,
+				ContractID:      "1",
+				DeployNotBefore: "2022-03-01T00:00:00Z",
+				DeployNotAfter:  "2022-03-08T00:00:00Z",
+			},
+		).Return(&cps.CreateEnrollmentResponse{
+			ID:         1,
+			Enrollment: "/cps/v2/enrollments/1",
+			Changes:    []string{"/cps/v2/enrollments/1/changes/2"},
+		}, nil).Once()
+
+		enrollment.Location = "/cps/v2/enrollments/1"
+		enrollment.PendingChanges = []string{"/cps/v2/enrollments/1/changes/2"}
+		client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+			Return(&enrollment, nil)
+
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&cps.Change{
+			AllowedInput: []cps.AllowedInput{{Type: "change-management-info"}},
+			StatusInfo: &cps.StatusInfo{
+				State:  "awaiting-input",
+				Status: "wait-ack-change-management",
+			},
+		}, nil)
+
+		client.On("GetChangeManagementInfo", mock.Anything, cps.GetChangeRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&ChangeManagementInfo{
+			ValidationResultHash: "7a5b36d1",
+			PendingState: ChangeManagementPending{
+				PendingCertificates: []StagingCertificate{{
+					CertificateType:    "san",
+					FullCertificate:    "-----BEGIN CERTIFICATE-----",
+					KeyAlgorithm:       "RSA",
+					SignatureAlgorithm: "SHA-256",
+				}},
+			},
+		}, nil)
+
+		client.On("AcknowledgeChangeManagement", mock.Anything, AcknowledgeChangeManagementRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+			Hash:         "7a5b36d1",
+		}).Return(&cps.UpdateChangeResponse{Change: "/cps/v2/enrollments/1/changes/2"}, nil).Once()
+
+		allowCancel := true
+		client.On("RemoveEnrollment", mock.Anything, cps.RemoveEnrollmentRequest{
+			EnrollmentID:              1,
+			AllowCancelPendingChanges: &allowCancel,
+		}).Return(&cps.RemoveEnrollmentResponse{
+			Enrollment: "1",
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResOVEVEnrollment/change_management/create_enrollment.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "validation_type", "ev"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "change_management", "true"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "pending_change_status", "wait-ack-change-management"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "staging_certificate.#", "1"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "staging_certificate.0.certificate", "-----BEGIN CERTIFICATE-----"),
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "staging_certificate.0.signature_algorithm", "SHA-256"),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResOVEVEnrollment/change_management/acknowledge_change.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cps_ov_ev_enrollment.ov", "acknowledge_change_management", "true"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,353 @@
+package cps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	cpstools "github.com/akamai/terraform-provider-akamai/v2/pkg/providers/cps/tools"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCPSThirdPartyEnrollment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCPSThirdPartyEnrollmentCreate,
+		ReadContext:   resourceCPSThirdPartyEnrollmentRead,
+		UpdateContext: resourceCPSThirdPartyEnrollmentUpdate,
+		DeleteContext: resourceCPSEnrollmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCPSEnrollmentImport,
+		},
+		Schema: enrollmentSchema(map[string]*schema.Schema{
+			"signature_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exclude_sans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"change_management": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"pending_change_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staging_certificate": stagingCertificateSchema(),
+			"csr_pem": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CSR generated by CPS in PEM format, available while the change waits for the signed certificate",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The certificate signed by your CA in PEM format, uploaded when the change waits for it",
+			},
+			"trust_chain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"certificate"},
+				Description:  "The trust chain of the signed certificate in PEM format",
+			},
+		}),
+	}
+}
+
+func resourceCPSThirdPartyEnrollmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceThirdPartyEnrollment")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Creating third-party enrollment")
+
+	enrollment, err := getThirdPartyEnrollment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contractID, err := tools.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule, err := getDeploymentSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := cps.CreateEnrollmentRequest{
+		Enrollment:      *enrollment,
+		ContractID:      strings.TrimPrefix(contractID, "ctr_"),
+		DeployNotBefore: schedule.NotBefore,
+		DeployNotAfter:  schedule.NotAfter,
+	}
+	res, err := client.CreateEnrollment(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.Itoa(res.ID))
+
+	acknowledgeWarnings, err := tools.GetBoolValue("acknowledge_pre_verification_warnings", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if err = waitForThirdPartyCertificateUpload(ctx, logger, client, res.ID, acknowledgeWarnings); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = uploadThirdPartyCertificate(ctx, d, logger, client, res.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = acknowledgeChangeManagement(ctx, d, logger, client, res.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCPSThirdPartyEnrollmentRead(ctx, d, m)
+}
+
+func resourceCPSThirdPartyEnrollmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceThirdPartyEnrollment")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Reading third-party enrollment")
+	enrollmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	enrollment, err := client.GetEnrollment(ctx, cps.GetEnrollmentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	attrs := enrollmentToAttrs(enrollment)
+	attrs["exclude_sans"] = enrollment.ThirdParty != nil && enrollment.ThirdParty.ExcludeSANS
+
+	changeAttrs, _, err := getPendingChangeAttrs(ctx, client, enrollmentID, enrollment.PendingChanges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for name, value := range changeAttrs {
+		attrs[name] = value
+	}
+	attrs["csr_pem"] = ""
+	if attrs["pending_change_status"] == statusWaitUploadThirdParty {
+		if attrs["csr_pem"], err = getThirdPartyCSR(ctx, client, enrollmentID, enrollment.PendingChanges); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = tools.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceCPSThirdPartyEnrollmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("CPS", "resourceThirdPartyEnrollment")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	client := inst.Client(meta)
+	logger.Debug("Updating third-party enrollment")
+
+	acknowledgeWarnings, err := tools.GetBoolValue("acknowledge_pre_verification_warnings", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	enrollmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !d.HasChanges(
+		"sans",
+		"admin_contact",
+		"tech_contact",
+		"certificate_chain_type",
+		"csr",
+		"enable_multi_stacked_certificates",
+		"network_configuration",
+		"signature_algorithm",
+		"organization",
+		"change_management",
+		"exclude_sans",
+	) {
+		logger.Debug("Enrollment does not have to be updated. Verifying status.")
+		if err = waitForThirdPartyCertificateUpload(ctx, logger, client, enrollmentID, acknowledgeWarnings); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = uploadThirdPartyCertificate(ctx, d, logger, client, enrollmentID); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = acknowledgeChangeManagement(ctx, d, logger, client, enrollmentID); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceCPSThirdPartyEnrollmentRead(ctx, d, m)
+	}
+
+	enrollment, err := getThirdPartyEnrollment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule, err := getDeploymentSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowCancel := true
+	req := cps.UpdateEnrollmentRequest{
+		Enrollment:                *enrollment,
+		EnrollmentID:              enrollmentID,
+		AllowCancelPendingChanges: &allowCancel,
+		DeployNotBefore:           schedule.NotBefore,
+		DeployNotAfter:            schedule.NotAfter,
+	}
+	if _, err := client.UpdateEnrollment(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = waitForThirdPartyCertificateUpload(ctx, logger, client, enrollmentID, acknowledgeWarnings); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = uploadThirdPartyCertificate(ctx, d, logger, client, enrollmentID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = acknowledgeChangeManagement(ctx, d, logger, client, enrollmentID); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCPSThirdPartyEnrollmentRead(ctx, d, m)
+}
+
+// getThirdPartyEnrollment builds the third-party enrollment object from ResourceData and sets the attributes which are fixed for this enrollment type
+func getThirdPartyEnrollment(d *schema.ResourceData) (*cps.Enrollment, error) {
+	enrollment, err := getEnrollment(d)
+	if err != nil {
+		return nil, err
+	}
+	enrollment.CertificateType = "third-party"
+	enrollment.ValidationType = "third-party"
+	enrollment.RA = "third-party"
+
+	excludeSANS, err := tools.GetBoolValue("exclude_sans", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	enrollment.ThirdParty = &cps.ThirdParty{ExcludeSANS: excludeSANS}
+
+	if err := d.Set("certificate_type", enrollment.CertificateType); err != nil {
+		return nil, fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := d.Set("validation_type", enrollment.ValidationType); err != nil {
+		return nil, fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := d.Set("registration_authority", enrollment.RA); err != nil {
+		return nil, fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	return enrollment, nil
+}
+
+// waitForThirdPartyCertificateUpload waits until the pre-verification of the third-party enrollment change is done
+// and CPS waits for the signed certificate to be uploaded
+func waitForThirdPartyCertificateUpload(ctx context.Context, logger log.Interface, client cps.CPS, enrollmentID int, acknowledgeWarnings bool) error {
+	return waitForChangeStatus(ctx, logger, client, enrollmentID, acknowledgeWarnings, func(status *cps.Change) bool {
+		return status.StatusInfo.Status == statusWaitUploadThirdParty
+	})
+}
+
+// getThirdPartyCSR returns the CSR of the pending change in PEM format
+func getThirdPartyCSR(ctx context.Context, client CPS, enrollmentID int, pendingChanges []string) (string, error) {
+	changeID, err := cpstools.GetChangeIDFromPendingChanges(pendingChanges)
+	if err != nil {
+		return "", err
+	}
+	res, err := client.GetThirdPartyCSR(ctx, cps.GetChangeRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(res.CSRs) == 0 {
+		return "", nil
+	}
+	return res.CSRs[0].CSR, nil
+}
+
+// uploadThirdPartyCertificate uploads the configured certificate and trust chain if the pending change waits for them.
+// Once uploaded, the change moves on, so the certificate is not uploaded again in later applies.
+func uploadThirdPartyCertificate(ctx context.Context, d *schema.ResourceData, logger log.Interface, client CPS, enrollmentID int) error {
+	certificate, err := tools.GetStringValue("certificate", d)
+	if err != nil {
+		if errors.Is(err, tools.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	trustChain, err := tools.GetStringValue("trust_chain", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return err
+	}
+	enrollment, err := client.GetEnrollment(ctx, cps.GetEnrollmentRequest{EnrollmentID: enrollmentID})
+	if err != nil {
+		return err
+	}
+	changeID, err := cpstools.GetChangeIDFromPendingChanges(enrollment.PendingChanges)
+	if err != nil {
+		if errors.Is(err, cpstools.ErrNoPendingChanges) {
+			return nil
+		}
+		return err
+	}
+	status, err := client.GetChangeStatus(ctx, cps.GetChangeStatusRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return err
+	}
+	if status.StatusInfo == nil || status.StatusInfo.Status != statusWaitUploadThirdParty {
+		logger.Debug("Change is not waiting for the third-party certificate")
+		return nil
+	}
+	csr, err := client.GetThirdPartyCSR(ctx, cps.GetChangeRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+	})
+	if err != nil {
+		return err
+	}
+	var keyAlgorithm string
+	if len(csr.CSRs) > 0 {
+		keyAlgorithm = csr.CSRs[0].KeyAlgorithm
+	}
+	logger.Debug("Uploading third-party certificate")
+	_, err = client.UploadThirdPartyCertificate(ctx, UploadThirdPartyCertificateRequest{
+		EnrollmentID: enrollmentID,
+		ChangeID:     changeID,
+		Certificates: []ThirdPartyCertificate{{
+			Certificate:  certificate,
+			TrustChain:   trustChain,
+			KeyAlgorithm: keyAlgorithm,
+		}},
+	})
+	return err
+}
@@ -0,0 +1,218 @@
+package cps
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jinzhu/copier"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceThirdPartyEnrollment(t *testing.T) {
+	t.Run("lifecycle test", func(t *testing.T) {
+		PollForChangeStatusInterval = 1 * time.Millisecond
+		client := &mockcps{}
+		enrollment := newTestEnrollment("third-party", "third-party", "third-party")
+		enrollment.ChangeManagement = true
+		enrollment.ThirdParty = &cps.ThirdParty{ExcludeSANS: false}
+
+		client.On("CreateEnrollment",
+			mock.Anything,
+			cps.CreateEnrollmentRequest{
+				Enrollment: enrollment,
+				ContractID: "1",
+			},
+		).Return(&cps.CreateEnrollmentResponse{
+			ID:         1,
+			Enrollment: "/cps/v2/enrollments/1",
+			Changes:    []string{"/cps/v2/enrollments/1/changes/2"},
+		}, nil).Once()
+
+		var enrollmentUpdate cps.Enrollment
+		err := copier.CopyWithOption(&enrollmentUpdate, enrollment, copier.Option{DeepCopy: true})
+		require.NoError(t, err)
+		enrollmentUpdate.ChangeManagement = false
+		allowCancel := true
+		client.On("UpdateEnrollment",
+			mock.Anything,
+			cps.UpdateEnrollmentRequest{
+				Enrollment:                enrollmentUpdate,
+				EnrollmentID:              1,
+				AllowCancelPendingChanges: &allowCancel,
+			},
+		).Run(func(_ mock.Arguments) {
+			enrollment.ChangeManagement = false
+		}).Return(&cps.UpdateEnrollmentResponse{
+			ID:         1,
+			Enrollment: "/cps/v2/enrollments/1",
+			Changes:    []string{"/cps/v2/enrollments/1/changes/2"},
+		}, nil).Once()
+
+		enrollment.Location = "/cps/v2/enrollments/1"
+		enrollment.PendingChanges = []string{"/cps/v2/enrollments/1/changes/2"}
+		client.On("GetEnrollment", mock.Anything, cps.GetEnrollmentRequest{EnrollmentID: 1}).
+			Return(&enrollment, nil)
+
+		// first verification loop, pre-verification checks in progress
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&cps.Change{
+			AllowedInput: []cps.AllowedInput{},
+			StatusInfo: &cps.StatusInfo{
+				State:  "running",
+				Status: "pre-verification-safety-checks",
+			},
+		}, nil).Once()
+
+		client.On("GetChangeStatus", mock.Anything, cps.GetChangeStatusRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&cps.Change{
+			AllowedInput: []cps.AllowedInput{{Type: "third-party-certificate"}},
+			StatusInfo: &cps.StatusInfo{
+				State:  "awaiting-input",
+				Status: "wait-upload-third-party",
+			},
+		}, nil)
+
+		client.On("GetThirdPartyCSR", mock.Anything, cps.GetChangeRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+		}).Return(&ThirdPartyCSR{
+			CSRs: []CertSigningRequest{{
+				CSR:          "-----BEGIN CERTIFICATE REQUEST-----",
+				KeyAlgorithm: "RSA",
+			}},
+		}, nil)
+
+		client.On("UploadThirdPartyCertificate", mock.Anything, UploadThirdPartyCertificateRequest{
+			EnrollmentID: 1,
+			ChangeID:     2,
+			Certificates: []ThirdPartyCertificate{{
+				Certificate:  "-----BEGIN CERTIFICATE-----",
+				TrustChain:   "-----BEGIN CERTIFICATE----- chain",
+				KeyAlgorithm: "RSA",
+			}},
+		}).Return(&cps.UpdateChangeResponse{Change: "/cps/v2/enrollments/1/changes/2"}, nil).Once()
+
+		client.On("RemoveEnrollment", mock.Anything, cps.RemoveEnrollmentRequest{
+			EnrollmentID:              1,
+			AllowCancelPendingChanges: &allowCancel,
+		}).Return(&cps.RemoveEnrollmentResponse{
+			Enrollment: "1",
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResThirdPartyEnrollment/lifecycle/create_enrollment.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "contract_id", "ctr_1"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "certificate_type", "third-party"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "validation_type", "third-party"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "registration_authority", "third-party"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "change_management", "true"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "pending_change_status", "wait-upload-third-party"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "csr_pem", "-----BEGIN CERTIFICATE REQUEST-----"),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResThirdPartyEnrollment/lifecycle/update_enrollment.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "change_management", "false"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "pending_change_status", "wait-upload-third-party"),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResThirdPartyEnrollment/lifecycle/upload_certificate.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "certificate", "-----BEGIN CERTIFICATE-----"),
+							resource.TestCheckResourceAttr("akamai_cps_third_party_enrollment.third_party", "trust_chain", "-----BEGIN CERTIFICATE----- chain"),
+						),
+					},
+					{
+						ImportState:             true,
+						ImportStateId:           "1,ctr_1",
+						ResourceName:            "akamai_cps_third_party_enrollment.third_party",
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"certificate", "trust_chain"},
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
+
+// newTestEnrollment returns an enrollment matching the attributes of the lifecycle test fixtures
+func newTestEnrollment(certificateType, validationType, ra string) cps.Enrollment {
+	return cps.Enrollment{
+		AdminContact: &cps.Contact{
+			AddressLineOne:   "150 Broadway",
+			City:             "Cambridge",
+			Country:          "US",
+			Email:            "r1d1@akamai.com",
+			FirstName:        "R1",
+			LastName:         "D1",
+			OrganizationName: "Akamai",
+			Phone:            "123123123",
+			PostalCode:       "12345",
+			Region:           "MA",
+		},
+		CertificateChainType: "default",
+		CertificateType:      certificateType,
+		CSR: &cps.CSR{
+			C:    "US",
+			CN:   "test.akamai.com",
+			L:    "Cambridge",
+			O:    "Akamai",
+			OU:   "WebEx",
+			SANS: []string{"san.test.akamai.com"},
+			ST:   "MA",
+		},
+		NetworkConfiguration: &cps.NetworkConfiguration{
+			DisallowedTLSVersions: []string{"TLSv1", "TLSv1_1"},
+			DNSNameSettings: &cps.DNSNameSettings{
+				CloneDNSNames: false,
+				DNSNames:      []string{"san.test.akamai.com"},
+			},
+			Geography:        "core",
+			MustHaveCiphers:  "ak-akamai-default",
+			OCSPStapling:     "on",
+			PreferredCiphers: "ak-akamai-default",
+			SecureNetwork:    "enhanced-tls",
+			SNIOnly:          true,
+		},
+		Org: &cps.Org{
+			AddressLineOne: "150 Broadway",
+			City:           "Cambridge",
+			Country:        "US",
+			Name:           "Akamai",
+			Phone:          "321321321",
+			PostalCode:     "12345",
+			Region:         "MA",
+		},
+		RA:                 ra,
+		SignatureAlgorithm: "SHA-256",
+		TechContact: &cps.Contact{
+			AddressLineOne:   "150 Broadway",
+			City:             "Cambridge",
+			Country:          "US",
+			Email:            "r2d2@akamai.com",
+			FirstName:        "R2",
+			LastName:         "D2",
+			OrganizationName: "Akamai",
+			Phone:            "123123123",
+			PostalCode:       "12345",
+			Region:           "MA",
+		},
+		ValidationType: validationType,
+	}
+}
@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_cps_enrollment" "test" {
+  enrollment_id = 1
+}
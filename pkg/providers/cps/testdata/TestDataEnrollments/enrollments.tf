@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_cps_enrollments" "test" {
+  contract_id = "ctr_1"
+}
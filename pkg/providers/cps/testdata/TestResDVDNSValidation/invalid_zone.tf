@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_cps_dv_dns_validation" "dns_validation" {
+  enrollment_id    = 1
+  zones            = ["example.com"]
+  propagation_delay = 0
+}
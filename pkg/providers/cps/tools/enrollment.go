@@ -1,11 +1,14 @@
 package tools
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
@@ -237,8 +240,10 @@ func NetworkConfigToMap(networkConfig cps.NetworkConfiguration) map[string]inter
 		mutualAuthMap := make(map[string]interface{})
 		mutualAuthMap["set_id"] = networkConfig.ClientMutualAuthentication.SetID
 		if networkConfig.ClientMutualAuthentication.AuthenticationOptions != nil {
-			mutualAuthMap["send_ca_list_to_client"] = *networkConfig.ClientMutualAuthentication.AuthenticationOptions.SendCAListToClient
-			if networkConfig.ClientMutualAuthentication.AuthenticationOptions.OCSP != nil {
+			if networkConfig.ClientMutualAuthentication.AuthenticationOptions.SendCAListToClient != nil {
+				mutualAuthMap["send_ca_list_to_client"] = *networkConfig.ClientMutualAuthentication.AuthenticationOptions.SendCAListToClient
+			}
+			if networkConfig.ClientMutualAuthentication.AuthenticationOptions.OCSP != nil && networkConfig.ClientMutualAuthentication.AuthenticationOptions.OCSP.Enabled != nil {
 				mutualAuthMap["ocsp_enabled"] = *networkConfig.ClientMutualAuthentication.AuthenticationOptions.OCSP.Enabled
 			}
 		}
@@ -272,6 +277,27 @@ func OrgToMap(org cps.Org) map[string]interface{} {
 	return orgMap
 }
 
+// DeployedCertificateToMap parses the deployed certificate in PEM format and returns a map with its issuer, serial number,
+// expiration time and the number of whole days left until the expiration at the given time
+func DeployedCertificateToMap(certificate string, keyAlgorithm string, now time.Time) (map[string]interface{}, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return nil, fmt.Errorf("deployed certificate is not in PEM format")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing deployed certificate: %s", err)
+	}
+	certificateMap := map[string]interface{}{
+		"key_algorithm":  keyAlgorithm,
+		"issuer":         cert.Issuer.String(),
+		"serial_number":  cert.SerialNumber.Text(16),
+		"not_after":      cert.NotAfter.UTC().Format(time.RFC3339),
+		"days_to_expiry": int(cert.NotAfter.Sub(now).Hours() / 24),
+	}
+	return certificateMap, nil
+}
+
 // GetChangeIDFromPendingChanges returns ChangeID of pending changes
 func GetChangeIDFromPendingChanges(pendingChanges []string) (int, error) {
 	if len(pendingChanges) < 1 {
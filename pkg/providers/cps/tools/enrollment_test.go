@@ -1,7 +1,15 @@
 package tools
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/cps"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -595,6 +603,58 @@ func TestNetworkConfigToMap(t *testing.T) {
 	}
 }
 
+func TestDeployedCertificateToMap(t *testing.T) {
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(0x1a2b),
+		Subject:      pkix.Name{CommonName: "test.akamai.com"},
+		Issuer:       pkix.Name{CommonName: "test.akamai.com"},
+		NotBefore:    now.AddDate(0, -1, 0),
+		NotAfter:     now.AddDate(0, 0, 30).Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	tests := map[string]struct {
+		given     string
+		expected  map[string]interface{}
+		withError bool
+	}{
+		"basic test": {
+			given: certificate,
+			expected: map[string]interface{}{
+				"key_algorithm":  "ECDSA",
+				"issuer":         "CN=test.akamai.com",
+				"serial_number":  "1a2b",
+				"not_after":      "2022-03-31T01:00:00Z",
+				"days_to_expiry": 30,
+			},
+		},
+		"not a PEM": {
+			given:     "certificate",
+			withError: true,
+		},
+		"invalid certificate": {
+			given:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("certificate")})),
+			withError: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := DeployedCertificateToMap(test.given, "ECDSA", now)
+			if test.withError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
 func TestGetChangeIDFromPendingChanges(t *testing.T) {
 	tests := map[string]struct {
 		givenChanges []string
@@ -0,0 +1,111 @@
+package datastream
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatastream() *schema.Resource {
+	dataSchema := tools.ComputedSchema(datastreamResourceSchema)
+	for key, attr := range tools.ComputedSchema(connectorsSchema()) {
+		dataSchema[key] = attr
+	}
+	// attributes which only control the behavior of the resource
+	delete(dataSchema, "poll_interval")
+	delete(dataSchema, "wait_for_activation")
+
+	dataSchema["stream_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "Identifies the stream",
+	}
+	dataSchema["version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Identifies the version of the stream. If omitted, the latest version is returned",
+	}
+	dataSchema["connector_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the connector associated with the stream",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDatastreamRead,
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceDatastreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Datastream", "dataSourceDatastreamRead")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	client := inst.Client(meta)
+	logger.Debug("Reading a stream")
+
+	streamID, err := tools.GetIntValue("stream_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := datastream.GetStreamRequest{
+		StreamID: int64(streamID),
+	}
+	version, err := tools.GetIntValue("version", d)
+	if err == nil {
+		req.Version = tools.Int64Ptr(int64(version))
+	}
+
+	streamDetails, err := client.GetStream(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attrs := streamToAttrs(streamDetails)
+	attrs["config"] = ConfigToSet(streamDetails.Config)
+
+	connectors, err := GetConnectorDetails(ctx, client, req, streamDetails.Connectors)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// there is no local configuration of the connector, so the secrets are left out
+	connectorKey, connectorProps, err := ConnectorToMap(connectors, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if connectorKey != "" {
+		attrs[connectorKey] = []interface{}{redactConnector(connectorKey, connectorProps)}
+		attrs["connector_type"] = connectorRegistry[connectorKey].connectorType
+	}
+
+	if err = tools.SetAttrs(d, attrs); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
+	d.SetId(strconv.Itoa(streamID))
+	return nil
+}
+
+// redactConnector removes sensitive properties from the connector map
+func redactConnector(connectorKey string, props map[string]interface{}) map[string]interface{} {
+	connectorSchema := connectorRegistry[connectorKey].schema.Schema
+	result := make(map[string]interface{}, len(props))
+	for key, value := range props {
+		if attr, ok := connectorSchema[key]; ok && !attr.Sensitive {
+			result[key] = value
+		}
+	}
+	return result
+}
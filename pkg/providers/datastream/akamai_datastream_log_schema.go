@@ -0,0 +1,259 @@
+package datastream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var delimiters = map[datastream.DelimiterType]string{
+	datastream.DelimiterTypeSpace: " ",
+}
+
+const (
+	fieldTypeString  = "string"
+	fieldTypeInteger = "integer"
+	fieldTypeFloat   = "float"
+)
+
+// fieldTypes maps the JSON keys of data set fields to the type of their values. The data set field metadata returned
+// by the API does not contain the type, so it is maintained here following the DataStream log field documentation.
+// Fields which are not listed carry string values
+var fieldTypes = map[string]string{
+	"bytes":               fieldTypeInteger,
+	"cacheStatus":         fieldTypeInteger,
+	"cacheable":           fieldTypeInteger,
+	"cp":                  fieldTypeInteger,
+	"dnsLookupTimeMSec":   fieldTypeInteger,
+	"maxAgeSec":           fieldTypeInteger,
+	"objSize":             fieldTypeInteger,
+	"overheadBytes":       fieldTypeInteger,
+	"reqEndTimeMSec":      fieldTypeInteger,
+	"reqPort":             fieldTypeInteger,
+	"reqTimeSec":          fieldTypeFloat,
+	"rspContentLen":       fieldTypeInteger,
+	"statusCode":          fieldTypeInteger,
+	"streamId":            fieldTypeInteger,
+	"tlsOverheadTimeMSec": fieldTypeInteger,
+	"totalBytes":          fieldTypeInteger,
+	"transferTimeMSec":    fieldTypeInteger,
+	"turnAroundTimeMSec":  fieldTypeInteger,
+	"uncompSize":          fieldTypeInteger,
+}
+
+// fieldType returns the type of the values of the data set field with given JSON key
+func fieldType(jsonKey string) string {
+	if fieldType, ok := fieldTypes[jsonKey]; ok {
+		return fieldType
+	}
+	return fieldTypeString
+}
+
+func dataSourceLogSchema() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLogSchemaRead,
+		Schema: map[string]*schema.Schema{
+			"dataset_fields_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Identifiers of the data set fields within the template that you want to receive in logs, in the order used in the stream",
+			},
+			"template_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     string(datastream.TemplateNameEdgeLogs),
+				Description: "The name of the data set template used in the stream configuration",
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: tools.ValidateStringInSlice([]string{
+					string(datastream.FormatTypeStructured),
+					string(datastream.FormatTypeJson),
+				}),
+				Description: "The format in which logs are received",
+			},
+			"delimiter": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: tools.ValidateStringInSlice([]string{string(datastream.DelimiterTypeSpace)}),
+				Description:      "A delimiter that separates data set fields in log lines, required for STRUCTURED format",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The data set fields in the order in which they appear in log lines",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"position": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The position of the field in a log line, starting from 0",
+						},
+						"dataset_field_description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Describes the data set field",
+						},
+						"dataset_field_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Identifies the field",
+						},
+						"dataset_field_json_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Specifies the JSON key for the field in a log line",
+						},
+						"dataset_field_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A name of the data set field",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the field values, either integer, float or string",
+						},
+					},
+				},
+			},
+			"sample_line": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A sample log line in which every field value is replaced with the field's JSON key in angle brackets",
+			},
+		},
+	}
+}
+
+func dataSourceLogSchemaRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("datastream", "dataSourceLogSchemaRead")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	logger.Debug("Reading log schema")
+	client := inst.Client(meta)
+
+	template, err := tools.GetStringValue("template_name", rd)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	format, err := tools.GetStringValue("format", rd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	delimiter, err := tools.GetStringValue("delimiter", rd)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if datastream.FormatType(format) == datastream.FormatTypeStructured && delimiter == "" {
+		return diag.Errorf("delimiter is required for %s format", datastream.FormatTypeStructured)
+	}
+	if datastream.FormatType(format) == datastream.FormatTypeJson && delimiter != "" {
+		return diag.Errorf("delimiter cannot be used with %s format", datastream.FormatTypeJson)
+	}
+	fieldIDs, err := tools.GetListValue("dataset_fields_ids", rd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSets, err := client.GetDatasetFields(ctx, datastream.GetDatasetFieldsRequest{
+		TemplateName: datastream.TemplateName(template),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	datasetFields, err := orderDatasetFields(dataSets, fieldIDs, template)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fields := parseDatasetFields(datasetFields)
+	for i, field := range fields {
+		field["position"] = i
+		field["type"] = fieldType(datasetFields[i].DatasetFieldJsonKey)
+	}
+
+	sampleLine, err := sampleLogLine(datasetFields, datastream.FormatType(format), datastream.DelimiterType(delimiter))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := rd.Set("fields", fields); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := rd.Set("sample_line", sampleLine); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
+	// ignoring the GetMd5Sum error, because `fields` is already initialized
+	md5Sum, _ := tools.GetMd5Sum(fmt.Sprintf("%v%s%s", fields, format, delimiter))
+	rd.SetId(md5Sum)
+
+	return nil
+}
+
+// orderDatasetFields returns the data set fields of the template with given IDs, in the order of the IDs
+func orderDatasetFields(dataSets []datastream.DataSets, fieldIDs []interface{}, template string) ([]datastream.DatasetFields, error) {
+	fieldsByID := make(map[int]datastream.DatasetFields)
+	for _, dataSet := range dataSets {
+		for _, field := range dataSet.DatasetFields {
+			fieldsByID[field.DatasetFieldID] = field
+		}
+	}
+
+	result := make([]datastream.DatasetFields, 0, len(fieldIDs))
+	for _, id := range fieldIDs {
+		field, ok := fieldsByID[id.(int)]
+		if !ok {
+			return nil, fmt.Errorf("dataset field %d is not available in template %s", id.(int), template)
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// sampleLogLine generates a log line with the given fields, using the field's JSON key in angle brackets as a value
+func sampleLogLine(fields []datastream.DatasetFields, format datastream.FormatType, delimiter datastream.DelimiterType) (string, error) {
+	if format == datastream.FormatTypeJson {
+		entries := make([]string, 0, len(fields))
+		for _, field := range fields {
+			key, err := json.Marshal(field.DatasetFieldJsonKey)
+			if err != nil {
+				return "", err
+			}
+			value, err := json.Marshal(fmt.Sprintf("<%s>", field.DatasetFieldJsonKey))
+			if err != nil {
+				return "", err
+			}
+			entries = append(entries, fmt.Sprintf("%s:%s", key, value))
+		}
+		return fmt.Sprintf("{%s}", strings.Join(entries, ",")), nil
+	}
+
+	separator, ok := delimiters[delimiter]
+	if !ok {
+		return "", fmt.Errorf("unsupported delimiter: %s", delimiter)
+	}
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		values = append(values, fmt.Sprintf("<%s>", field.DatasetFieldJsonKey))
+	}
+	return strings.Join(values, separator), nil
+}
@@ -0,0 +1,116 @@
+package datastream
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceLogSchemaRead(t *testing.T) {
+	dataSets := []datastream.DataSets{
+		{
+			DatasetGroupName: "Log information",
+			DatasetFields: []datastream.DatasetFields{
+				{
+					DatasetFieldID:          1000,
+					DatasetFieldName:        "CP code",
+					DatasetFieldDescription: "The Content Provider code associated with the request.",
+					DatasetFieldJsonKey:     "cp",
+				},
+				{
+					DatasetFieldID:          1002,
+					DatasetFieldName:        "Request ID",
+					DatasetFieldDescription: "The identifier of the request.",
+					DatasetFieldJsonKey:     "reqId",
+				},
+			},
+		},
+		{
+			DatasetGroupName: "Message exchange data",
+			DatasetFields: []datastream.DatasetFields{
+				{
+					DatasetFieldID:          1006,
+					DatasetFieldName:        "Client IP",
+					DatasetFieldDescription: "The IP address of the client.",
+					DatasetFieldJsonKey:     "cliIP",
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		configPath string
+		init       func(*mockdatastream)
+		checks     []resource.TestCheckFunc
+		withError  *regexp.Regexp
+	}{
+		"structured format keeps the order of field ids": {
+			configPath: "testdata/TestDataSourceLogSchemaRead/structured.tf",
+			init: func(m *mockdatastream) {
+				m.On("GetDatasetFields", mock.Anything, datastream.GetDatasetFieldsRequest{
+					TemplateName: datastream.TemplateNameEdgeLogs,
+				}).Return(dataSets, nil)
+			},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.#", "3"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.0.position", "0"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.0.dataset_field_id", "1006"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.0.dataset_field_name", "Client IP"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.0.type", "string"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.1.dataset_field_json_key", "cp"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.1.type", "integer"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.2.position", "2"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.2.dataset_field_id", "1002"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "sample_line", "<cliIP> <cp> <reqId>"),
+			},
+		},
+		"json format": {
+			configPath: "testdata/TestDataSourceLogSchemaRead/json.tf",
+			init: func(m *mockdatastream) {
+				m.On("GetDatasetFields", mock.Anything, datastream.GetDatasetFieldsRequest{
+					TemplateName: datastream.TemplateNameEdgeLogs,
+				}).Return(dataSets, nil)
+			},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "fields.#", "2"),
+				resource.TestCheckResourceAttr("data.akamai_datastream_log_schema.test", "sample_line", `{"cp":"<cp>","cliIP":"<cliIP>"}`),
+			},
+		},
+		"structured format without delimiter": {
+			configPath: "testdata/TestDataSourceLogSchemaRead/structured_no_delimiter.tf",
+			init:       func(m *mockdatastream) {},
+			withError:  regexp.MustCompile("delimiter is required for STRUCTURED format"),
+		},
+		"field not available in template": {
+			configPath: "testdata/TestDataSourceLogSchemaRead/unknown_field.tf",
+			init: func(m *mockdatastream) {
+				m.On("GetDatasetFields", mock.Anything, datastream.GetDatasetFieldsRequest{
+					TemplateName: datastream.TemplateNameEdgeLogs,
+				}).Return(dataSets, nil)
+			},
+			withError: regexp.MustCompile("dataset field 9999 is not available in template EDGE_LOGS"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockdatastream{}
+			test.init(client)
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:      loadFixtureString(test.configPath),
+							Check:       resource.ComposeAggregateTestCheckFunc(test.checks...),
+							ExpectError: test.withError,
+						},
+					},
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
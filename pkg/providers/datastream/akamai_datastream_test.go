@@ -0,0 +1,110 @@
+package datastream
+
+import (
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceDatastreamRead(t *testing.T) {
+	stream := &datastream.DetailedStreamVersion{
+		ActivationStatus: datastream.ActivationStatusActivated,
+		Config: datastream.Config{
+			Delimiter: datastream.DelimiterTypePtr(datastream.DelimiterTypeSpace),
+			Format:    datastream.FormatTypeStructured,
+			Frequency: datastream.Frequency{
+				TimeInSec: datastream.TimeInSec30,
+			},
+			UploadFilePrefix: "pre",
+			UploadFileSuffix: "suf",
+		},
+		Connectors: []datastream.ConnectorDetails{
+			{
+				Bucket:        "s3_test_bucket",
+				ConnectorID:   1,
+				ConnectorType: datastream.ConnectorTypeS3,
+				ConnectorName: "s3_test_connector_name",
+				Path:          "s3_test_path",
+				Region:        "s3_test_region",
+			},
+		},
+		ContractID: "test_contract",
+		CreatedBy:  "johndoe",
+		Datasets: []datastream.DataSets{
+			{
+				DatasetFields: []datastream.DatasetFields{
+					{DatasetFieldID: 1001, Order: 1},
+					{DatasetFieldID: 1002, Order: 0},
+				},
+			},
+		},
+		EmailIDs:        "test_email1@akamai.com,test_email2@akamai.com",
+		GroupID:         1337,
+		GroupName:       "test_group",
+		Properties:      []datastream.Property{{PropertyID: 1}, {PropertyID: 2}},
+		StreamID:        7050,
+		StreamName:      "test_stream",
+		StreamType:      datastream.StreamTypeRawLogs,
+		StreamVersionID: 2,
+		TemplateName:    datastream.TemplateNameEdgeLogs,
+	}
+
+	tests := map[string]struct {
+		configPath string
+		request    datastream.GetStreamRequest
+	}{
+		"latest version": {
+			configPath: "testdata/TestDataSourceDatastreamRead/stream.tf",
+			request:    datastream.GetStreamRequest{StreamID: 7050},
+		},
+		"given version": {
+			configPath: "testdata/TestDataSourceDatastreamRead/stream_version.tf",
+			request:    datastream.GetStreamRequest{StreamID: 7050, Version: tools.Int64Ptr(2)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockdatastream{}
+			client.On("GetStream", mock.Anything, test.request).Return(stream, nil)
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString(test.configPath),
+							Check: resource.ComposeTestCheckFunc(
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "id", "7050"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "active", "true"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "activation_status", "ACTIVATED"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "config.#", "1"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "config.0.upload_file_prefix", "pre"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "contract_id", "test_contract"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "dataset_fields_ids.#", "2"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "dataset_fields_ids.0", "1002"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "email_ids.#", "2"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "group_id", "1337"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "property_ids.#", "2"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "stream_version_id", "2"),
+								resource.TestCheckResourceAttrSet("data.akamai_datastream.test", "papi_json"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "connector_type", "S3"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "s3_connector.#", "1"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "s3_connector.0.bucket", "s3_test_bucket"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "s3_connector.0.connector_id", "1"),
+								resource.TestCheckNoResourceAttr("data.akamai_datastream.test", "s3_connector.0.access_key"),
+								resource.TestCheckNoResourceAttr("data.akamai_datastream.test", "s3_connector.0.secret_access_key"),
+								resource.TestCheckResourceAttr("data.akamai_datastream.test", "azure_connector.#", "0"),
+							),
+						},
+					},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package datastream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatastreams() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatastreamsRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Returns only the streams of the group",
+			},
+			"contract_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only the streams of the contract",
+			},
+			"property_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Returns only the streams which collect logs of the property",
+			},
+			"streams": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The latest versions of the streams matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stream_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Identifies the stream",
+						},
+						"stream_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the stream",
+						},
+						"stream_version_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Identifies the latest version of the stream",
+						},
+						"stream_type_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the stream",
+						},
+						"group_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Identifies the group the stream belongs to",
+						},
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the group the stream belongs to",
+						},
+						"contract_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifies the contract the stream belongs to",
+						},
+						"property_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Identifies the properties the stream collects logs of",
+						},
+						"activation_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The activation status of the stream",
+						},
+						"connectors": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connectors the stream sends logs to",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The username who created the stream",
+						},
+						"created_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time when the stream was created",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatastreamsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Datastream", "dataSourceDatastreamsRead")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	client := inst.Client(meta)
+	logger.Debug("Listing streams")
+
+	req := ListStreamsRequest{}
+	groupID, err := tools.GetIntValue("group_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		req.GroupID = &groupID
+	}
+	contractID, err := tools.GetStringValue("contract_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	contractID = strings.TrimPrefix(contractID, "ctr_")
+	propertyID, err := tools.GetIntValue("property_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	streams, err := client.ListStreams(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]interface{}, 0, len(streams))
+	for _, stream := range streams {
+		if contractID != "" && stream.ContractID != contractID {
+			continue
+		}
+		if propertyID != 0 && !streamHasProperty(stream, propertyID) {
+			continue
+		}
+		propertyIDs := make([]int, 0, len(stream.Properties))
+		for _, property := range stream.Properties {
+			propertyIDs = append(propertyIDs, property.PropertyID)
+		}
+		result = append(result, map[string]interface{}{
+			"stream_id":         stream.StreamID,
+			"stream_name":       stream.StreamName,
+			"stream_version_id": stream.StreamVersionID,
+			"stream_type_name":  stream.StreamTypeName,
+			"group_id":          stream.GroupID,
+			"group_name":        stream.GroupName,
+			"contract_id":       stream.ContractID,
+			"property_ids":      propertyIDs,
+			"activation_status": string(stream.ActivationStatus),
+			"connectors":        stream.Connectors,
+			"created_by":        stream.CreatedBy,
+			"created_date":      stream.CreatedDate,
+		})
+	}
+
+	if err := d.Set("streams", result); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
+	// ignoring the GetMd5Sum error, because the filters are already initialized
+	id, _ := tools.GetMd5Sum(fmt.Sprintf("%d:%s:%d", groupID, contractID, propertyID))
+	d.SetId(id)
+
+	return nil
+}
+
+// streamHasProperty returns true if the stream collects logs of the property with given ID
+func streamHasProperty(stream StreamDetails, propertyID int) bool {
+	for _, property := range stream.Properties {
+		if property.PropertyID == propertyID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package datastream
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceDatastreamsRead(t *testing.T) {
+	streams := []StreamDetails{
+		{
+			ActivationStatus: datastream.ActivationStatusActivated,
+			Connectors:       "S3-S1",
+			ContractID:       "contract_1",
+			CreatedBy:        "johndoe",
+			CreatedDate:      "10-07-2020 12:19:02 GMT",
+			GroupID:          1337,
+			GroupName:        "test_group",
+			Properties:       []datastream.Property{{PropertyID: 1}, {PropertyID: 2}},
+			StreamID:         7050,
+			StreamName:       "test_stream_1",
+			StreamTypeName:   "Logs - Raw",
+			StreamVersionID:  2,
+		},
+		{
+			ActivationStatus: datastream.ActivationStatusInactive,
+			Connectors:       "SPLUNK-S1",
+			ContractID:       "contract_2",
+			GroupID:          1337,
+			GroupName:        "test_group",
+			Properties:       []datastream.Property{{PropertyID: 3}},
+			StreamID:         7051,
+			StreamName:       "test_stream_2",
+			StreamTypeName:   "Logs - Raw",
+			StreamVersionID:  1,
+		},
+	}
+
+	tests := map[string]struct {
+		configPath string
+		request    ListStreamsRequest
+		checks     []resource.TestCheckFunc
+	}{
+		"by group": {
+			configPath: "testdata/TestDataSourceDatastreamsRead/group.tf",
+			request:    ListStreamsRequest{GroupID: tools.IntPtr(1337)},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.#", "2"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.stream_id", "7050"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.stream_name", "test_stream_1"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.stream_version_id", "2"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.group_name", "test_group"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.contract_id", "contract_1"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.property_ids.#", "2"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.property_ids.1", "2"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.activation_status", "ACTIVATED"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.connectors", "S3-S1"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.1.stream_id", "7051"),
+			},
+		},
+		"by contract": {
+			configPath: "testdata/TestDataSourceDatastreamsRead/contract.tf",
+			request:    ListStreamsRequest{},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.stream_id", "7051"),
+			},
+		},
+		"by property": {
+			configPath: "testdata/TestDataSourceDatastreamsRead/property.tf",
+			request:    ListStreamsRequest{GroupID: tools.IntPtr(1337)},
+			checks: []resource.TestCheckFunc{
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.#", "1"),
+				resource.TestCheckResourceAttr("data.akamai_datastreams.test", "streams.0.stream_id", "7050"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockdatastream{}
+			client.On("ListStreams", mock.Anything, test.request).Return(streams, nil)
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString(test.configPath),
+							Check:  resource.ComposeTestCheckFunc(test.checks...),
+						},
+					},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDataSourceDatastreamsReadError(t *testing.T) {
+	client := &mockdatastream{}
+	client.On("ListStreams", mock.Anything, ListStreamsRequest{GroupID: tools.IntPtr(1337)}).
+		Return(nil, &datastream.Error{Title: "Forbidden", StatusCode: 403})
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config:      loadFixtureString("testdata/TestDataSourceDatastreamsRead/group.tf"),
+					ExpectError: regexp.MustCompile("Forbidden"),
+				},
+			},
+		})
+	})
+
+	client.AssertExpectations(t)
+}
@@ -0,0 +1,150 @@
+package datastream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+)
+
+type (
+	// DS is the DataStream API interface used by the provider. It extends the edgegrid client
+	// with requests for the details which the client does not decode yet
+	DS interface {
+		datastream.DS
+
+		// GetStreamConnectors returns the connectors of the stream, including the settings of the connectors defined by the provider
+		GetStreamConnectors(context.Context, datastream.GetStreamRequest) ([]ConnectorDetails, error)
+
+		// ListStreams returns the latest versions of the streams, optionally only the ones in given group
+		ListStreams(context.Context, ListStreamsRequest) ([]StreamDetails, error)
+	}
+
+	// ListStreamsRequest contains parameters necessary to send a ListStreams request
+	ListStreamsRequest struct {
+		GroupID *int
+	}
+
+	// StreamDetails contains the summary of a stream returned by ListStreams
+	StreamDetails struct {
+		ActivationStatus datastream.ActivationStatus `json:"activationStatus"`
+		Archived         bool                        `json:"archived"`
+		Connectors       string                      `json:"connectors"`
+		ContractID       string                      `json:"contractId"`
+		CreatedBy        string                      `json:"createdBy"`
+		CreatedDate      string                      `json:"createdDate"`
+		CurrentVersionID int64                       `json:"currentVersionId"`
+		Errors           []datastream.Errors         `json:"errors"`
+		GroupID          int                         `json:"groupId"`
+		GroupName        string                      `json:"groupName"`
+		Properties       []datastream.Property       `json:"properties"`
+		StreamID         int64                       `json:"streamId"`
+		StreamName       string                      `json:"streamName"`
+		StreamTypeName   string                      `json:"streamTypeName"`
+		StreamVersionID  int64                       `json:"streamVersionId"`
+	}
+
+	ds struct {
+		datastream.DS
+		session session.Session
+	}
+)
+
+// ErrListStreams is returned when ListStreams fails
+var ErrListStreams = errors.New("list streams")
+
+// Client returns a new DS instance with the specified session
+func Client(sess session.Session) DS {
+	return &ds{
+		DS:      datastream.Client(sess),
+		session: sess,
+	}
+}
+
+func (d *ds) GetStreamConnectors(ctx context.Context, params datastream.GetStreamRequest) ([]ConnectorDetails, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", datastream.ErrGetStream, datastream.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf("/datastream-config-api/v1/log/streams/%d", params.StreamID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", datastream.ErrGetStream, err)
+	}
+	if params.Version != nil {
+		query := uri.Query()
+		query.Add("version", strconv.FormatInt(*params.Version, 10))
+		uri.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", datastream.ErrGetStream, err)
+	}
+
+	var rval struct {
+		Connectors []ConnectorDetails `json:"connectors"`
+	}
+	resp, err := d.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", datastream.ErrGetStream, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", datastream.ErrGetStream, responseError(resp))
+	}
+
+	return rval.Connectors, nil
+}
+
+func (d *ds) ListStreams(ctx context.Context, params ListStreamsRequest) ([]StreamDetails, error) {
+	uri, err := url.Parse("/datastream-config-api/v1/log/streams")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListStreams, err)
+	}
+	if params.GroupID != nil {
+		query := uri.Query()
+		query.Add("groupId", strconv.Itoa(*params.GroupID))
+		uri.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListStreams, err)
+	}
+
+	var rval []StreamDetails
+	resp, err := d.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListStreams, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListStreams, responseError(resp))
+	}
+
+	return rval, nil
+}
+
+// responseError parses the DataStream API error from the response
+func responseError(r *http.Response) error {
+	e := datastream.Error{StatusCode: r.StatusCode}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		e.Title = "Failed to unmarshal error body"
+		e.Detail = err.Error()
+	}
+	e.StatusCode = r.StatusCode
+	return &e
+}
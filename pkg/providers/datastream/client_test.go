@@ -0,0 +1,232 @@
+package datastream
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockAPIClient(t *testing.T, mockServer *httptest.Server) DS {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+
+	s, err := session.New(
+		session.WithClient(httpClient),
+		session.WithSigner(&edgegrid.Config{Host: serverURL.Host}),
+	)
+	require.NoError(t, err)
+	return Client(s)
+}
+
+func TestGetStreamConnectors(t *testing.T) {
+	tests := map[string]struct {
+		params           datastream.GetStreamRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse []ConnectorDetails
+		withError        error
+	}{
+		"200 OK": {
+			params:         datastream.GetStreamRequest{StreamID: 1, Version: tools.Int64Ptr(2)},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "streamId": 1,
+    "connectors": [
+        {
+            "connectorType": "ELASTICSEARCH",
+            "connectorId": 3,
+            "connectorName": "elastic connector",
+            "endpoint": "https://elastic.example.com/_bulk",
+            "indexName": "logs",
+            "tlsHostname": "elastic.example.com",
+            "caCert": "ca",
+            "contentType": "application/json",
+            "customHeaderName": "X-Header",
+            "customHeaderValue": "value"
+        }
+    ]
+}`,
+			expectedPath: "/datastream-config-api/v1/log/streams/1?version=2",
+			expectedResponse: []ConnectorDetails{
+				{
+					ConnectorDetails: datastream.ConnectorDetails{
+						ConnectorID:   3,
+						ConnectorName: "elastic connector",
+						ConnectorType: ConnectorTypeElasticsearch,
+						Endpoint:      "https://elastic.example.com/_bulk",
+					},
+					CACert:            "ca",
+					ContentType:       "application/json",
+					CustomHeaderName:  "X-Header",
+					CustomHeaderValue: "value",
+					IndexName:         "logs",
+					TLSHostname:       "elastic.example.com",
+				},
+			},
+		},
+		"404 Not Found": {
+			params:         datastream.GetStreamRequest{StreamID: 1},
+			responseStatus: http.StatusNotFound,
+			responseBody: `{
+    "type": "not-found",
+    "title": "Not Found",
+    "detail": "Stream does not exist"
+}`,
+			expectedPath: "/datastream-config-api/v1/log/streams/1",
+			withError: &datastream.Error{
+				Type:       "not-found",
+				Title:      "Not Found",
+				Detail:     "Stream does not exist",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		"validation error": {
+			params:    datastream.GetStreamRequest{},
+			withError: datastream.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetStreamConnectors(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestListStreams(t *testing.T) {
+	tests := map[string]struct {
+		params           ListStreamsRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse []StreamDetails
+		withError        error
+	}{
+		"200 OK": {
+			params:         ListStreamsRequest{GroupID: tools.IntPtr(1337)},
+			responseStatus: http.StatusOK,
+			responseBody: `[
+    {
+        "streamId": 7050,
+        "streamName": "test stream",
+        "streamVersionId": 2,
+        "currentVersionId": 2,
+        "streamTypeName": "Logs - Raw",
+        "groupId": 1337,
+        "groupName": "test group",
+        "contractId": "test_contract",
+        "activationStatus": "ACTIVATED",
+        "archived": false,
+        "connectors": "S3-S1",
+        "createdBy": "johndoe",
+        "createdDate": "10-07-2020 12:19:02 GMT",
+        "properties": [
+            {
+                "propertyId": 1,
+                "propertyName": "property_1"
+            }
+        ],
+        "errors": []
+    }
+]`,
+			expectedPath: "/datastream-config-api/v1/log/streams?groupId=1337",
+			expectedResponse: []StreamDetails{
+				{
+					ActivationStatus: datastream.ActivationStatusActivated,
+					Connectors:       "S3-S1",
+					ContractID:       "test_contract",
+					CreatedBy:        "johndoe",
+					CreatedDate:      "10-07-2020 12:19:02 GMT",
+					CurrentVersionID: 2,
+					Errors:           []datastream.Errors{},
+					GroupID:          1337,
+					GroupName:        "test group",
+					Properties:       []datastream.Property{{PropertyID: 1, PropertyName: "property_1"}},
+					StreamID:         7050,
+					StreamName:       "test stream",
+					StreamTypeName:   "Logs - Raw",
+					StreamVersionID:  2,
+				},
+			},
+		},
+		"all groups": {
+			params:           ListStreamsRequest{},
+			responseStatus:   http.StatusOK,
+			responseBody:     `[]`,
+			expectedPath:     "/datastream-config-api/v1/log/streams",
+			expectedResponse: []StreamDetails{},
+		},
+		"403 Forbidden": {
+			params:         ListStreamsRequest{GroupID: tools.IntPtr(1337)},
+			responseStatus: http.StatusForbidden,
+			responseBody: `{
+    "type": "forbidden",
+    "title": "Forbidden",
+    "detail": "No access to the group"
+}`,
+			expectedPath: "/datastream-config-api/v1/log/streams?groupId=1337",
+			withError: &datastream.Error{
+				Type:       "forbidden",
+				Title:      "Forbidden",
+				Detail:     "No access to the group",
+				StatusCode: http.StatusForbidden,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.ListStreams(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
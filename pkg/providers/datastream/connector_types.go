@@ -0,0 +1,183 @@
+package datastream
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// The connectors below are not (yet) provided by the edgegrid client, so the provider defines their
+// request payloads itself. They implement datastream.AbstractConnector, which is all the client needs
+// to send them as a part of the stream configuration.
+
+const (
+	// ConnectorTypeElasticsearch const
+	ConnectorTypeElasticsearch datastream.ConnectorType = "ELASTICSEARCH"
+	// ConnectorTypeNewRelic const
+	ConnectorTypeNewRelic datastream.ConnectorType = "NEW_RELIC"
+	// ConnectorTypeLoggly const
+	ConnectorTypeLoggly datastream.ConnectorType = "LOGGLY"
+	// ConnectorTypeS3Compatible const
+	ConnectorTypeS3Compatible datastream.ConnectorType = "S3_COMPATIBLE"
+)
+
+type (
+	// ConnectorDetails extends the client's ConnectorDetails with the settings of the connectors defined by the provider
+	ConnectorDetails struct {
+		datastream.ConnectorDetails
+		CACert            string `json:"caCert"`
+		ContentType       string `json:"contentType"`
+		CustomHeaderName  string `json:"customHeaderName"`
+		CustomHeaderValue string `json:"customHeaderValue"`
+		IndexName         string `json:"indexName"`
+		TLSHostname       string `json:"tlsHostname"`
+	}
+
+	// HTTPSConnector extends the client's CustomHTTPSConnector with custom header and mTLS settings
+	HTTPSConnector struct {
+		datastream.CustomHTTPSConnector
+		ContentType       string `json:"contentType,omitempty"`
+		CustomHeaderName  string `json:"customHeaderName,omitempty"`
+		CustomHeaderValue string `json:"customHeaderValue,omitempty"`
+		TLSHostname       string `json:"tlsHostname,omitempty"`
+		CACert            string `json:"caCert,omitempty"`
+		ClientCert        string `json:"clientCert,omitempty"`
+		ClientKey         string `json:"clientKey,omitempty"`
+	}
+
+	// ElasticsearchConnector provides details about the Elasticsearch connector in a stream
+	ElasticsearchConnector struct {
+		ConnectorType     datastream.ConnectorType `json:"connectorType"`
+		ConnectorName     string                   `json:"connectorName"`
+		Endpoint          string                   `json:"endpoint"`
+		IndexName         string                   `json:"indexName"`
+		UserName          string                   `json:"userName"`
+		Password          string                   `json:"password"`
+		ContentType       string                   `json:"contentType,omitempty"`
+		CustomHeaderName  string                   `json:"customHeaderName,omitempty"`
+		CustomHeaderValue string                   `json:"customHeaderValue,omitempty"`
+		TLSHostname       string                   `json:"tlsHostname,omitempty"`
+		CACert            string                   `json:"caCert,omitempty"`
+		ClientCert        string                   `json:"clientCert,omitempty"`
+		ClientKey         string                   `json:"clientKey,omitempty"`
+	}
+
+	// NewRelicConnector provides details about the New Relic connector in a stream
+	NewRelicConnector struct {
+		ConnectorType     datastream.ConnectorType `json:"connectorType"`
+		ConnectorName     string                   `json:"connectorName"`
+		Endpoint          string                   `json:"endpoint"`
+		AuthToken         string                   `json:"authToken"`
+		ContentType       string                   `json:"contentType,omitempty"`
+		CustomHeaderName  string                   `json:"customHeaderName,omitempty"`
+		CustomHeaderValue string                   `json:"customHeaderValue,omitempty"`
+	}
+
+	// LogglyConnector provides details about the Loggly connector in a stream
+	LogglyConnector struct {
+		ConnectorType     datastream.ConnectorType `json:"connectorType"`
+		ConnectorName     string                   `json:"connectorName"`
+		Endpoint          string                   `json:"endpoint"`
+		AuthToken         string                   `json:"authToken"`
+		Tags              string                   `json:"tags,omitempty"`
+		ContentType       string                   `json:"contentType,omitempty"`
+		CustomHeaderName  string                   `json:"customHeaderName,omitempty"`
+		CustomHeaderValue string                   `json:"customHeaderValue,omitempty"`
+	}
+
+	// S3CompatibleConnector provides details about a connector of a storage exposing the Amazon S3 API
+	S3CompatibleConnector struct {
+		ConnectorType   datastream.ConnectorType `json:"connectorType"`
+		ConnectorName   string                   `json:"connectorName"`
+		Endpoint        string                   `json:"endpoint"`
+		Bucket          string                   `json:"bucket"`
+		Region          string                   `json:"region"`
+		Path            string                   `json:"path"`
+		AccessKey       string                   `json:"accessKey"`
+		SecretAccessKey string                   `json:"secretAccessKey"`
+	}
+)
+
+// Validate validates HTTPSConnector
+func (c *HTTPSConnector) Validate() error {
+	if err := c.CustomHTTPSConnector.Validate(); err != nil {
+		return err
+	}
+	return validateClientCertificate(c.ClientCert, c.ClientKey)
+}
+
+// SetConnectorType for ElasticsearchConnector
+func (c *ElasticsearchConnector) SetConnectorType() {
+	c.ConnectorType = ConnectorTypeElasticsearch
+}
+
+// Validate validates ElasticsearchConnector
+func (c *ElasticsearchConnector) Validate() error {
+	if err := (validation.Errors{
+		"ConnectorType": validation.Validate(c.ConnectorType, validation.Required, validation.In(ConnectorTypeElasticsearch)),
+		"ConnectorName": validation.Validate(c.ConnectorName, validation.Required),
+		"Endpoint":      validation.Validate(c.Endpoint, validation.Required),
+		"IndexName":     validation.Validate(c.IndexName, validation.Required),
+		"UserName":      validation.Validate(c.UserName, validation.Required),
+		"Password":      validation.Validate(c.Password, validation.Required),
+	}.Filter()); err != nil {
+		return err
+	}
+	return validateClientCertificate(c.ClientCert, c.ClientKey)
+}
+
+// SetConnectorType for NewRelicConnector
+func (c *NewRelicConnector) SetConnectorType() {
+	c.ConnectorType = ConnectorTypeNewRelic
+}
+
+// Validate validates NewRelicConnector
+func (c *NewRelicConnector) Validate() error {
+	return validation.Errors{
+		"ConnectorType": validation.Validate(c.ConnectorType, validation.Required, validation.In(ConnectorTypeNewRelic)),
+		"ConnectorName": validation.Validate(c.ConnectorName, validation.Required),
+		"Endpoint":      validation.Validate(c.Endpoint, validation.Required),
+		"AuthToken":     validation.Validate(c.AuthToken, validation.Required),
+	}.Filter()
+}
+
+// SetConnectorType for LogglyConnector
+func (c *LogglyConnector) SetConnectorType() {
+	c.ConnectorType = ConnectorTypeLoggly
+}
+
+// Validate validates LogglyConnector
+func (c *LogglyConnector) Validate() error {
+	return validation.Errors{
+		"ConnectorType": validation.Validate(c.ConnectorType, validation.Required, validation.In(ConnectorTypeLoggly)),
+		"ConnectorName": validation.Validate(c.ConnectorName, validation.Required),
+		"Endpoint":      validation.Validate(c.Endpoint, validation.Required),
+		"AuthToken":     validation.Validate(c.AuthToken, validation.Required),
+	}.Filter()
+}
+
+// SetConnectorType for S3CompatibleConnector
+func (c *S3CompatibleConnector) SetConnectorType() {
+	c.ConnectorType = ConnectorTypeS3Compatible
+}
+
+// Validate validates S3CompatibleConnector
+func (c *S3CompatibleConnector) Validate() error {
+	return validation.Errors{
+		"ConnectorType":   validation.Validate(c.ConnectorType, validation.Required, validation.In(ConnectorTypeS3Compatible)),
+		"ConnectorName":   validation.Validate(c.ConnectorName, validation.Required),
+		"Endpoint":        validation.Validate(c.Endpoint, validation.Required),
+		"Bucket":          validation.Validate(c.Bucket, validation.Required),
+		"Region":          validation.Validate(c.Region, validation.Required),
+		"Path":            validation.Validate(c.Path, validation.Required),
+		"AccessKey":       validation.Validate(c.AccessKey, validation.Required),
+		"SecretAccessKey": validation.Validate(c.SecretAccessKey, validation.Required),
+	}.Filter()
+}
+
+// validateClientCertificate checks that client certificate and key used for mTLS are either both provided or both omitted
+func validateClientCertificate(cert, key string) error {
+	return validation.Errors{
+		"ClientCert": validation.Validate(cert, validation.When(key != "", validation.Required.Error("is required when ClientKey is provided"))),
+		"ClientKey":  validation.Validate(key, validation.When(cert != "", validation.Required.Error("is required when ClientCert is provided"))),
+	}.Filter()
+}
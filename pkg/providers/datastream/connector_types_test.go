@@ -0,0 +1,84 @@
+package datastream
+
+import (
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectorTypesValidate(t *testing.T) {
+	tests := map[string]struct {
+		connector datastream.AbstractConnector
+		withError string
+	}{
+		"valid https connector with client certificate": {
+			connector: &HTTPSConnector{
+				CustomHTTPSConnector: datastream.CustomHTTPSConnector{
+					AuthenticationType: datastream.AuthenticationTypeNone,
+					ConnectorName:      "https connector",
+					URL:                "https://example.com",
+				},
+				ClientCert: "cert",
+				ClientKey:  "key",
+			},
+		},
+		"https connector with client certificate but no key": {
+			connector: &HTTPSConnector{
+				CustomHTTPSConnector: datastream.CustomHTTPSConnector{
+					AuthenticationType: datastream.AuthenticationTypeNone,
+					ConnectorName:      "https connector",
+					URL:                "https://example.com",
+				},
+				ClientCert: "cert",
+			},
+			withError: "ClientKey: is required when ClientCert is provided",
+		},
+		"elasticsearch connector without index name": {
+			connector: &ElasticsearchConnector{
+				ConnectorName: "elastic connector",
+				Endpoint:      "https://elastic.example.com/_bulk",
+				Password:      "pass",
+				UserName:      "user",
+			},
+			withError: "IndexName: cannot be blank",
+		},
+		"valid new relic connector": {
+			connector: &NewRelicConnector{
+				AuthToken:     "token",
+				ConnectorName: "new relic connector",
+				Endpoint:      "https://log-api.newrelic.com/log/v1",
+			},
+		},
+		"loggly connector without auth token": {
+			connector: &LogglyConnector{
+				ConnectorName: "loggly connector",
+				Endpoint:      "https://logs-01.loggly.com/bulk",
+			},
+			withError: "AuthToken: cannot be blank",
+		},
+		"valid s3 compatible connector": {
+			connector: &S3CompatibleConnector{
+				AccessKey:       "access",
+				Bucket:          "bucket",
+				ConnectorName:   "s3 compatible connector",
+				Endpoint:        "https://storage.example.com",
+				Path:            "logs",
+				Region:          "eu-1",
+				SecretAccessKey: "secret",
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.connector.SetConnectorType()
+			err := test.connector.Validate()
+			if test.withError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -1,52 +1,872 @@
 package datastream
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var (
-	// connectorTypeToResourceName maps ConnectorType to TF resource key
-	connectorTypeToResourceName = map[datastream.ConnectorType]string{
-		datastream.ConnectorTypeAzure:     "azure_connector",
-		datastream.ConnectorTypeDataDog:   "datadog_connector",
-		datastream.ConnectorTypeGcs:       "gcs_connector",
-		datastream.ConnectorTypeHTTPS:     "https_connector",
-		datastream.ConnectorTypeOracle:    "oracle_connector",
-		datastream.ConnectorTypeS3:        "s3_connector",
-		datastream.ConnectorTypeSplunk:    "splunk_connector",
-		datastream.ConnectorTypeSumoLogic: "sumologic_connector",
-	}
-
-	connectorMappers = map[datastream.ConnectorType]func(datastream.ConnectorDetails, map[string]interface{}) map[string]interface{}{
-		datastream.ConnectorTypeAzure:     MapAzureConnector,
-		datastream.ConnectorTypeDataDog:   MapDatadogConnector,
-		datastream.ConnectorTypeGcs:       MapGCSConnector,
-		datastream.ConnectorTypeHTTPS:     MapHTTPSConnector,
-		datastream.ConnectorTypeOracle:    MapOracleConnector,
-		datastream.ConnectorTypeS3:        MapS3Connector,
-		datastream.ConnectorTypeSplunk:    MapSplunkConnector,
-		datastream.ConnectorTypeSumoLogic: MapSumoLogicConnector,
-	}
-
-	connectorGetters = map[string]func(map[string]interface{}) datastream.AbstractConnector{
-		"azure_connector":     GetAzureConnector,
-		"datadog_connector":   GetDatadogConnector,
-		"gcs_connector":       GetGCSConnector,
-		"https_connector":     GetHTTPSConnector,
-		"oracle_connector":    GetOracleConnector,
-		"s3_connector":        GetS3Connector,
-		"splunk_connector":    GetSplunkConnector,
-		"sumologic_connector": GetSumoLogicConnector,
+// connectorDefinition describes a stream destination: its block in the resource schema
+// and the functions translating the block from and to the API representation
+type connectorDefinition struct {
+	connectorType datastream.ConnectorType
+	// filenameOptions tells whether upload_file_prefix and upload_file_suffix can be configured for the connector
+	filenameOptions bool
+	// urlKey is the name of the endpoint attribute for which trailing slashes are ignored
+	urlKey string
+	// extendedDetails tells whether the API returns settings of the connector which the edgegrid client does not decode
+	extendedDetails bool
+	getter          func(map[string]interface{}) datastream.AbstractConnector
+	mapper          func(ConnectorDetails, map[string]interface{}) map[string]interface{}
+	schema          *schema.Resource
+}
+
+// connectorRegistry maps TF resource keys to the supported connectors.
+// Adding a new destination only requires registering it here.
+var connectorRegistry = map[string]connectorDefinition{
+	"s3_connector": {
+		connectorType:   datastream.ConnectorTypeS3,
+		filenameOptions: true,
+		getter:          GetS3Connector,
+		mapper:          MapS3Connector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The access key identifier used to authenticate requests to the Amazon S3 account",
+				},
+				"bucket": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the Amazon S3 bucket",
+				},
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The path to the folder within Amazon S3 bucket where logs will be stored",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The AWS region where Amazon S3 bucket resides",
+				},
+				"secret_access_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The secret access key identifier used to authenticate requests to the Amazon S3 account",
+				},
+			},
+		},
+	},
+	"azure_connector": {
+		connectorType:   datastream.ConnectorTypeAzure,
+		filenameOptions: true,
+		getter:          GetAzureConnector,
+		mapper:          MapAzureConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "Access keys associated with Azure Storage account",
+				},
+				"account_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the Azure Storage account name",
+				},
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"container_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Specifies the Azure Storage container name",
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The path to the folder within Azure Storage container where logs will be stored",
+				},
+			},
+		},
+	},
+	"datadog_connector": {
+		connectorType:   datastream.ConnectorTypeDataDog,
+		filenameOptions: false,
+		urlKey:          "url",
+		getter:          GetDatadogConnector,
+		mapper:          MapDatadogConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auth_token": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The API key associated with Datadog account",
+				},
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Default:     false,
+					Optional:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The service of the Datadog connector",
+				},
+				"source": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The source of the Datadog connector",
+				},
+				"tags": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The tags of the Datadog connector",
+				},
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Datadog endpoint where logs will be stored",
+				},
+			},
+		},
+	},
+	"splunk_connector": {
+		connectorType:   datastream.ConnectorTypeSplunk,
+		filenameOptions: false,
+		urlKey:          "url",
+		getter:          GetSplunkConnector,
+		mapper:          MapSplunkConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Default:     true,
+					Optional:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"event_collector_token": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The Event Collector token associated with Splunk account",
+				},
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The raw event Splunk URL where logs will be stored",
+				},
+			},
+		},
+	},
+	"gcs_connector": {
+		connectorType:   datastream.ConnectorTypeGcs,
+		filenameOptions: true,
+		getter:          GetGCSConnector,
+		mapper:          MapGCSConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the storage bucket created in Google Cloud account",
+				},
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The path to the folder within Google Cloud bucket where logs will be stored",
+				},
+				"private_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The contents of the JSON private key generated and downloaded in Google Cloud Storage account",
+				},
+				"project_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The unique ID of Google Cloud project",
+				},
+				"service_account_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the service account with the storage.object.create permission or Storage Object Creator role",
+				},
+			},
+		},
+	},
+	"https_connector": {
+		connectorType:   datastream.ConnectorTypeHTTPS,
+		filenameOptions: false,
+		extendedDetails: true,
+		urlKey:          "url",
+		getter:          GetHTTPSConnector,
+		mapper:          MapHTTPSConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"authentication_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Either NONE for no authentication, or BASIC for username and password authentication",
+				},
+				"ca_cert": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The certification authority (CA) certificate used to verify the origin server's certificate",
+				},
+				"client_cert": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The PEM-formatted digital certificate used for mutual TLS authentication",
+				},
+				"client_key": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The private key in PEM format used for mutual TLS authentication",
+				},
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Default:     false,
+					Optional:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"content_type": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The type of the resource passed in the request's custom header",
+				},
+				"custom_header_name": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "A human-readable name for the request's custom header",
+				},
+				"custom_header_value": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The custom header's contents passed with the request",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "Password set for custom HTTPS endpoint for authentication",
+				},
+				"tls_hostname": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The hostname that verifies the server's certificate and matches the Subject Alternative Names (SANs) in the certificate",
+				},
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "URL where logs will be stored",
+				},
+				"user_name": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "Username used for authentication",
+				},
+			},
+		},
+	},
+	"sumologic_connector": {
+		connectorType:   datastream.ConnectorTypeSumoLogic,
+		filenameOptions: false,
+		urlKey:          "endpoint",
+		getter:          GetSumoLogicConnector,
+		mapper:          MapSumoLogicConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"collector_code": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The unique HTTP collector code of Sumo Logic endpoint",
+				},
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Default:     true,
+					Optional:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"endpoint": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Sumo Logic collection endpoint where logs will be stored",
+				},
+			},
+		},
+	},
+	"oracle_connector": {
+		connectorType:   datastream.ConnectorTypeOracle,
+		filenameOptions: true,
+		getter:          GetOracleConnector,
+		mapper:          MapOracleConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The access key identifier used to authenticate requests to the Oracle Cloud account",
+				},
+				"bucket": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the Oracle Cloud Storage bucket",
+				},
+				"compress_logs": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Indicates whether the logs should be compressed",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The namespace of Oracle Cloud Storage account",
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The path to the folder within your Oracle Cloud Storage bucket where logs will be stored",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Oracle Cloud Storage region where bucket resides",
+				},
+				"secret_access_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The secret access key identifier used to authenticate requests to the Oracle Cloud account",
+				},
+			},
+		},
+	},
+	"elasticsearch_connector": {
+		connectorType:   ConnectorTypeElasticsearch,
+		filenameOptions: false,
+		extendedDetails: true,
+		urlKey:          "endpoint",
+		getter:          GetElasticsearchConnector,
+		mapper:          MapElasticsearchConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ca_cert": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The certification authority (CA) certificate used to verify the origin server's certificate",
+				},
+				"client_cert": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The PEM-formatted digital certificate used for mutual TLS authentication",
+				},
+				"client_key": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The private key in PEM format used for mutual TLS authentication",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"content_type": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The type of the resource passed in the request's custom header",
+				},
+				"custom_header_name": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "A human-readable name for the request's custom header",
+				},
+				"custom_header_value": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The custom header's contents passed with the request",
+				},
+				"endpoint": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Elasticsearch bulk endpoint URL where logs will be stored",
+				},
+				"index_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The index name of the Elastic cloud where logs will be stored",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The Elasticsearch basic access authentication password",
+				},
+				"tls_hostname": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The hostname that verifies the server's certificate and matches the Subject Alternative Names (SANs) in the certificate",
+				},
+				"user_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The Elasticsearch basic access authentication username",
+				},
+			},
+		},
+	},
+	"new_relic_connector": {
+		connectorType:   ConnectorTypeNewRelic,
+		filenameOptions: false,
+		extendedDetails: true,
+		urlKey:          "endpoint",
+		getter:          GetNewRelicConnector,
+		mapper:          MapNewRelicConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auth_token": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The API key associated with New Relic account",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"content_type": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The type of the resource passed in the request's custom header",
+				},
+				"custom_header_name": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "A human-readable name for the request's custom header",
+				},
+				"custom_header_value": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The custom header's contents passed with the request",
+				},
+				"endpoint": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The New Relic endpoint URL where logs will be stored",
+				},
+			},
+		},
+	},
+	"loggly_connector": {
+		connectorType:   ConnectorTypeLoggly,
+		filenameOptions: false,
+		extendedDetails: true,
+		urlKey:          "endpoint",
+		getter:          GetLogglyConnector,
+		mapper:          MapLogglyConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auth_token": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The unique HTTP code for Loggly bulk endpoint",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"content_type": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The type of the resource passed in the request's custom header",
+				},
+				"custom_header_name": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "A human-readable name for the request's custom header",
+				},
+				"custom_header_value": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The custom header's contents passed with the request",
+				},
+				"endpoint": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The Loggly bulk endpoint URL where logs will be stored",
+				},
+				"tags": {
+					Type:        schema.TypeString,
+					Default:     "",
+					Optional:    true,
+					Description: "The tags used to segment and filter log events in Loggly",
+				},
+			},
+		},
+	},
+	"s3_compatible_connector": {
+		connectorType:   ConnectorTypeS3Compatible,
+		filenameOptions: true,
+		getter:          GetS3CompatibleConnector,
+		mapper:          MapS3CompatibleConnector,
+		schema: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The access key identifier used to authenticate requests to the storage account",
+				},
+				"bucket": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the storage bucket",
+				},
+				"connector_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Identifies the connector associated with the stream",
+				},
+				"connector_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the connector",
+				},
+				"endpoint": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The URL of the S3-compatible storage service",
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The path to the folder within the bucket where logs will be stored",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The region where the bucket resides",
+				},
+				"secret_access_key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					StateFunc:   hashSecret,
+					Description: "The secret access key identifier used to authenticate requests to the storage account",
+				},
+			},
+		},
+	},
+}
+
+// connectorKeys returns sorted TF resource keys of the registered connectors
+func connectorKeys() []string {
+	keys := make([]string, 0, len(connectorRegistry))
+	for key := range connectorRegistry {
+		keys = append(keys, key)
 	}
-)
+	sort.Strings(keys)
+	return keys
+}
+
+// connectorKeysWithoutFilenameOptions returns sorted TF resource keys of connectors which do not allow configuring upload file prefix and suffix
+func connectorKeysWithoutFilenameOptions() []string {
+	var keys []string
+	for _, key := range connectorKeys() {
+		if !connectorRegistry[key].filenameOptions {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// connectorsSchema returns the schema of connector blocks of the stream resource, exactly one of which has to be set
+func connectorsSchema() map[string]*schema.Schema {
+	keys := connectorKeys()
+	result := make(map[string]*schema.Schema, len(keys))
+	for _, key := range keys {
+		definition := connectorRegistry[key]
+		connectorSchema := &schema.Schema{
+			Type:         schema.TypeSet,
+			MaxItems:     1,
+			ExactlyOneOf: keys,
+			Optional:     true,
+			Elem:         definition.schema,
+			Set:          hashConnector(definition.schema),
+		}
+		if definition.urlKey != "" {
+			connectorSchema.DiffSuppressFunc = urlSuppressor(definition.urlKey)
+		}
+		result[key] = connectorSchema
+	}
+	return result
+}
+
+// secretFingerprintPrefix marks connector secrets which have already been replaced with their fingerprints
+const secretFingerprintPrefix = "sha1:"
+
+// hashSecret is a StateFunc of connector secrets, so that only their fingerprints are kept in the state.
+// Values which already are fingerprints are returned unchanged.
+func hashSecret(v interface{}) string {
+	secret, ok := v.(string)
+	if !ok || secret == "" {
+		return ""
+	}
+	if strings.HasPrefix(secret, secretFingerprintPrefix) {
+		return secret
+	}
+	return secretFingerprintPrefix + tools.GetSHAString(secret)
+}
+
+// hashConnector returns a set hash function of the connector which uses fingerprints of the secrets.
+// Secrets from the configuration and their fingerprints from the state then produce the same set element,
+// while a changed secret still produces a diff.
+func hashConnector(connectorResource *schema.Resource) schema.SchemaSetFunc {
+	hash := schema.HashResource(connectorResource)
+	return func(v interface{}) int {
+		connector, ok := v.(map[string]interface{})
+		if !ok {
+			return hash(v)
+		}
+		withFingerprints := make(map[string]interface{}, len(connector))
+		for key, value := range connector {
+			if attr, ok := connectorResource.Schema[key]; ok && attr.Sensitive {
+				value = hashSecret(value)
+			}
+			withFingerprints[key] = value
+		}
+		return hash(withFingerprints)
+	}
+}
+
+// hashConnectorSecrets replaces plain text secrets of the connectors in a raw state with their fingerprints
+func hashConnectorSecrets(rawState map[string]interface{}) map[string]interface{} {
+	for key, definition := range connectorRegistry {
+		connectors, ok := rawState[key].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, c := range connectors {
+			connector, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for attrKey, attr := range definition.schema.Schema {
+				if value, ok := connector[attrKey]; ok && attr.Sensitive {
+					connector[attrKey] = hashSecret(value)
+				}
+			}
+		}
+	}
+	return rawState
+}
+
+// connectorByType returns TF resource key and definition of the connector with given type
+func connectorByType(connectorType datastream.ConnectorType) (string, connectorDefinition, bool) {
+	for key, definition := range connectorRegistry {
+		if definition.connectorType == connectorType {
+			return key, definition, true
+		}
+	}
+	return "", connectorDefinition{}, false
+}
+
+// GetConnectorDetails returns details of the stream connectors returned by GetStream. The stream is read again
+// for connectors with settings which the edgegrid client does not decode
+func GetConnectorDetails(ctx context.Context, client DS, req datastream.GetStreamRequest, streamConnectors []datastream.ConnectorDetails) ([]ConnectorDetails, error) {
+	connectors := make([]ConnectorDetails, 0, len(streamConnectors))
+	var extendedDetails bool
+	for _, connector := range streamConnectors {
+		connectors = append(connectors, ConnectorDetails{ConnectorDetails: connector})
+		if _, definition, ok := connectorByType(connector.ConnectorType); ok && definition.extendedDetails {
+			extendedDetails = true
+		}
+	}
+	if !extendedDetails {
+		return connectors, nil
+	}
+	return client.GetStreamConnectors(ctx, req)
+}
 
 // ConnectorToMap converts ConnectorDetails struct to map of properties
-func ConnectorToMap(connectors []datastream.ConnectorDetails, d *schema.ResourceData) (string, map[string]interface{}, error) {
+func ConnectorToMap(connectors []ConnectorDetails, d *schema.ResourceData) (string, map[string]interface{}, error) {
 	// api returned empty list of connectors
 	if len(connectors) != 1 {
 		return "", nil, nil
@@ -54,7 +874,7 @@ func ConnectorToMap(connectors []datastream.ConnectorDetails, d *schema.Resource
 
 	connectorDetails := connectors[0]
 	connectorType := connectorDetails.ConnectorType
-	resourceKey, ok := connectorTypeToResourceName[connectorType]
+	resourceKey, definition, ok := connectorByType(connectorType)
 	if !ok {
 		return "", nil, fmt.Errorf("cannot find resource name for connector type: %s", connectorType)
 	}
@@ -71,13 +891,7 @@ func ConnectorToMap(connectors []datastream.ConnectorDetails, d *schema.Resource
 		connectorItemProperties = localConnectorSet.List()[0].(map[string]interface{})
 	}
 
-	// select proper mapper function and call it
-	mapper, ok := connectorMappers[connectorType]
-	if !ok {
-		return "", nil, fmt.Errorf("cannot find mapper function for %s connector", resourceKey)
-	}
-
-	connectorProperties := mapper(connectorDetails, connectorItemProperties)
+	connectorProperties := definition.mapper(connectorDetails, connectorItemProperties)
 	return resourceKey, connectorProperties, nil
 }
 
@@ -99,12 +913,12 @@ func GetConnectors(d *schema.ResourceData, keys []string) ([]datastream.Abstract
 	}
 
 	connectorProperties := connectorSet.List()[0].(map[string]interface{})
-	connectorResourceGetter, ok := connectorGetters[connectorName]
+	definition, ok := connectorRegistry[connectorName]
 	if !ok {
 		return nil, fmt.Errorf("cannot find getter function for %s connector", connectorName)
 	}
 
-	connector := connectorResourceGetter(connectorProperties)
+	connector := definition.getter(connectorProperties)
 	return []datastream.AbstractConnector{connector}, nil
 }
 
@@ -121,7 +935,7 @@ func GetS3Connector(props map[string]interface{}) datastream.AbstractConnector {
 }
 
 // MapS3Connector selects fields needed for S3Connector
-func MapS3Connector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+func MapS3Connector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	rv := map[string]interface{}{
 		"access_key":        "",
 		"bucket":            c.Bucket,
@@ -152,7 +966,7 @@ func GetAzureConnector(props map[string]interface{}) datastream.AbstractConnecto
 }
 
 // MapAzureConnector selects fields needed for AzureConnector
-func MapAzureConnector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+func MapAzureConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	rv := map[string]interface{}{
 		"access_key":     "",
 		"account_name":   c.AccountName,
@@ -182,7 +996,7 @@ func GetDatadogConnector(props map[string]interface{}) datastream.AbstractConnec
 }
 
 // MapDatadogConnector selects fields needed for DatadogConnector
-func MapDatadogConnector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+func MapDatadogConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	rv := map[string]interface{}{
 		"auth_token":     "",
 		"compress_logs":  c.CompressLogs,
@@ -210,7 +1024,7 @@ func GetSplunkConnector(props map[string]interface{}) datastream.AbstractConnect
 }
 
 // MapSplunkConnector selects fields needed for SplunkConnector
-func MapSplunkConnector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+func MapSplunkConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	rv := map[string]interface{}{
 		"compress_logs":         c.CompressLogs,
 		"connector_id":          c.ConnectorID,
@@ -237,7 +1051,7 @@ func GetGCSConnector(props map[string]interface{}) datastream.AbstractConnector
 }
 
 // MapGCSConnector selects fields needed for GCSConnector
-func MapGCSConnector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+func MapGCSConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	rv := map[string]interface{}{
 		"bucket":               c.Bucket,
 		"compress_logs":        c.CompressLogs,
@@ -254,26 +1068,40 @@ func MapGCSConnector(c datastream.ConnectorDetails, s map[string]interface{}) ma
 	return rv
 }
 
-// GetHTTPSConnector builds CustomHTTPSConnector structure
+// GetHTTPSConnector builds HTTPSConnector structure
 func GetHTTPSConnector(props map[string]interface{}) datastream.AbstractConnector {
-	return &datastream.CustomHTTPSConnector{
-		AuthenticationType: datastream.AuthenticationType(props["authentication_type"].(string)),
-		CompressLogs:       props["compress_logs"].(bool),
-		ConnectorName:      props["connector_name"].(string),
-		Password:           props["password"].(string),
-		URL:                props["url"].(string),
-		UserName:           props["user_name"].(string),
+	return &HTTPSConnector{
+		CustomHTTPSConnector: datastream.CustomHTTPSConnector{
+			AuthenticationType: datastream.AuthenticationType(props["authentication_type"].(string)),
+			CompressLogs:       props["compress_logs"].(bool),
+			ConnectorName:      props["connector_name"].(string),
+			Password:           props["password"].(string),
+			URL:                props["url"].(string),
+			UserName:           props["user_name"].(string),
+		},
+		CACert:            props["ca_cert"].(string),
+		ClientCert:        props["client_cert"].(string),
+		ClientKey:         props["client_key"].(string),
+		ContentType:       props["content_type"].(string),
+		CustomHeaderName:  props["custom_header_name"].(string),
+		CustomHeaderValue: props["custom_header_value"].(string),
+		TLSHostname:       props["tls_hostname"].(string),
 	}
 }
 
-// MapHTTPSConnector selects fields needed for CustomHTTPSConnector
-func MapHTTPSConnector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+// MapHTTPSConnector selects fields needed for HTTPSConnector
+func MapHTTPSConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	rv := map[string]interface{}{
 		"authentication_type": c.AuthenticationType,
 		"compress_logs":       c.CompressLogs,
 		"connector_id":        c.ConnectorID,
 		"connector_name":      c.ConnectorName,
+		"ca_cert":             c.CACert,
+		"content_type":        c.ContentType,
+		"custom_header_name":  c.CustomHeaderName,
+		"custom_header_value": c.CustomHeaderValue,
 		"password":            "",
+		"tls_hostname":        c.TLSHostname,
 		"url":                 c.URL,
 		"user_name":           "",
 	}
@@ -281,6 +1109,7 @@ func MapHTTPSConnector(c datastream.ConnectorDetails, s map[string]interface{})
 		rv["password"] = s["password"]
 		rv["user_name"] = s["user_name"]
 	}
+	copyLocalProperties(rv, s, "client_cert", "client_key")
 	return rv
 }
 
@@ -295,7 +1124,7 @@ func GetSumoLogicConnector(props map[string]interface{}) datastream.AbstractConn
 }
 
 // MapSumoLogicConnector selects fields needed for SumoLogicConnector
-func MapSumoLogicConnector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+func MapSumoLogicConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	endpoint := tools.GetFirstNotEmpty(c.Endpoint, c.URL)
 
 	rv := map[string]interface{}{
@@ -325,7 +1154,7 @@ func GetOracleConnector(props map[string]interface{}) datastream.AbstractConnect
 }
 
 // MapOracleConnector selects fields needed for OracleCloudStorageConnector
-func MapOracleConnector(c datastream.ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+func MapOracleConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
 	rv := map[string]interface{}{
 		"access_key":        "",
 		"bucket":            c.Bucket,
@@ -343,3 +1172,138 @@ func MapOracleConnector(c datastream.ConnectorDetails, s map[string]interface{})
 	}
 	return rv
 }
+
+// GetElasticsearchConnector builds ElasticsearchConnector structure
+func GetElasticsearchConnector(props map[string]interface{}) datastream.AbstractConnector {
+	return &ElasticsearchConnector{
+		CACert:            props["ca_cert"].(string),
+		ClientCert:        props["client_cert"].(string),
+		ClientKey:         props["client_key"].(string),
+		ConnectorName:     props["connector_name"].(string),
+		ContentType:       props["content_type"].(string),
+		CustomHeaderName:  props["custom_header_name"].(string),
+		CustomHeaderValue: props["custom_header_value"].(string),
+		Endpoint:          props["endpoint"].(string),
+		IndexName:         props["index_name"].(string),
+		Password:          props["password"].(string),
+		TLSHostname:       props["tls_hostname"].(string),
+		UserName:          props["user_name"].(string),
+	}
+}
+
+// MapElasticsearchConnector selects fields needed for ElasticsearchConnector
+func MapElasticsearchConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+	rv := map[string]interface{}{
+		"ca_cert":             c.CACert,
+		"connector_id":        c.ConnectorID,
+		"connector_name":      c.ConnectorName,
+		"content_type":        c.ContentType,
+		"custom_header_name":  c.CustomHeaderName,
+		"custom_header_value": c.CustomHeaderValue,
+		"endpoint":            tools.GetFirstNotEmpty(c.Endpoint, c.URL),
+		"index_name":          c.IndexName,
+		"password":            "",
+		"tls_hostname":        c.TLSHostname,
+		"user_name":           "",
+	}
+	copyLocalProperties(rv, s, "client_cert", "client_key", "password", "user_name")
+	return rv
+}
+
+// GetNewRelicConnector builds NewRelicConnector structure
+func GetNewRelicConnector(props map[string]interface{}) datastream.AbstractConnector {
+	return &NewRelicConnector{
+		AuthToken:         props["auth_token"].(string),
+		ConnectorName:     props["connector_name"].(string),
+		ContentType:       props["content_type"].(string),
+		CustomHeaderName:  props["custom_header_name"].(string),
+		CustomHeaderValue: props["custom_header_value"].(string),
+		Endpoint:          props["endpoint"].(string),
+	}
+}
+
+// MapNewRelicConnector selects fields needed for NewRelicConnector
+func MapNewRelicConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+	rv := map[string]interface{}{
+		"auth_token":          "",
+		"connector_id":        c.ConnectorID,
+		"connector_name":      c.ConnectorName,
+		"content_type":        c.ContentType,
+		"custom_header_name":  c.CustomHeaderName,
+		"custom_header_value": c.CustomHeaderValue,
+		"endpoint":            tools.GetFirstNotEmpty(c.Endpoint, c.URL),
+	}
+	copyLocalProperties(rv, s, "auth_token")
+	return rv
+}
+
+// GetLogglyConnector builds LogglyConnector structure
+func GetLogglyConnector(props map[string]interface{}) datastream.AbstractConnector {
+	return &LogglyConnector{
+		AuthToken:         props["auth_token"].(string),
+		ConnectorName:     props["connector_name"].(string),
+		ContentType:       props["content_type"].(string),
+		CustomHeaderName:  props["custom_header_name"].(string),
+		CustomHeaderValue: props["custom_header_value"].(string),
+		Endpoint:          props["endpoint"].(string),
+		Tags:              props["tags"].(string),
+	}
+}
+
+// MapLogglyConnector selects fields needed for LogglyConnector
+func MapLogglyConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+	rv := map[string]interface{}{
+		"auth_token":          "",
+		"connector_id":        c.ConnectorID,
+		"connector_name":      c.ConnectorName,
+		"content_type":        c.ContentType,
+		"custom_header_name":  c.CustomHeaderName,
+		"custom_header_value": c.CustomHeaderValue,
+		"endpoint":            tools.GetFirstNotEmpty(c.Endpoint, c.URL),
+		"tags":                c.Tags,
+	}
+	copyLocalProperties(rv, s, "auth_token")
+	return rv
+}
+
+// GetS3CompatibleConnector builds S3CompatibleConnector structure
+func GetS3CompatibleConnector(props map[string]interface{}) datastream.AbstractConnector {
+	return &S3CompatibleConnector{
+		AccessKey:       props["access_key"].(string),
+		Bucket:          props["bucket"].(string),
+		ConnectorName:   props["connector_name"].(string),
+		Endpoint:        props["endpoint"].(string),
+		Path:            props["path"].(string),
+		Region:          props["region"].(string),
+		SecretAccessKey: props["secret_access_key"].(string),
+	}
+}
+
+// MapS3CompatibleConnector selects fields needed for S3CompatibleConnector
+func MapS3CompatibleConnector(c ConnectorDetails, s map[string]interface{}) map[string]interface{} {
+	rv := map[string]interface{}{
+		"access_key":        "",
+		"bucket":            c.Bucket,
+		"connector_id":      c.ConnectorID,
+		"connector_name":    c.ConnectorName,
+		"endpoint":          tools.GetFirstNotEmpty(c.Endpoint, c.URL),
+		"path":              c.Path,
+		"region":            c.Region,
+		"secret_access_key": "",
+	}
+	if s["access_key"] != nil && s["secret_access_key"] != nil {
+		rv["access_key"] = s["access_key"]
+		rv["secret_access_key"] = s["secret_access_key"]
+	}
+	return rv
+}
+
+// copyLocalProperties copies given properties from the local configuration of the connector.
+// It is used for secrets, which are not returned by the API.
+func copyLocalProperties(rv, s map[string]interface{}, keys ...string) {
+	for _, key := range keys {
+		if s[key] != nil {
+			rv[key] = s[key]
+		}
+	}
+}
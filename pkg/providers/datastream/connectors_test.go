@@ -1,11 +1,14 @@
 package datastream
 
 import (
+	"context"
 	"testing"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var resourceSchema = map[string]*schema.Schema{
@@ -62,12 +65,12 @@ func TestConnectorToMap(t *testing.T) {
 	}
 
 	tests := map[string]struct {
-		connectorDetails []datastream.ConnectorDetails
+		connectorDetails []ConnectorDetails
 		resourceMap      map[string]interface{}
 		expectedResult
 	}{
 		"empty connector details": {
-			connectorDetails: []datastream.ConnectorDetails{},
+			connectorDetails: []ConnectorDetails{},
 			expectedResult: expectedResult{
 				key:   "",
 				props: nil,
@@ -75,13 +78,13 @@ func TestConnectorToMap(t *testing.T) {
 			},
 		},
 		"more than one connector": {
-			connectorDetails: []datastream.ConnectorDetails{
-				{
+			connectorDetails: []ConnectorDetails{
+				{ConnectorDetails: datastream.ConnectorDetails{
 					ConnectorType: datastream.ConnectorTypeS3,
-				},
-				{
+				}},
+				{ConnectorDetails: datastream.ConnectorDetails{
 					ConnectorType: datastream.ConnectorTypeGcs,
-				},
+				}},
 			},
 			expectedResult: expectedResult{
 				key:   "",
@@ -90,10 +93,10 @@ func TestConnectorToMap(t *testing.T) {
 			},
 		},
 		"no resource name for invalid connector type": {
-			connectorDetails: []datastream.ConnectorDetails{
-				{
+			connectorDetails: []ConnectorDetails{
+				{ConnectorDetails: datastream.ConnectorDetails{
 					ConnectorType: datastream.ConnectorType("invalid_connector"),
-				},
+				}},
 			},
 			resourceMap: nil,
 			expectedResult: expectedResult{
@@ -101,14 +104,14 @@ func TestConnectorToMap(t *testing.T) {
 			},
 		},
 		"no connector in local resource": {
-			connectorDetails: []datastream.ConnectorDetails{
-				{
+			connectorDetails: []ConnectorDetails{
+				{ConnectorDetails: datastream.ConnectorDetails{
 					ConnectorID:   1337,
 					CompressLogs:  true,
 					ConnectorName: "sumologic connector",
 					ConnectorType: datastream.ConnectorTypeSumoLogic,
 					URL:           "sumologic endpoint",
-				},
+				}},
 			},
 			resourceMap: nil,
 			expectedResult: expectedResult{
@@ -123,14 +126,14 @@ func TestConnectorToMap(t *testing.T) {
 			},
 		},
 		"proper configuration": {
-			connectorDetails: []datastream.ConnectorDetails{
-				{
+			connectorDetails: []ConnectorDetails{
+				{ConnectorDetails: datastream.ConnectorDetails{
 					ConnectorID:   1337,
 					CompressLogs:  true,
 					ConnectorName: "sumologic connector",
 					ConnectorType: datastream.ConnectorTypeSumoLogic,
 					URL:           "sumologic endpoint",
-				},
+				}},
 			},
 			resourceMap: map[string]interface{}{
 				"sumologic_connector": []interface{}{
@@ -217,3 +220,336 @@ func TestGetConnectors(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisteredConnectors(t *testing.T) {
+	tests := map[string]struct {
+		connectorKey      string
+		localConnector    map[string]interface{}
+		connectorDetails  ConnectorDetails
+		expectedConnector datastream.AbstractConnector
+		expectedProps     map[string]interface{}
+	}{
+		"https with custom header and client certificate": {
+			connectorKey: "https_connector",
+			localConnector: map[string]interface{}{
+				"authentication_type": "NONE",
+				"ca_cert":             "ca",
+				"client_cert":         "cert",
+				"client_key":          "key",
+				"connector_name":      "https connector",
+				"content_type":        "application/json",
+				"custom_header_name":  "X-Header",
+				"custom_header_value": "value",
+				"tls_hostname":        "example.com",
+				"url":                 "https://example.com/logs",
+			},
+			connectorDetails: ConnectorDetails{
+				ConnectorDetails: datastream.ConnectorDetails{
+					AuthenticationType: datastream.AuthenticationTypeNone,
+					ConnectorID:        1,
+					ConnectorName:      "https connector",
+					ConnectorType:      datastream.ConnectorTypeHTTPS,
+					URL:                "https://example.com/logs",
+				},
+				CACert:            "ca",
+				ContentType:       "application/json",
+				CustomHeaderName:  "X-Header",
+				CustomHeaderValue: "value",
+				TLSHostname:       "example.com",
+			},
+			expectedConnector: &HTTPSConnector{
+				CustomHTTPSConnector: datastream.CustomHTTPSConnector{
+					AuthenticationType: datastream.AuthenticationTypeNone,
+					ConnectorName:      "https connector",
+					URL:                "https://example.com/logs",
+				},
+				CACert:            "ca",
+				ClientCert:        "cert",
+				ClientKey:         "key",
+				ContentType:       "application/json",
+				CustomHeaderName:  "X-Header",
+				CustomHeaderValue: "value",
+				TLSHostname:       "example.com",
+			},
+			expectedProps: map[string]interface{}{
+				"authentication_type": datastream.AuthenticationTypeNone,
+				"ca_cert":             "ca",
+				"client_cert":         "cert",
+				"client_key":          "key",
+				"compress_logs":       false,
+				"connector_id":        1,
+				"connector_name":      "https connector",
+				"content_type":        "application/json",
+				"custom_header_name":  "X-Header",
+				"custom_header_value": "value",
+				"password":            "",
+				"tls_hostname":        "example.com",
+				"url":                 "https://example.com/logs",
+				"user_name":           "",
+			},
+		},
+		"elasticsearch": {
+			connectorKey: "elasticsearch_connector",
+			localConnector: map[string]interface{}{
+				"connector_name": "elastic connector",
+				"endpoint":       "https://elastic.example.com/_bulk",
+				"index_name":     "logs",
+				"password":       "pass",
+				"user_name":      "user",
+			},
+			connectorDetails: ConnectorDetails{
+				ConnectorDetails: datastream.ConnectorDetails{
+					ConnectorID:   2,
+					ConnectorName: "elastic connector",
+					ConnectorType: ConnectorTypeElasticsearch,
+					Endpoint:      "https://elastic.example.com/_bulk",
+				},
+				ContentType: "application/json",
+				IndexName:   "logs",
+			},
+			expectedConnector: &ElasticsearchConnector{
+				ConnectorName: "elastic connector",
+				Endpoint:      "https://elastic.example.com/_bulk",
+				IndexName:     "logs",
+				Password:      "pass",
+				UserName:      "user",
+			},
+			expectedProps: map[string]interface{}{
+				"ca_cert":             "",
+				"client_cert":         "",
+				"client_key":          "",
+				"connector_id":        2,
+				"connector_name":      "elastic connector",
+				"content_type":        "application/json",
+				"custom_header_name":  "",
+				"custom_header_value": "",
+				"endpoint":            "https://elastic.example.com/_bulk",
+				"index_name":          "logs",
+				"password":            "pass",
+				"tls_hostname":        "",
+				"user_name":           "user",
+			},
+		},
+		"new relic": {
+			connectorKey: "new_relic_connector",
+			localConnector: map[string]interface{}{
+				"auth_token":     "token",
+				"connector_name": "new relic connector",
+				"endpoint":       "https://log-api.newrelic.com/log/v1",
+			},
+			connectorDetails: ConnectorDetails{
+				ConnectorDetails: datastream.ConnectorDetails{
+					ConnectorID:   3,
+					ConnectorName: "new relic connector",
+					ConnectorType: ConnectorTypeNewRelic,
+					URL:           "https://log-api.newrelic.com/log/v1",
+				},
+				CustomHeaderName:  "X-Header",
+				CustomHeaderValue: "value",
+			},
+			expectedConnector: &NewRelicConnector{
+				AuthToken:     "token",
+				ConnectorName: "new relic connector",
+				Endpoint:      "https://log-api.newrelic.com/log/v1",
+			},
+			expectedProps: map[string]interface{}{
+				"auth_token":          "token",
+				"connector_id":        3,
+				"connector_name":      "new relic connector",
+				"content_type":        "",
+				"custom_header_name":  "X-Header",
+				"custom_header_value": "value",
+				"endpoint":            "https://log-api.newrelic.com/log/v1",
+			},
+		},
+		"loggly": {
+			connectorKey: "loggly_connector",
+			localConnector: map[string]interface{}{
+				"auth_token":     "token",
+				"connector_name": "loggly connector",
+				"endpoint":       "https://logs-01.loggly.com/bulk",
+				"tags":           "tag1,tag2",
+			},
+			connectorDetails: ConnectorDetails{
+				ConnectorDetails: datastream.ConnectorDetails{
+					ConnectorID:   4,
+					ConnectorName: "loggly connector",
+					ConnectorType: ConnectorTypeLoggly,
+					Endpoint:      "https://logs-01.loggly.com/bulk",
+					Tags:          "tag1,tag2",
+				},
+			},
+			expectedConnector: &LogglyConnector{
+				AuthToken:     "token",
+				ConnectorName: "loggly connector",
+				Endpoint:      "https://logs-01.loggly.com/bulk",
+				Tags:          "tag1,tag2",
+			},
+			expectedProps: map[string]interface{}{
+				"auth_token":          "token",
+				"connector_id":        4,
+				"connector_name":      "loggly connector",
+				"content_type":        "",
+				"custom_header_name":  "",
+				"custom_header_value": "",
+				"endpoint":            "https://logs-01.loggly.com/bulk",
+				"tags":                "tag1,tag2",
+			},
+		},
+		"s3 compatible": {
+			connectorKey: "s3_compatible_connector",
+			localConnector: map[string]interface{}{
+				"access_key":        "access",
+				"bucket":            "bucket",
+				"connector_name":    "s3 compatible connector",
+				"endpoint":          "https://storage.example.com",
+				"path":              "logs",
+				"region":            "eu-1",
+				"secret_access_key": "secret",
+			},
+			connectorDetails: ConnectorDetails{
+				ConnectorDetails: datastream.ConnectorDetails{
+					Bucket:        "bucket",
+					ConnectorID:   5,
+					ConnectorName: "s3 compatible connector",
+					ConnectorType: ConnectorTypeS3Compatible,
+					Endpoint:      "https://storage.example.com",
+					Path:          "logs",
+					Region:        "eu-1",
+				},
+			},
+			expectedConnector: &S3CompatibleConnector{
+				AccessKey:       "access",
+				Bucket:          "bucket",
+				ConnectorName:   "s3 compatible connector",
+				Endpoint:        "https://storage.example.com",
+				Path:            "logs",
+				Region:          "eu-1",
+				SecretAccessKey: "secret",
+			},
+			expectedProps: map[string]interface{}{
+				"access_key":        "access",
+				"bucket":            "bucket",
+				"connector_id":      5,
+				"connector_name":    "s3 compatible connector",
+				"endpoint":          "https://storage.example.com",
+				"path":              "logs",
+				"region":            "eu-1",
+				"secret_access_key": "secret",
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, connectorsSchema(), map[string]interface{}{
+				test.connectorKey: []interface{}{test.localConnector},
+			})
+
+			connectors, err := GetConnectors(d, ExactlyOneConnectorRule)
+			assert.NoError(t, err)
+			assert.Equal(t, []datastream.AbstractConnector{test.expectedConnector}, connectors)
+
+			resourceKey, properties, err := ConnectorToMap([]ConnectorDetails{test.connectorDetails}, d)
+			assert.NoError(t, err)
+			assert.Equal(t, test.connectorKey, resourceKey)
+			assert.Equal(t, test.expectedProps, properties)
+		})
+	}
+}
+
+func TestConnectorSecrets(t *testing.T) {
+	t.Run("secrets are stored as fingerprints", func(t *testing.T) {
+		assert.Equal(t, "", hashSecret(""))
+		assert.Equal(t, "sha1:97abdc203a1b381f29561225d82783803b2ffe5b", hashSecret("s3_test_access_key"))
+		assert.Equal(t, hashSecret("s3_test_access_key"), hashSecret(hashSecret("s3_test_access_key")))
+		assert.NotEqual(t, hashSecret("s3_test_access_key"), hashSecret("s3_test_access_key_rotated"))
+	})
+
+	t.Run("set hash uses fingerprints of secrets", func(t *testing.T) {
+		hash := hashConnector(connectorRegistry["s3_connector"].schema)
+		fromConfig := map[string]interface{}{
+			"access_key":        "s3_test_access_key",
+			"bucket":            "s3_test_bucket",
+			"connector_name":    "s3_test_connector_name",
+			"path":              "s3_test_path",
+			"region":            "s3_test_region",
+			"secret_access_key": "s3_test_secret_key",
+		}
+		fromState := map[string]interface{}{
+			"access_key":        hashSecret("s3_test_access_key"),
+			"bucket":            "s3_test_bucket",
+			"connector_name":    "s3_test_connector_name",
+			"path":              "s3_test_path",
+			"region":            "s3_test_region",
+			"secret_access_key": hashSecret("s3_test_secret_key"),
+		}
+		assert.Equal(t, hash(fromConfig), hash(fromState))
+
+		fromState["bucket"] = "s3_test_bucket_updated"
+		assert.NotEqual(t, hash(fromConfig), hash(fromState))
+
+		fromState["bucket"] = "s3_test_bucket"
+		fromState["access_key"] = hashSecret("s3_test_access_key_rotated")
+		assert.NotEqual(t, hash(fromConfig), hash(fromState))
+	})
+
+	t.Run("plain text secrets in state are upgraded", func(t *testing.T) {
+		rawState := map[string]interface{}{
+			"stream_name": "test_stream",
+			"s3_connector": []interface{}{
+				map[string]interface{}{
+					"access_key":        "s3_test_access_key",
+					"bucket":            "s3_test_bucket",
+					"secret_access_key": hashSecret("s3_test_secret_key"),
+				},
+			},
+		}
+		upgraded, err := upgradeDatastreamV0(context.Background(), rawState, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"stream_name":         "test_stream",
+			"wait_for_activation": true,
+			"s3_connector": []interface{}{
+				map[string]interface{}{
+					"access_key":        hashSecret("s3_test_access_key"),
+					"bucket":            "s3_test_bucket",
+					"secret_access_key": hashSecret("s3_test_secret_key"),
+				},
+			},
+		}, upgraded)
+	})
+}
+
+func TestGetConnectorDetails(t *testing.T) {
+	req := datastream.GetStreamRequest{StreamID: 1}
+
+	t.Run("connector decoded by the client", func(t *testing.T) {
+		client := &mockdatastream{}
+		connectors, err := GetConnectorDetails(context.Background(), client, req, []datastream.ConnectorDetails{
+			{ConnectorType: datastream.ConnectorTypeS3, Bucket: "bucket"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []ConnectorDetails{
+			{ConnectorDetails: datastream.ConnectorDetails{ConnectorType: datastream.ConnectorTypeS3, Bucket: "bucket"}},
+		}, connectors)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("connector with extended details", func(t *testing.T) {
+		client := &mockdatastream{}
+		expected := []ConnectorDetails{
+			{
+				ConnectorDetails: datastream.ConnectorDetails{ConnectorType: ConnectorTypeNewRelic},
+				ContentType:      "application/json",
+			},
+		}
+		client.On("GetStreamConnectors", mock.Anything, req).Return(expected, nil).Once()
+
+		connectors, err := GetConnectorDetails(context.Background(), client, req, []datastream.ConnectorDetails{
+			{ConnectorType: ConnectorTypeNewRelic},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected, connectors)
+		client.AssertExpectations(t)
+	})
+}
@@ -31,6 +31,26 @@ func (m *mockdatastream) GetStream(ctx context.Context, r datastream.GetStreamRe
 	return args.Get(0).(*datastream.DetailedStreamVersion), args.Error(1)
 }
 
+func (m *mockdatastream) ListStreams(ctx context.Context, r ListStreamsRequest) ([]StreamDetails, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]StreamDetails), args.Error(1)
+}
+
+func (m *mockdatastream) GetStreamConnectors(ctx context.Context, r datastream.GetStreamRequest) ([]ConnectorDetails, error) {
+	args := m.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]ConnectorDetails), args.Error(1)
+}
+
 func (m *mockdatastream) UpdateStream(ctx context.Context, r datastream.UpdateStreamRequest) (*datastream.StreamUpdate, error) {
 	args := m.Called(ctx, r)
 
@@ -3,7 +3,6 @@ package datastream
 import (
 	"sync"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/apex/log"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,7 +13,7 @@ type (
 	provider struct {
 		*schema.Provider
 
-		client datastream.DS
+		client DS
 	}
 
 	// Option is a ds provider option
@@ -45,29 +44,33 @@ func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{},
 		DataSourcesMap: map[string]*schema.Resource{
+			"akamai_datastream":                    dataSourceDatastream(),
 			"akamai_datastream_activation_history": dataAkamaiDatastreamActivationHistory(),
 			"akamai_datastream_dataset_fields":     dataSourceDatasetFields(),
+			"akamai_datastream_log_schema":         dataSourceLogSchema(),
+			"akamai_datastreams":                   dataSourceDatastreams(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"akamai_datastream": resourceDatastream(),
+			"akamai_datastream":            resourceDatastream(),
+			"akamai_datastream_activation": resourceDatastreamActivation(),
 		},
 	}
 	return provider
 }
 
 // WithClient sets the client interface function, used for mocking and testing
-func WithClient(c datastream.DS) Option {
+func WithClient(c DS) Option {
 	return func(p *provider) {
 		p.client = c
 	}
 }
 
 // Client returns the ds interface
-func (p *provider) Client(meta akamai.OperationMeta) datastream.DS {
+func (p *provider) Client(meta akamai.OperationMeta) DS {
 	if p.client != nil {
 		return p.client
 	}
-	return datastream.Client(meta.Session())
+	return Client(meta.Session())
 }
 
 func (p *provider) Name() string {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -23,15 +24,10 @@ var (
 	PollForActivationStatusChangeInterval = 10 * time.Minute
 
 	// ExactlyOneConnectorRule defines connector fields names
-	ExactlyOneConnectorRule = []string{
-		"s3_connector", "azure_connector", "gcs_connector", "https_connector",
-		"splunk_connector", "datadog_connector", "oracle_connector", "sumologic_connector",
-	}
+	ExactlyOneConnectorRule = connectorKeys()
 
 	// ConnectorsWithoutFilenameOptionsConfig defines connectors wtihout option to configure prefix and suffix
-	ConnectorsWithoutFilenameOptionsConfig = []string{
-		"https_connector", "datadog_connector", "splunk_connector", "sumologic_connector",
-	}
+	ConnectorsWithoutFilenameOptionsConfig = connectorKeysWithoutFilenameOptions()
 
 	// DatastreamResourceTimeout is the default timeout for the resource operations (max activation time + polling interval)
 	DatastreamResourceTimeout = 180 * time.Minute
@@ -46,6 +42,11 @@ const (
 )
 
 func resourceDatastream() *schema.Resource {
+	resourceSchema := connectorsSchema()
+	for key, attr := range datastreamResourceSchema {
+		resourceSchema[key] = attr
+	}
+
 	return &schema.Resource{
 		CreateContext: resourceDatastreamCreate,
 		ReadContext:   resourceDatastreamRead,
@@ -57,9 +58,15 @@ func resourceDatastream() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			validateConfig,
 		),
-		Schema: datastreamResourceSchema,
+		StateUpgraders: []schema.StateUpgrader{{
+			Version: 0,
+			Type:    resourceDatastreamV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: upgradeDatastreamV0,
+		}},
+		SchemaVersion: 1,
+		Schema:        resourceSchema,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDatastreamImport,
 		},
 	}
 }
@@ -70,6 +77,11 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "Defining if stream should be active or not",
 	},
+	"activation_status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The activation status of the stream",
+	},
 	"config": {
 		Type:        schema.TypeSet,
 		MinItems:    1,
@@ -137,6 +149,12 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 		Computed:    true,
 		Description: "The configuration in JSON format that can be copy-pasted into PAPI configuration to enable datastream behavior",
 	},
+	"poll_interval": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "The interval in seconds between checks of the stream activation status. Defaults to 10 minutes",
+	},
 	"product_id": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -176,389 +194,11 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "The name of the template associated with the stream",
 	},
-
-	"s3_connector": {
-		Type:         schema.TypeSet,
-		MaxItems:     1,
-		ExactlyOneOf: ExactlyOneConnectorRule,
-		Optional:     true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"access_key": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The access key identifier used to authenticate requests to the Amazon S3 account",
-				},
-				"bucket": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the Amazon S3 bucket",
-				},
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Computed:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"path": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The path to the folder within Amazon S3 bucket where logs will be stored",
-				},
-				"region": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The AWS region where Amazon S3 bucket resides",
-				},
-				"secret_access_key": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The secret access key identifier used to authenticate requests to the Amazon S3 account",
-				},
-			},
-		},
-	},
-	"azure_connector": {
-		Type:     schema.TypeSet,
-		MaxItems: 1,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"access_key": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "Access keys associated with Azure Storage account",
-				},
-				"account_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Specifies the Azure Storage account name",
-				},
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Computed:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"container_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Specifies the Azure Storage container name",
-				},
-				"path": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The path to the folder within Azure Storage container where logs will be stored",
-				},
-			},
-		},
-	},
-	"datadog_connector": {
-		Type:             schema.TypeSet,
-		MaxItems:         1,
-		Optional:         true,
-		DiffSuppressFunc: urlSuppressor("url"),
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"auth_token": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The API key associated with Datadog account",
-				},
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Default:     false,
-					Optional:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"service": {
-					Type:        schema.TypeString,
-					Default:     "",
-					Optional:    true,
-					Description: "The service of the Datadog connector",
-				},
-				"source": {
-					Type:        schema.TypeString,
-					Default:     "",
-					Optional:    true,
-					Description: "The source of the Datadog connector",
-				},
-				"tags": {
-					Type:        schema.TypeString,
-					Default:     "",
-					Optional:    true,
-					Description: "The tags of the Datadog connector",
-				},
-				"url": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The Datadog endpoint where logs will be stored",
-				},
-			},
-		},
-	},
-	"splunk_connector": {
-		Type:             schema.TypeSet,
-		MaxItems:         1,
-		Optional:         true,
-		DiffSuppressFunc: urlSuppressor("url"),
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Default:     true,
-					Optional:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"event_collector_token": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The Event Collector token associated with Splunk account",
-				},
-				"url": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The raw event Splunk URL where logs will be stored",
-				},
-			},
-		},
-	},
-	"gcs_connector": {
-		Type:     schema.TypeSet,
-		MaxItems: 1,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"bucket": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the storage bucket created in Google Cloud account",
-				},
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Computed:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"path": {
-					Type:        schema.TypeString,
-					Default:     "",
-					Optional:    true,
-					Description: "The path to the folder within Google Cloud bucket where logs will be stored",
-				},
-				"private_key": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The contents of the JSON private key generated and downloaded in Google Cloud Storage account",
-				},
-				"project_id": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The unique ID of Google Cloud project",
-				},
-				"service_account_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the service account with the storage.object.create permission or Storage Object Creator role",
-				},
-			},
-		},
-	},
-	"https_connector": {
-		Type:             schema.TypeSet,
-		MaxItems:         1,
-		Optional:         true,
-		DiffSuppressFunc: urlSuppressor("url"),
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"authentication_type": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Either NONE for no authentication, or BASIC for username and password authentication",
-				},
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Default:     false,
-					Optional:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"password": {
-					Type:        schema.TypeString,
-					Default:     "",
-					Optional:    true,
-					Sensitive:   true,
-					Description: "Password set for custom HTTPS endpoint for authentication",
-				},
-				"url": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "URL where logs will be stored",
-				},
-				"user_name": {
-					Type:        schema.TypeString,
-					Default:     "",
-					Optional:    true,
-					Sensitive:   true,
-					Description: "Username used for authentication",
-				},
-			},
-		},
-	},
-	"sumologic_connector": {
-		Type:             schema.TypeSet,
-		MaxItems:         1,
-		Optional:         true,
-		DiffSuppressFunc: urlSuppressor("endpoint"),
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"collector_code": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The unique HTTP collector code of Sumo Logic endpoint",
-				},
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Default:     true,
-					Optional:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"endpoint": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The Sumo Logic collection endpoint where logs will be stored",
-				},
-			},
-		},
-	},
-	"oracle_connector": {
-		Type:     schema.TypeSet,
-		MaxItems: 1,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"access_key": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The access key identifier used to authenticate requests to the Oracle Cloud account",
-				},
-				"bucket": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the Oracle Cloud Storage bucket",
-				},
-				"compress_logs": {
-					Type:        schema.TypeBool,
-					Computed:    true,
-					Description: "Indicates whether the logs should be compressed",
-				},
-				"connector_id": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Identifies the connector associated with the stream",
-				},
-				"connector_name": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the connector",
-				},
-				"namespace": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The namespace of Oracle Cloud Storage account",
-				},
-				"path": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The path to the folder within your Oracle Cloud Storage bucket where logs will be stored",
-				},
-				"region": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The Oracle Cloud Storage region where bucket resides",
-				},
-				"secret_access_key": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Sensitive:   true,
-					Description: "The secret access key identifier used to authenticate requests to the Oracle Cloud account",
-				},
-			},
-		},
+	"wait_for_activation": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Whether to wait until the stream is activated. If false, the resource returns while the stream is still activating, which is reported in activation_status",
 	},
 }
 
@@ -715,8 +355,12 @@ func resourceDatastreamCreate(ctx context.Context, d *schema.ResourceData, m int
 	streamID := res.StreamVersionKey.StreamID
 	d.SetId(strconv.FormatInt(streamID, 10))
 
-	if active {
-		_, err = waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusActivated)
+	wait, err := tools.GetBoolValue("wait_for_activation", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if active && wait {
+		_, err = waitForStreamStatusChange(ctx, client, streamID, getPollInterval(d), datastream.ActivationStatusActivated)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -742,42 +386,21 @@ func resourceDatastreamRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	streamDetails, err := client.GetStream(ctx, datastream.GetStreamRequest{
+	req := datastream.GetStreamRequest{
 		StreamID: streamID,
-	})
+	}
+	streamDetails, err := client.GetStream(ctx, req)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	attrs := make(map[string]interface{})
+	attrs := streamToAttrs(streamDetails)
 
-	attrs["active"] = streamDetails.ActivationStatus == datastream.ActivationStatusActivated
-	attrs["contract_id"] = streamDetails.ContractID
-	attrs["created_by"] = streamDetails.CreatedBy
-	attrs["created_date"] = streamDetails.CreatedDate
-	attrs["dataset_fields_ids"] = DataSetFieldsToList(streamDetails.Datasets)
-	attrs["contract_id"] = streamDetails.ContractID
-	attrs["email_ids"] = strings.Split(streamDetails.EmailIDs, ",")
-	var emailIDs []string
-	if streamDetails.EmailIDs != "" {
-		emailIDs = strings.Split(streamDetails.EmailIDs, ",")
+	connectors, err := GetConnectorDetails(ctx, client, req, streamDetails.Connectors)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	attrs["email_ids"] = emailIDs
-
-	attrs["group_id"] = strconv.Itoa(streamDetails.GroupID)
-	attrs["group_name"] = streamDetails.GroupName
-	attrs["modified_by"] = streamDetails.ModifiedBy
-	attrs["modified_date"] = streamDetails.ModifiedDate
-	attrs["papi_json"] = StreamIDToPapiJSON(streamDetails.StreamID)
-	attrs["product_id"] = streamDetails.ProductID
-	attrs["product_name"] = streamDetails.ProductName
-	attrs["property_ids"] = PropertyToList(streamDetails.Properties)
-	attrs["stream_name"] = streamDetails.StreamName
-	attrs["stream_type"] = streamDetails.StreamType
-	attrs["stream_version_id"] = streamDetails.StreamVersionID
-	attrs["template_name"] = streamDetails.TemplateName
-
-	connectorKey, connectorProps, err := ConnectorToMap(streamDetails.Connectors, d)
+	connectorKey, connectorProps, err := ConnectorToMap(connectors, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -808,6 +431,43 @@ func resourceDatastreamRead(ctx context.Context, d *schema.ResourceData, m inter
 	return nil
 }
 
+// streamToAttrs converts the stream details into a map of attributes shared by the resource and the data source.
+// Connectors and config are handled separately as their representation differs between the two.
+func streamToAttrs(streamDetails *datastream.DetailedStreamVersion) map[string]interface{} {
+	attrs := make(map[string]interface{})
+
+	// a stream which is still activating is considered active, so that not waiting for activation doesn't produce a diff
+	attrs["active"] = streamDetails.ActivationStatus == datastream.ActivationStatusActivated ||
+		streamDetails.ActivationStatus == datastream.ActivationStatusActivating
+	attrs["activation_status"] = streamDetails.ActivationStatus
+	attrs["contract_id"] = streamDetails.ContractID
+	attrs["created_by"] = streamDetails.CreatedBy
+	attrs["created_date"] = streamDetails.CreatedDate
+	attrs["dataset_fields_ids"] = DataSetFieldsToList(streamDetails.Datasets)
+	attrs["contract_id"] = streamDetails.ContractID
+	attrs["email_ids"] = strings.Split(streamDetails.EmailIDs, ",")
+	var emailIDs []string
+	if streamDetails.EmailIDs != "" {
+		emailIDs = strings.Split(streamDetails.EmailIDs, ",")
+	}
+	attrs["email_ids"] = emailIDs
+
+	attrs["group_id"] = strconv.Itoa(streamDetails.GroupID)
+	attrs["group_name"] = streamDetails.GroupName
+	attrs["modified_by"] = streamDetails.ModifiedBy
+	attrs["modified_date"] = streamDetails.ModifiedDate
+	attrs["papi_json"] = StreamIDToPapiJSON(streamDetails.StreamID)
+	attrs["product_id"] = streamDetails.ProductID
+	attrs["product_name"] = streamDetails.ProductName
+	attrs["property_ids"] = PropertyToList(streamDetails.Properties)
+	attrs["stream_name"] = streamDetails.StreamName
+	attrs["stream_type"] = streamDetails.StreamType
+	attrs["stream_version_id"] = streamDetails.StreamVersionID
+	attrs["template_name"] = streamDetails.TemplateName
+
+	return attrs
+}
+
 func resourceDatastreamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := akamai.Meta(m)
 	logger := meta.Log("Datastream", "resourceDatastreamUpdate")
@@ -825,8 +485,14 @@ func resourceDatastreamUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	pollInterval := getPollInterval(d)
+	wait, err := tools.GetBoolValue("wait_for_activation", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
 	// it is not possible to edit stream while it is (de)activating
-	currentStreamStatus, err := waitForStreamStatusChange(ctx, client, streamID,
+	currentStreamStatus, err := waitForStreamStatusChange(ctx, client, streamID, pollInterval,
 		datastream.ActivationStatusDeactivated,
 		datastream.ActivationStatusActivated,
 		datastream.ActivationStatusInactive,
@@ -859,23 +525,18 @@ func resourceDatastreamUpdate(ctx context.Context, d *schema.ResourceData, m int
 			}
 
 			// wait until stream is activated because updating active stream causes its reactivation
-			logger.Debugf("waiting for stream #%d activation", streamID)
-			_, err = waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusActivated)
-			if err != nil {
-				return diag.FromErr(err)
+			if wait {
+				logger.Debugf("waiting for stream #%d activation", streamID)
+				_, err = waitForStreamStatusChange(ctx, client, streamID, pollInterval, datastream.ActivationStatusActivated)
+				if err != nil {
+					return diag.FromErr(err)
+				}
 			}
 		} else {
 			// stream is active and should be deactivated
 
-			// deactivate stream first
-			err = deactivateStream(ctx, client, logger, streamID)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			// wait until stream is deactivated
-			logger.Debugf("waiting for stream #%d deactivation", streamID)
-			_, err = waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusDeactivated)
+			// deactivate stream first, it waits until the stream is deactivated
+			err = deactivateStream(ctx, client, logger, streamID, pollInterval)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -896,15 +557,8 @@ func resourceDatastreamUpdate(ctx context.Context, d *schema.ResourceData, m int
 		if newActive {
 			//stream is inactive and should be activated
 
-			// activate stream first
-			err = activateStream(ctx, client, logger, streamID)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			// wait until stream is deactivated
-			logger.Debugf("waiting for stream #%d activation", streamID)
-			_, err = waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusActivated)
+			// activate stream, it waits until the stream is activated if wait_for_activation is set
+			err = activateStream(ctx, client, logger, streamID, pollInterval, wait)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -971,9 +625,14 @@ func updateStream(ctx context.Context, client datastream.DS, logger log.Interfac
 		}
 		templateName := datastream.TemplateName(templateNameStr)
 
-		connectors, err := GetConnectors(d, ExactlyOneConnectorRule)
-		if err != nil {
-			return err
+		// The state keeps only fingerprints of the connector secrets, so the connector is sent only when its
+		// block changed. The secrets are then read from the configuration
+		var connectors []datastream.AbstractConnector
+		if d.HasChanges(ExactlyOneConnectorRule...) {
+			connectors, err = GetConnectors(d, ExactlyOneConnectorRule)
+			if err != nil {
+				return err
+			}
 		}
 
 		req := datastream.UpdateStreamRequest{
@@ -1001,7 +660,7 @@ func updateStream(ctx context.Context, client datastream.DS, logger log.Interfac
 	return nil
 }
 
-func deactivateStream(ctx context.Context, client datastream.DS, logger log.Interface, streamID int64) error {
+func deactivateStream(ctx context.Context, client datastream.DS, logger log.Interface, streamID int64, pollInterval time.Duration) error {
 	logger.Debug("deactivating stream")
 	_, err := client.DeactivateStream(ctx, datastream.DeactivateStreamRequest{
 		StreamID: streamID,
@@ -1011,11 +670,11 @@ func deactivateStream(ctx context.Context, client datastream.DS, logger log.Inte
 	}
 
 	logger.Debugf("waiting for the stream #%d to be deactivated", streamID)
-	_, err = waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusDeactivated)
+	_, err = waitForStreamStatusChange(ctx, client, streamID, pollInterval, datastream.ActivationStatusDeactivated)
 	return err
 }
 
-func activateStream(ctx context.Context, client datastream.DS, logger log.Interface, streamID int64) error {
+func activateStream(ctx context.Context, client datastream.DS, logger log.Interface, streamID int64, pollInterval time.Duration, wait bool) error {
 	logger.Debug("activating stream")
 	_, err := client.ActivateStream(ctx, datastream.ActivateStreamRequest{
 		StreamID: streamID,
@@ -1024,8 +683,12 @@ func activateStream(ctx context.Context, client datastream.DS, logger log.Interf
 		return err
 	}
 
+	if !wait {
+		return nil
+	}
+
 	logger.Debugf("waiting for the stream #%d to be activated", streamID)
-	_, err = waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusActivated)
+	_, err = waitForStreamStatusChange(ctx, client, streamID, pollInterval, datastream.ActivationStatusActivated)
 	return err
 }
 
@@ -1046,6 +709,8 @@ func resourceDatastreamDelete(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	pollInterval := getPollInterval(d)
+
 	streamDetails, err := client.GetStream(ctx, datastream.GetStreamRequest{
 		StreamID: streamID,
 	})
@@ -1059,7 +724,7 @@ func resourceDatastreamDelete(ctx context.Context, d *schema.ResourceData, m int
 
 	// if stream is activating we have to wait until activation finishes
 	if activationStatus == datastream.ActivationStatusActivating {
-		_, err := waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusActivated)
+		_, err := waitForStreamStatusChange(ctx, client, streamID, pollInterval, datastream.ActivationStatusActivated)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1081,7 +746,7 @@ func resourceDatastreamDelete(ctx context.Context, d *schema.ResourceData, m int
 
 	// if stream is deactivating phase - wait until it completes
 	if activationStatus == datastream.ActivationStatusDeactivating {
-		_, err := waitForStreamStatusChange(ctx, client, streamID, datastream.ActivationStatusDeactivated)
+		_, err := waitForStreamStatusChange(ctx, client, streamID, pollInterval, datastream.ActivationStatusDeactivated)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1104,7 +769,49 @@ func resourceDatastreamDelete(ctx context.Context, d *schema.ResourceData, m int
 	return nil
 }
 
-func waitForStreamStatusChange(ctx context.Context, client datastream.DS, streamID int64, expectedStatuses ...datastream.ActivationStatus) (*datastream.ActivationStatus, error) {
+// resourceDatastreamImport imports the stream by its ID or, with the property:<property_id> import ID,
+// the stream which collects logs of the property
+func resourceDatastreamImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Datastream", "resourceDatastreamImport")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	if !strings.HasPrefix(d.Id(), "property:") {
+		return []*schema.ResourceData{d}, nil
+	}
+	propertyID, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(d.Id(), "property:"), "prp_"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid property ID in import ID %q: %s", d.Id(), err)
+	}
+
+	client := inst.Client(meta)
+	logger.Debugf("Importing the stream of property %d", propertyID)
+	streams, err := client.ListStreams(ctx, ListStreamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	streamIDs := make([]string, 0)
+	for _, stream := range streams {
+		if streamHasProperty(stream, propertyID) {
+			streamIDs = append(streamIDs, strconv.FormatInt(stream.StreamID, 10))
+		}
+	}
+	switch len(streamIDs) {
+	case 0:
+		return nil, fmt.Errorf("no stream collects logs of property %d", propertyID)
+	case 1:
+		d.SetId(streamIDs[0])
+		return []*schema.ResourceData{d}, nil
+	default:
+		return nil, fmt.Errorf("property %d is used by several streams, import one of them by its ID: %s", propertyID, strings.Join(streamIDs, ", "))
+	}
+}
+
+func waitForStreamStatusChange(ctx context.Context, client datastream.DS, streamID int64, pollInterval time.Duration, expectedStatuses ...datastream.ActivationStatus) (*datastream.ActivationStatus, error) {
 	expectedStatusesMap := map[datastream.ActivationStatus]bool{}
 	for _, status := range expectedStatuses {
 		expectedStatusesMap[status] = true
@@ -1122,7 +829,7 @@ func waitForStreamStatusChange(ctx context.Context, client datastream.DS, stream
 	_, ok := expectedStatusesMap[streamDetails.ActivationStatus]
 	for ; !ok; _, ok = expectedStatusesMap[streamDetails.ActivationStatus] {
 		select {
-		case <-time.After(PollForActivationStatusChangeInterval):
+		case <-time.After(pollInterval):
 			streamDetails, err = client.GetStream(ctx, getStreamReq)
 			if err != nil {
 				return nil, err
@@ -1136,6 +843,15 @@ func waitForStreamStatusChange(ctx context.Context, client datastream.DS, stream
 	return &streamDetails.ActivationStatus, nil
 }
 
+// getPollInterval returns the interval of polling for stream status set in the resource or the default one
+func getPollInterval(d *schema.ResourceData) time.Duration {
+	pollInterval, err := tools.GetIntValue("poll_interval", d)
+	if err != nil {
+		return PollForActivationStatusChangeInterval
+	}
+	return time.Duration(pollInterval) * time.Second
+}
+
 func urlSuppressor(key string) schema.SchemaDiffSuppressFunc {
 	return func(k string, _ string, _ string, d *schema.ResourceData) bool {
 		connectorName := strings.Split(k, ".")[0]
@@ -0,0 +1,139 @@
+package datastream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDatastreamActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatastreamActivationCreate,
+		ReadContext:   resourceDatastreamActivationRead,
+		DeleteContext: resourceDatastreamActivationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &DatastreamResourceTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"stream_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifies the stream to wait for",
+			},
+			"stream_version_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Identifies the version of the stream. A change of the version makes the resource wait for the stream again",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the stream is expected to end up activated or deactivated",
+			},
+			"poll_interval": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "The interval in seconds between checks of the stream activation status. Defaults to 10 minutes",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The activation status of the stream",
+			},
+		},
+	}
+}
+
+func resourceDatastreamActivationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Datastream", "resourceDatastreamActivationCreate")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	client := inst.Client(meta)
+	logger.Debug("Waiting for stream activation")
+
+	streamID, err := tools.GetIntValue("stream_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	active, err := tools.GetBoolValue("active", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expectedStatus := datastream.ActivationStatusDeactivated
+	if active {
+		expectedStatus = datastream.ActivationStatusActivated
+	}
+
+	logger.Debugf("waiting for stream #%d to leave activating/deactivating state", streamID)
+	status, err := waitForStreamStatusChange(ctx, client, int64(streamID), getPollInterval(d),
+		datastream.ActivationStatusActivated,
+		datastream.ActivationStatusDeactivated,
+		datastream.ActivationStatusInactive,
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if *status != expectedStatus && !(expectedStatus == datastream.ActivationStatusDeactivated && *status == datastream.ActivationStatusInactive) {
+		return diag.FromErr(fmt.Errorf("stream %d finished in %s status, expected %s", streamID, *status, expectedStatus))
+	}
+
+	d.SetId(strconv.Itoa(streamID))
+	return resourceDatastreamActivationRead(ctx, d, m)
+}
+
+func resourceDatastreamActivationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Datastream", "resourceDatastreamActivationRead")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	client := inst.Client(meta)
+	logger.Debug("Reading stream activation status")
+
+	streamID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	streamDetails, err := client.GetStream(ctx, datastream.GetStreamRequest{
+		StreamID: streamID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("status", streamDetails.ActivationStatus); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatastreamActivationDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// the resource only tracks the activation, the stream itself is managed by akamai_datastream
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,93 @@
+package datastream
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResourceDatastreamActivation(t *testing.T) {
+	PollForActivationStatusChangeInterval = 1 * time.Millisecond
+
+	getStreamRequest := datastream.GetStreamRequest{
+		StreamID: 12321,
+	}
+	streamWithStatus := func(status datastream.ActivationStatus) *datastream.DetailedStreamVersion {
+		return &datastream.DetailedStreamVersion{
+			StreamID:         12321,
+			StreamVersionID:  2,
+			ActivationStatus: status,
+		}
+	}
+
+	tests := map[string]struct {
+		tfFile    string
+		init      func(*mockdatastream)
+		check     resource.TestCheckFunc
+		withError *regexp.Regexp
+	}{
+		"wait for activation": {
+			tfFile: "testdata/TestResourceDatastreamActivation/activation.tf",
+			init: func(m *mockdatastream) {
+				m.On("GetStream", mock.Anything, getStreamRequest).
+					Return(streamWithStatus(datastream.ActivationStatusActivating), nil).
+					Once()
+				m.On("GetStream", mock.Anything, getStreamRequest).
+					Return(streamWithStatus(datastream.ActivationStatusActivated), nil)
+			},
+			check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("akamai_datastream_activation.a", "id", "12321"),
+				resource.TestCheckResourceAttr("akamai_datastream_activation.a", "status", string(datastream.ActivationStatusActivated)),
+			),
+		},
+		"wait for deactivation": {
+			tfFile: "testdata/TestResourceDatastreamActivation/deactivation.tf",
+			init: func(m *mockdatastream) {
+				m.On("GetStream", mock.Anything, getStreamRequest).
+					Return(streamWithStatus(datastream.ActivationStatusDeactivating), nil).
+					Once()
+				m.On("GetStream", mock.Anything, getStreamRequest).
+					Return(streamWithStatus(datastream.ActivationStatusDeactivated), nil)
+			},
+			check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("akamai_datastream_activation.a", "id", "12321"),
+				resource.TestCheckResourceAttr("akamai_datastream_activation.a", "status", string(datastream.ActivationStatusDeactivated)),
+			),
+		},
+		"stream ends up in unexpected status": {
+			tfFile: "testdata/TestResourceDatastreamActivation/activation.tf",
+			init: func(m *mockdatastream) {
+				m.On("GetStream", mock.Anything, getStreamRequest).
+					Return(streamWithStatus(datastream.ActivationStatusDeactivated), nil).
+					Once()
+			},
+			withError: regexp.MustCompile("stream 12321 finished in DEACTIVATED status, expected ACTIVATED"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockdatastream{}
+			test.init(client)
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:      loadFixtureString(test.tfFile),
+							Check:       test.check,
+							ExpectError: test.withError,
+						},
+					},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package datastream
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SchemaVersion 0 of the stream resource, which kept connector secrets in plain text -- this is referenced in migrations
+// to SchemaVersion 1. SchemaVersion 1 adds new connectors and attributes, the attributes below are unchanged
+func resourceDatastreamV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"active": {Type: schema.TypeBool, Required: true},
+			"config": {
+				Type:     schema.TypeSet,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delimiter": {Type: schema.TypeString, Optional: true},
+						"format":    {Type: schema.TypeString, Required: true},
+						"frequency": {
+							Type:     schema.TypeSet,
+							MaxItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time_in_sec": {Type: schema.TypeInt, Required: true},
+								},
+							},
+						},
+						"upload_file_prefix": {Type: schema.TypeString, Optional: true},
+						"upload_file_suffix": {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+			"contract_id":        {Type: schema.TypeString, Required: true},
+			"created_by":         {Type: schema.TypeString, Computed: true},
+			"created_date":       {Type: schema.TypeString, Computed: true},
+			"dataset_fields_ids": {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+			"email_ids":          {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"group_id":           {Type: schema.TypeString, Required: true},
+			"group_name":         {Type: schema.TypeString, Computed: true},
+			"modified_by":        {Type: schema.TypeString, Computed: true},
+			"modified_date":      {Type: schema.TypeString, Computed: true},
+			"papi_json":          {Type: schema.TypeString, Computed: true},
+			"product_id":         {Type: schema.TypeString, Computed: true},
+			"product_name":       {Type: schema.TypeString, Computed: true},
+			"property_ids":       {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"stream_name":        {Type: schema.TypeString, Required: true},
+			"stream_type":        {Type: schema.TypeString, Required: true},
+			"stream_version_id":  {Type: schema.TypeInt, Computed: true},
+			"template_name":      {Type: schema.TypeString, Required: true},
+
+			// Secrets are replaced with their fingerprints in SchemaVersion 1
+			"s3_connector": connectorV0(map[string]*schema.Schema{
+				"access_key":        {Type: schema.TypeString, Required: true, Sensitive: true},
+				"bucket":            {Type: schema.TypeString, Required: true},
+				"compress_logs":     {Type: schema.TypeBool, Computed: true},
+				"connector_id":      {Type: schema.TypeInt, Computed: true},
+				"connector_name":    {Type: schema.TypeString, Required: true},
+				"path":              {Type: schema.TypeString, Required: true},
+				"region":            {Type: schema.TypeString, Required: true},
+				"secret_access_key": {Type: schema.TypeString, Required: true, Sensitive: true},
+			}),
+			"azure_connector": connectorV0(map[string]*schema.Schema{
+				"access_key":     {Type: schema.TypeString, Required: true, Sensitive: true},
+				"account_name":   {Type: schema.TypeString, Required: true},
+				"compress_logs":  {Type: schema.TypeBool, Computed: true},
+				"connector_id":   {Type: schema.TypeInt, Computed: true},
+				"connector_name": {Type: schema.TypeString, Required: true},
+				"container_name": {Type: schema.TypeString, Required: true},
+				"path":           {Type: schema.TypeString, Required: true},
+			}),
+			"datadog_connector": connectorV0(map[string]*schema.Schema{
+				"auth_token":     {Type: schema.TypeString, Required: true, Sensitive: true},
+				"compress_logs":  {Type: schema.TypeBool, Optional: true},
+				"connector_id":   {Type: schema.TypeInt, Computed: true},
+				"connector_name": {Type: schema.TypeString, Required: true},
+				"service":        {Type: schema.TypeString, Optional: true},
+				"source":         {Type: schema.TypeString, Optional: true},
+				"tags":           {Type: schema.TypeString, Optional: true},
+				"url":            {Type: schema.TypeString, Required: true},
+			}),
+			"splunk_connector": connectorV0(map[string]*schema.Schema{
+				"compress_logs":         {Type: schema.TypeBool, Optional: true},
+				"connector_id":          {Type: schema.TypeInt, Computed: true},
+				"connector_name":        {Type: schema.TypeString, Required: true},
+				"event_collector_token": {Type: schema.TypeString, Required: true, Sensitive: true},
+				"url":                   {Type: schema.TypeString, Required: true},
+			}),
+			"gcs_connector": connectorV0(map[string]*schema.Schema{
+				"bucket":               {Type: schema.TypeString, Required: true},
+				"compress_logs":        {Type: schema.TypeBool, Computed: true},
+				"connector_id":         {Type: schema.TypeInt, Computed: true},
+				"connector_name":       {Type: schema.TypeString, Required: true},
+				"path":                 {Type: schema.TypeString, Optional: true},
+				"private_key":          {Type: schema.TypeString, Required: true, Sensitive: true},
+				"project_id":           {Type: schema.TypeString, Required: true},
+				"service_account_name": {Type: schema.TypeString, Required: true},
+			}),
+			"https_connector": connectorV0(map[string]*schema.Schema{
+				"authentication_type": {Type: schema.TypeString, Required: true},
+				"compress_logs":       {Type: schema.TypeBool, Optional: true},
+				"connector_id":        {Type: schema.TypeInt, Computed: true},
+				"connector_name":      {Type: schema.TypeString, Required: true},
+				"password":            {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"url":                 {Type: schema.TypeString, Required: true},
+				"user_name":           {Type: schema.TypeString, Optional: true, Sensitive: true},
+			}),
+			"sumologic_connector": connectorV0(map[string]*schema.Schema{
+				"collector_code": {Type: schema.TypeString, Required: true, Sensitive: true},
+				"compress_logs":  {Type: schema.TypeBool, Optional: true},
+				"connector_id":   {Type: schema.TypeInt, Computed: true},
+				"connector_name": {Type: schema.TypeString, Required: true},
+				"endpoint":       {Type: schema.TypeString, Required: true},
+			}),
+			"oracle_connector": connectorV0(map[string]*schema.Schema{
+				"access_key":        {Type: schema.TypeString, Required: true, Sensitive: true},
+				"bucket":            {Type: schema.TypeString, Required: true},
+				"compress_logs":     {Type: schema.TypeBool, Computed: true},
+				"connector_id":      {Type: schema.TypeInt, Computed: true},
+				"connector_name":    {Type: schema.TypeString, Required: true},
+				"namespace":         {Type: schema.TypeString, Required: true},
+				"path":              {Type: schema.TypeString, Required: true},
+				"region":            {Type: schema.TypeString, Required: true},
+				"secret_access_key": {Type: schema.TypeString, Required: true, Sensitive: true},
+			}),
+		},
+	}
+}
+
+// connectorV0 returns the SchemaVersion 0 block of a connector with given attributes
+func connectorV0(attrs map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		MaxItems: 1,
+		Optional: true,
+		Elem:     &schema.Resource{Schema: attrs},
+	}
+}
+
+// upgradeDatastreamV0 replaces connector secrets stored in plain text with their fingerprints.
+// Streams created before wait_for_activation was introduced always waited for the activation, so it is set to its default
+// to avoid a diff on the first plan
+func upgradeDatastreamV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState["wait_for_activation"] == nil {
+		rawState["wait_for_activation"] = true
+	}
+	return hashConnectorSecrets(rawState), nil
+}
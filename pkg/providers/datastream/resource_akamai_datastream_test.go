@@ -11,6 +11,7 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/datastream/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -348,12 +349,12 @@ func TestResourceStream(t *testing.T) {
 							resource.TestCheckResourceAttr("akamai_datastream.s", "stream_type", string(datastream.StreamTypeRawLogs)),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "template_name", string(datastream.TemplateNameEdgeLogs)),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.#", "1"),
-							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.access_key", "s3_test_access_key"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.access_key", hashSecret("s3_test_access_key")),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.bucket", "s3_test_bucket"),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.connector_name", "s3_test_connector_name"),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.path", "s3_test_path"),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.region", "s3_test_region"),
-							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.secret_access_key", "s3_test_secret_key"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.secret_access_key", hashSecret("s3_test_secret_key")),
 						),
 					},
 					{
@@ -383,12 +384,299 @@ func TestResourceStream(t *testing.T) {
 							resource.TestCheckResourceAttr("akamai_datastream.s", "stream_type", string(datastream.StreamTypeRawLogs)),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "template_name", string(datastream.TemplateNameEdgeLogs)),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.#", "1"),
-							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.access_key", "s3_test_access_key"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.access_key", hashSecret("s3_test_access_key")),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.bucket", "s3_test_bucket_updated"),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.connector_name", "s3_test_connector_name_updated"),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.path", "s3_test_path"),
 							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.region", "s3_test_region"),
-							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.secret_access_key", "s3_test_secret_key"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.secret_access_key", hashSecret("s3_test_secret_key")),
+						),
+					},
+				},
+			})
+
+			client.AssertExpectations(t)
+		})
+	})
+
+	t.Run("update without connector changes", func(t *testing.T) {
+		client := &mockdatastream{}
+
+		streamID := int64(12321)
+
+		streamConfiguration := datastream.StreamConfiguration{
+			ActivateNow: false,
+			Config: datastream.Config{
+				Delimiter: datastream.DelimiterTypePtr(datastream.DelimiterTypeSpace),
+				Format:    datastream.FormatTypeStructured,
+				Frequency: datastream.Frequency{
+					TimeInSec: datastream.TimeInSec30,
+				},
+				UploadFilePrefix: "pre",
+				UploadFileSuffix: "suf",
+			},
+			Connectors: []datastream.AbstractConnector{
+				&datastream.S3Connector{
+					AccessKey:       "s3_test_access_key",
+					Bucket:          "s3_test_bucket",
+					ConnectorName:   "s3_test_connector_name",
+					Path:            "s3_test_path",
+					Region:          "s3_test_region",
+					SecretAccessKey: "s3_test_secret_key",
+				},
+			},
+			ContractID:      "test_contract",
+			DatasetFieldIDs: []int{1001, 1002, 2000, 2001},
+			EmailIDs:        "test_email1@akamai.com,test_email2@akamai.com",
+			GroupID:         tools.IntPtr(1337),
+			PropertyIDs:     []int{1, 2, 3},
+			StreamName:      "test_stream",
+			StreamType:      datastream.StreamTypeRawLogs,
+			TemplateName:    datastream.TemplateNameEdgeLogs,
+		}
+
+		// the connector is not sent, as only fingerprints of its secrets are known
+		updateStreamRequest := datastream.UpdateStreamRequest{
+			StreamID: streamID,
+			StreamConfiguration: datastream.StreamConfiguration{
+				Config:          streamConfiguration.Config,
+				ContractID:      streamConfiguration.ContractID,
+				DatasetFieldIDs: streamConfiguration.DatasetFieldIDs,
+				EmailIDs:        streamConfiguration.EmailIDs,
+				PropertyIDs:     streamConfiguration.PropertyIDs,
+				StreamName:      "test_stream_renamed",
+				StreamType:      streamConfiguration.StreamType,
+				TemplateName:    streamConfiguration.TemplateName,
+			},
+		}
+
+		streamVersionKey := datastream.StreamVersionKey{
+			StreamID:        streamID,
+			StreamVersionID: 1,
+		}
+
+		getStreamResponse := &datastream.DetailedStreamVersion{
+			ActivationStatus: datastream.ActivationStatusInactive,
+			Config:           streamConfiguration.Config,
+			Connectors: []datastream.ConnectorDetails{
+				{
+					Bucket:        "s3_test_bucket",
+					ConnectorType: datastream.ConnectorTypeS3,
+					ConnectorName: "s3_test_connector_name",
+					Path:          "s3_test_path",
+					Region:        "s3_test_region",
+				},
+			},
+			ContractID: streamConfiguration.ContractID,
+			Datasets: []datastream.DataSets{
+				{
+					DatasetFields: []datastream.DatasetFields{
+						{DatasetFieldID: 1001, Order: 0},
+						{DatasetFieldID: 1002, Order: 1},
+						{DatasetFieldID: 2000, Order: 2},
+						{DatasetFieldID: 2001, Order: 3},
+					},
+				},
+			},
+			EmailIDs: streamConfiguration.EmailIDs,
+			GroupID:  *streamConfiguration.GroupID,
+			Properties: []datastream.Property{
+				{PropertyID: 1, PropertyName: "property_1"},
+				{PropertyID: 2, PropertyName: "property_2"},
+				{PropertyID: 3, PropertyName: "property_3"},
+			},
+			StreamID:        streamID,
+			StreamName:      streamConfiguration.StreamName,
+			StreamType:      streamConfiguration.StreamType,
+			StreamVersionID: streamVersionKey.StreamVersionID,
+			TemplateName:    streamConfiguration.TemplateName,
+		}
+
+		getStreamResponseRenamed := *getStreamResponse
+		getStreamResponseRenamed.StreamName = updateStreamRequest.StreamConfiguration.StreamName
+
+		client.On("CreateStream", mock.Anything, datastream.CreateStreamRequest{StreamConfiguration: streamConfiguration}).
+			Return(&datastream.StreamUpdate{StreamVersionKey: streamVersionKey}, nil).Once()
+
+		getStream := client.On("GetStream", mock.Anything, datastream.GetStreamRequest{StreamID: streamID}).
+			Return(getStreamResponse, nil)
+
+		client.On("UpdateStream", mock.Anything, updateStreamRequest).
+			Return(&datastream.StreamUpdate{StreamVersionKey: streamVersionKey}, nil).
+			Run(func(mock.Arguments) {
+				getStream.ReturnArguments = mock.Arguments{&getStreamResponseRenamed, nil}
+			}).Once()
+
+		client.On("ListStreams", mock.Anything, ListStreamsRequest{}).
+			Return([]StreamDetails{
+				{StreamID: 7050, Properties: []datastream.Property{{PropertyID: 5}}},
+				{StreamID: streamID, Properties: getStreamResponse.Properties},
+			}, nil).Once()
+
+		client.On("DeleteStream", mock.Anything, datastream.DeleteStreamRequest{
+			StreamID: streamID,
+		}).Return(&datastream.DeleteStreamResponse{Message: "Success"}, nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResourceStream/update_resource/create_stream.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_datastream.s", "stream_name", "test_stream"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.access_key", hashSecret("s3_test_access_key")),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.secret_access_key", hashSecret("s3_test_secret_key")),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResourceStream/update_resource/update_stream_name.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_datastream.s", "stream_name", "test_stream_renamed"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.access_key", hashSecret("s3_test_access_key")),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "s3_connector.0.secret_access_key", hashSecret("s3_test_secret_key")),
+						),
+					},
+					{
+						ResourceName:  "akamai_datastream.s",
+						ImportState:   true,
+						ImportStateId: "property:prp_2",
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if len(states) != 1 || states[0].ID != strconv.FormatInt(streamID, 10) {
+								return fmt.Errorf("expected stream %d to be imported", streamID)
+							}
+							return nil
+						},
+					},
+				},
+			})
+
+			client.AssertExpectations(t)
+		})
+	})
+
+	t.Run("create without waiting for activation", func(t *testing.T) {
+		client := &mockdatastream{}
+
+		PollForActivationStatusChangeInterval = 1 * time.Millisecond
+
+		streamID := int64(12321)
+
+		streamConfiguration := datastream.StreamConfiguration{
+			ActivateNow: true,
+			Config: datastream.Config{
+				Delimiter: datastream.DelimiterTypePtr(datastream.DelimiterTypeSpace),
+				Format:    datastream.FormatTypeStructured,
+				Frequency: datastream.Frequency{
+					TimeInSec: datastream.TimeInSec30,
+				},
+				UploadFilePrefix: "pre",
+				UploadFileSuffix: "suf",
+			},
+			Connectors: []datastream.AbstractConnector{
+				&datastream.S3Connector{
+					AccessKey:       "s3_test_access_key",
+					Bucket:          "s3_test_bucket",
+					ConnectorName:   "s3_test_connector_name",
+					Path:            "s3_test_path",
+					Region:          "s3_test_region",
+					SecretAccessKey: "s3_test_secret_key",
+				},
+			},
+			ContractID:      "test_contract",
+			DatasetFieldIDs: []int{1001, 1002, 2000, 2001},
+			EmailIDs:        "test_email1@akamai.com,test_email2@akamai.com",
+			GroupID:         tools.IntPtr(1337),
+			PropertyIDs:     []int{1, 2, 3},
+			StreamName:      "test_stream",
+			StreamType:      datastream.StreamTypeRawLogs,
+			TemplateName:    datastream.TemplateNameEdgeLogs,
+		}
+
+		streamVersionKey := datastream.StreamVersionKey{
+			StreamID:        streamID,
+			StreamVersionID: 1,
+		}
+
+		getStreamResponse := &datastream.DetailedStreamVersion{
+			ActivationStatus: datastream.ActivationStatusActivating,
+			Config:           streamConfiguration.Config,
+			Connectors: []datastream.ConnectorDetails{
+				{
+					Bucket:        "s3_test_bucket",
+					ConnectorType: datastream.ConnectorTypeS3,
+					ConnectorName: "s3_test_connector_name",
+					Path:          "s3_test_path",
+					Region:        "s3_test_region",
+				},
+			},
+			ContractID: streamConfiguration.ContractID,
+			Datasets: []datastream.DataSets{
+				{
+					DatasetFields: []datastream.DatasetFields{
+						{DatasetFieldID: 1001, Order: 0},
+						{DatasetFieldID: 1002, Order: 1},
+						{DatasetFieldID: 2000, Order: 2},
+						{DatasetFieldID: 2001, Order: 3},
+					},
+				},
+			},
+			EmailIDs: streamConfiguration.EmailIDs,
+			GroupID:  *streamConfiguration.GroupID,
+			Properties: []datastream.Property{
+				{PropertyID: 1, PropertyName: "property_1"},
+				{PropertyID: 2, PropertyName: "property_2"},
+				{PropertyID: 3, PropertyName: "property_3"},
+			},
+			StreamID:        streamID,
+			StreamName:      streamConfiguration.StreamName,
+			StreamType:      streamConfiguration.StreamType,
+			StreamVersionID: streamVersionKey.StreamVersionID,
+			TemplateName:    streamConfiguration.TemplateName,
+		}
+
+		getStreamResponseActivated := *getStreamResponse
+		getStreamResponseActivated.ActivationStatus = datastream.ActivationStatusActivated
+		getStreamResponseDeactivated := *getStreamResponse
+		getStreamResponseDeactivated.ActivationStatus = datastream.ActivationStatusDeactivated
+
+		client.On("CreateStream", mock.Anything, datastream.CreateStreamRequest{StreamConfiguration: streamConfiguration}).
+			Return(&datastream.StreamUpdate{StreamVersionKey: streamVersionKey}, nil).Once()
+
+		// the stream keeps activating until the second step
+		getStream := client.On("GetStream", mock.Anything, datastream.GetStreamRequest{StreamID: streamID}).
+			Return(getStreamResponse, nil)
+
+		client.On("DeactivateStream", mock.Anything, datastream.DeactivateStreamRequest{StreamID: streamID}).
+			Return(&datastream.DeactivateStreamResponse{StreamVersionKey: streamVersionKey}, nil).
+			Run(func(mock.Arguments) {
+				getStream.ReturnArguments = mock.Arguments{&getStreamResponseDeactivated, nil}
+			}).Once()
+
+		client.On("DeleteStream", mock.Anything, datastream.DeleteStreamRequest{
+			StreamID: streamID,
+		}).Return(&datastream.DeleteStreamResponse{Message: "Success"}, nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						// the plan after the apply is empty, as the activating stream is reported as active
+						Config: loadFixtureString("testdata/TestResourceStream/no_wait/create_stream.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_datastream.s", "active", "true"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "activation_status", string(datastream.ActivationStatusActivating)),
+						),
+					},
+					{
+						PreConfig: func() {
+							getStream.ReturnArguments = mock.Arguments{&getStreamResponseActivated, nil}
+						},
+						Config: loadFixtureString("testdata/TestResourceStream/no_wait/create_stream.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_datastream.s", "active", "true"),
+							resource.TestCheckResourceAttr("akamai_datastream.s", "activation_status", string(datastream.ActivationStatusActivated)),
 						),
 					},
 				},
@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_datastream" "test" {
+  stream_id = 7050
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_datastream" "test" {
+  stream_id = 7050
+  version   = 2
+}
@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_datastreams" "test" {
+  contract_id = "ctr_contract_2"
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_datastreams" "test" {
+  group_id    = 1337
+  property_id = 2
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_datastream_log_schema" "test" {
+  template_name      = "EDGE_LOGS"
+  dataset_fields_ids = [1000, 1006]
+  format             = "JSON"
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_datastream_log_schema" "test" {
+  dataset_fields_ids = [1006, 1000, 1002]
+  format             = "STRUCTURED"
+  delimiter          = "SPACE"
+}
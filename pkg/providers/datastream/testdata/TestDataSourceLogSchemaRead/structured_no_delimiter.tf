@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_datastream_log_schema" "test" {
+  dataset_fields_ids = [1000]
+  format             = "STRUCTURED"
+}
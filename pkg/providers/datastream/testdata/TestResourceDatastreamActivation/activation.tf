@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_datastream_activation" "a" {
+  stream_id         = 12321
+  stream_version_id = 2
+  poll_interval     = 1
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_datastream_activation" "a" {
+  stream_id = 12321
+  active    = false
+}
@@ -14,6 +14,98 @@ type IAM struct {
 	mock.Mock
 }
 
+// CreateAPIClient provides a mock function with given fields: _a0, _a1
+func (_m *IAM) CreateAPIClient(_a0 context.Context, _a1 CreateAPIClientRequest) (*APIClient, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *APIClient
+	if rf, ok := ret.Get(0).(func(context.Context, CreateAPIClientRequest) *APIClient); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*APIClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateAPIClientRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCredential provides a mock function with given fields: _a0, _a1
+func (_m *IAM) CreateCredential(_a0 context.Context, _a1 CreateCredentialRequest) (*Credential, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Credential
+	if rf, ok := ret.Get(0).(func(context.Context, CreateCredentialRequest) *Credential); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Credential)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateCredentialRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroup provides a mock function with given fields: _a0, _a1
+func (_m *IAM) CreateGroup(_a0 context.Context, _a1 CreateGroupRequest) (*iam.Group, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *iam.Group
+	if rf, ok := ret.Get(0).(func(context.Context, CreateGroupRequest) *iam.Group); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CreateGroupRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRole provides a mock function with given fields: _a0, _a1
+func (_m *IAM) CreateRole(_a0 context.Context, _a1 RoleRequest) (*iam.Role, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *iam.Role
+	if rf, ok := ret.Get(0).(func(context.Context, RoleRequest) *iam.Role); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, RoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateUser provides a mock function with given fields: _a0, _a1
 func (_m *IAM) CreateUser(_a0 context.Context, _a1 iam.CreateUserRequest) (*iam.User, error) {
 	ret := _m.Called(_a0, _a1)
@@ -37,6 +129,140 @@ func (_m *IAM) CreateUser(_a0 context.Context, _a1 iam.CreateUserRequest) (*iam.
 	return r0, r1
 }
 
+// DeleteAPIClient provides a mock function with given fields: _a0, _a1
+func (_m *IAM) DeleteAPIClient(_a0 context.Context, _a1 DeleteAPIClientRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteAPIClientRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCredential provides a mock function with given fields: _a0, _a1
+func (_m *IAM) DeleteCredential(_a0 context.Context, _a1 CredentialRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CredentialRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRole provides a mock function with given fields: _a0, _a1
+func (_m *IAM) DeleteRole(_a0 context.Context, _a1 DeleteRoleRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteRoleRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAPIClient provides a mock function with given fields: _a0, _a1
+func (_m *IAM) GetAPIClient(_a0 context.Context, _a1 GetAPIClientRequest) (*APIClient, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *APIClient
+	if rf, ok := ret.Get(0).(func(context.Context, GetAPIClientRequest) *APIClient); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*APIClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, GetAPIClientRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCredential provides a mock function with given fields: _a0, _a1
+func (_m *IAM) GetCredential(_a0 context.Context, _a1 CredentialRequest) (*Credential, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Credential
+	if rf, ok := ret.Get(0).(func(context.Context, CredentialRequest) *Credential); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Credential)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, CredentialRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGroup provides a mock function with given fields: _a0, _a1
+func (_m *IAM) GetGroup(_a0 context.Context, _a1 GetGroupRequest) (*iam.Group, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *iam.Group
+	if rf, ok := ret.Get(0).(func(context.Context, GetGroupRequest) *iam.Group); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, GetGroupRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRole provides a mock function with given fields: _a0, _a1
+func (_m *IAM) GetRole(_a0 context.Context, _a1 GetRoleRequest) (*iam.Role, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *iam.Role
+	if rf, ok := ret.Get(0).(func(context.Context, GetRoleRequest) *iam.Role); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, GetRoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUser provides a mock function with given fields: _a0, _a1
 func (_m *IAM) GetUser(_a0 context.Context, _a1 iam.GetUserRequest) (*iam.User, error) {
 	ret := _m.Called(_a0, _a1)
@@ -60,6 +286,29 @@ func (_m *IAM) GetUser(_a0 context.Context, _a1 iam.GetUserRequest) (*iam.User,
 	return r0, r1
 }
 
+// ListBlockedProperties provides a mock function with given fields: _a0, _a1
+func (_m *IAM) ListBlockedProperties(_a0 context.Context, _a1 ListBlockedPropertiesRequest) ([]int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, ListBlockedPropertiesRequest) []int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ListBlockedPropertiesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListGroups provides a mock function with given fields: _a0, _a1
 func (_m *IAM) ListGroups(_a0 context.Context, _a1 iam.ListGroupsRequest) ([]iam.Group, error) {
 	ret := _m.Called(_a0, _a1)
@@ -175,6 +424,71 @@ func (_m *IAM) ListTimeoutPolicies(_a0 context.Context) ([]iam.TimeoutPolicy, er
 	return r0, r1
 }
 
+// ListUsers provides a mock function with given fields: _a0, _a1
+func (_m *IAM) ListUsers(_a0 context.Context, _a1 ListUsersRequest) ([]iam.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []iam.User
+	if rf, ok := ret.Get(0).(func(context.Context, ListUsersRequest) []iam.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]iam.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ListUsersRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockUser provides a mock function with given fields: _a0, _a1
+func (_m *IAM) LockUser(_a0 context.Context, _a1 LockUserRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, LockUserRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MoveGroup provides a mock function with given fields: _a0, _a1
+func (_m *IAM) MoveGroup(_a0 context.Context, _a1 MoveGroupRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, MoveGroupRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveGroup provides a mock function with given fields: _a0, _a1
+func (_m *IAM) RemoveGroup(_a0 context.Context, _a1 RemoveGroupRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, RemoveGroupRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveUser provides a mock function with given fields: _a0, _a1
 func (_m *IAM) RemoveUser(_a0 context.Context, _a1 iam.RemoveUserRequest) error {
 	ret := _m.Called(_a0, _a1)
@@ -189,6 +503,34 @@ func (_m *IAM) RemoveUser(_a0 context.Context, _a1 iam.RemoveUserRequest) error
 	return r0
 }
 
+// ResetUserPassword provides a mock function with given fields: _a0, _a1
+func (_m *IAM) ResetUserPassword(_a0 context.Context, _a1 ResetUserPasswordRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ResetUserPasswordRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetUserTFA provides a mock function with given fields: _a0, _a1
+func (_m *IAM) ResetUserTFA(_a0 context.Context, _a1 ResetUserTFARequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ResetUserTFARequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SupportedContactTypes provides a mock function with given fields: _a0
 func (_m *IAM) SupportedContactTypes(_a0 context.Context) ([]string, error) {
 	ret := _m.Called(_a0)
@@ -281,6 +623,135 @@ func (_m *IAM) SupportedTimezones(_a0 context.Context) ([]iam.Timezone, error) {
 	return r0, r1
 }
 
+// UnlockUser provides a mock function with given fields: _a0, _a1
+func (_m *IAM) UnlockUser(_a0 context.Context, _a1 UnlockUserRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, UnlockUserRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAPIClient provides a mock function with given fields: _a0, _a1
+func (_m *IAM) UpdateAPIClient(_a0 context.Context, _a1 UpdateAPIClientRequest) (*APIClient, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *APIClient
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateAPIClientRequest) *APIClient); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*APIClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateAPIClientRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBlockedProperties provides a mock function with given fields: _a0, _a1
+func (_m *IAM) UpdateBlockedProperties(_a0 context.Context, _a1 UpdateBlockedPropertiesRequest) ([]int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateBlockedPropertiesRequest) []int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateBlockedPropertiesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCredential provides a mock function with given fields: _a0, _a1
+func (_m *IAM) UpdateCredential(_a0 context.Context, _a1 UpdateCredentialRequest) (*Credential, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *Credential
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateCredentialRequest) *Credential); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Credential)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateCredentialRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateGroupName provides a mock function with given fields: _a0, _a1
+func (_m *IAM) UpdateGroupName(_a0 context.Context, _a1 UpdateGroupNameRequest) (*iam.Group, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *iam.Group
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateGroupNameRequest) *iam.Group); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateGroupNameRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRole provides a mock function with given fields: _a0, _a1
+func (_m *IAM) UpdateRole(_a0 context.Context, _a1 UpdateRoleRequest) (*iam.Role, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *iam.Role
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateRoleRequest) *iam.Role); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateRoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUserAuthGrants provides a mock function with given fields: _a0, _a1
 func (_m *IAM) UpdateUserAuthGrants(_a0 context.Context, _a1 iam.UpdateUserAuthGrantsRequest) ([]iam.AuthGrant, error) {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,1306 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// API is the IAM API interface used by the provider. It extends the edgegrid client with the group, role,
+	// blocked property, API client, user listing and user lifecycle requests which the client does not support yet
+	API interface {
+		iam.IAM
+
+		// CreateGroup creates a group nested in the parent group
+		CreateGroup(context.Context, CreateGroupRequest) (*iam.Group, error)
+
+		// GetGroup returns the group with given ID
+		GetGroup(context.Context, GetGroupRequest) (*iam.Group, error)
+
+		// UpdateGroupName renames the group
+		UpdateGroupName(context.Context, UpdateGroupNameRequest) (*iam.Group, error)
+
+		// MoveGroup moves the group, with its sub-groups, under another parent group
+		MoveGroup(context.Context, MoveGroupRequest) error
+
+		// RemoveGroup removes the group, which must not have sub-groups or users
+		RemoveGroup(context.Context, RemoveGroupRequest) error
+
+		// CreateRole creates a custom role from the granted roles
+		CreateRole(context.Context, RoleRequest) (*iam.Role, error)
+
+		// GetRole returns the role with given ID, including its granted roles
+		GetRole(context.Context, GetRoleRequest) (*iam.Role, error)
+
+		// UpdateRole updates the name, description and granted roles of a custom role
+		UpdateRole(context.Context, UpdateRoleRequest) (*iam.Role, error)
+
+		// DeleteRole deletes a custom role, which must not be assigned to any user
+		DeleteRole(context.Context, DeleteRoleRequest) error
+
+		// ListBlockedProperties returns the IDs of the properties the user can't access in the group
+		ListBlockedProperties(context.Context, ListBlockedPropertiesRequest) ([]int64, error)
+
+		// UpdateBlockedProperties replaces the properties the user can't access in the group
+		UpdateBlockedProperties(context.Context, UpdateBlockedPropertiesRequest) ([]int64, error)
+
+		// CreateAPIClient creates an API client
+		CreateAPIClient(context.Context, CreateAPIClientRequest) (*APIClient, error)
+
+		// GetAPIClient returns the API client with given ID, including its API and group access
+		GetAPIClient(context.Context, GetAPIClientRequest) (*APIClient, error)
+
+		// UpdateAPIClient replaces the settings of the API client
+		UpdateAPIClient(context.Context, UpdateAPIClientRequest) (*APIClient, error)
+
+		// DeleteAPIClient deletes the API client and its credentials
+		DeleteAPIClient(context.Context, DeleteAPIClientRequest) error
+
+		// CreateCredential creates a credential of the API client. The client secret is only returned here
+		CreateCredential(context.Context, CreateCredentialRequest) (*Credential, error)
+
+		// GetCredential returns the credential of the API client without the client secret
+		GetCredential(context.Context, CredentialRequest) (*Credential, error)
+
+		// UpdateCredential updates the description, expiration and status of the credential
+		UpdateCredential(context.Context, UpdateCredentialRequest) (*Credential, error)
+
+		// DeleteCredential deletes an inactive credential of the API client
+		DeleteCredential(context.Context, CredentialRequest) error
+
+		// ListUsers returns the users of the account, optionally only those in the group, with their grants
+		ListUsers(context.Context, ListUsersRequest) ([]iam.User, error)
+
+		// LockUser locks the user, who can no longer log in
+		LockUser(context.Context, LockUserRequest) error
+
+		// UnlockUser unlocks the user
+		UnlockUser(context.Context, UnlockUserRequest) error
+
+		// ResetUserTFA resets the two-factor authentication of the user, who has to configure it again on next login
+		ResetUserTFA(context.Context, ResetUserTFARequest) error
+
+		// ResetUserPassword sends the user an email with a link to set a new password
+		ResetUserPassword(context.Context, ResetUserPasswordRequest) error
+	}
+
+	// CreateGroupRequest contains params required to create a group
+	CreateGroupRequest struct {
+		ParentGroupID int64
+		GroupName     string
+	}
+
+	// GetGroupRequest contains params required to fetch a group
+	GetGroupRequest struct {
+		GroupID int64
+	}
+
+	// UpdateGroupNameRequest contains params required to rename a group
+	UpdateGroupNameRequest struct {
+		GroupID   int64
+		GroupName string
+	}
+
+	// MoveGroupRequest contains params required to move a group under another parent group
+	MoveGroupRequest struct {
+		SourceGroupID      int64 `json:"sourceGroupId"`
+		DestinationGroupID int64 `json:"destinationGroupId"`
+	}
+
+	// RemoveGroupRequest contains params required to remove a group
+	RemoveGroupRequest struct {
+		GroupID int64
+	}
+
+	// RoleRequest contains params required to create or update a custom role
+	RoleRequest struct {
+		Name         string
+		Description  string
+		GrantedRoles []int64
+	}
+
+	// GetRoleRequest contains params required to fetch a role
+	GetRoleRequest struct {
+		RoleID int64
+	}
+
+	// UpdateRoleRequest contains params required to update a custom role
+	UpdateRoleRequest struct {
+		RoleID int64
+		RoleRequest
+	}
+
+	// DeleteRoleRequest contains params required to delete a custom role
+	DeleteRoleRequest struct {
+		RoleID int64
+	}
+
+	// ListBlockedPropertiesRequest contains params required to list the properties blocked for a user in a group
+	ListBlockedPropertiesRequest struct {
+		IdentityID string
+		GroupID    int64
+	}
+
+	// UpdateBlockedPropertiesRequest contains params required to replace the properties blocked for a user in a group
+	UpdateBlockedPropertiesRequest struct {
+		IdentityID string
+		GroupID    int64
+		Properties []int64
+	}
+
+	// APIClient is an API client with its API and group access
+	APIClient struct {
+		ClientID           string      `json:"clientId"`
+		ClientName         string      `json:"clientName"`
+		ClientDescription  string      `json:"clientDescription"`
+		ClientType         string      `json:"clientType"`
+		AccessToken        string      `json:"accessToken"`
+		AuthorizedUsers    []string    `json:"authorizedUsers"`
+		NotificationEmails []string    `json:"notificationEmails"`
+		AllowAccountSwitch bool        `json:"allowAccountSwitch"`
+		APIAccess          APIAccess   `json:"apiAccess"`
+		GroupAccess        GroupAccess `json:"groupAccess"`
+		CreatedBy          string      `json:"createdBy"`
+		CreatedDate        string      `json:"createdDate"`
+	}
+
+	// APIAccess describes the APIs the API client can access
+	APIAccess struct {
+		AllAccessibleAPIs bool       `json:"allAccessibleApis"`
+		APIs              []APIScope `json:"apis,omitempty"`
+	}
+
+	// APIScope is the access level of the API client to an API
+	APIScope struct {
+		APIID       int64  `json:"apiId"`
+		APIName     string `json:"apiName,omitempty"`
+		AccessLevel string `json:"accessLevel"`
+	}
+
+	// GroupAccess describes the groups the API client can access
+	GroupAccess struct {
+		CloneAuthorizedUserGroups bool          `json:"cloneAuthorizedUserGroups"`
+		Groups                    []ClientGroup `json:"groups,omitempty"`
+	}
+
+	// ClientGroup is the role of the API client in a group
+	ClientGroup struct {
+		GroupID   int64  `json:"groupId"`
+		GroupName string `json:"groupName,omitempty"`
+		RoleID    int64  `json:"roleId"`
+		RoleName  string `json:"roleName,omitempty"`
+	}
+
+	// APIClientSettings contains the settings of an API client sent when it is created or updated
+	APIClientSettings struct {
+		ClientName              string      `json:"clientName"`
+		ClientDescription       string      `json:"clientDescription,omitempty"`
+		AuthorizedUsers         []string    `json:"authorizedUsers"`
+		NotificationEmails      []string    `json:"notificationEmails,omitempty"`
+		AllowAccountSwitch      bool        `json:"allowAccountSwitch"`
+		CanAutoCreateCredential bool        `json:"canAutoCreateCredential"`
+		APIAccess               APIAccess   `json:"apiAccess"`
+		GroupAccess             GroupAccess `json:"groupAccess"`
+	}
+
+	// CreateAPIClientRequest contains params required to create an API client
+	CreateAPIClientRequest struct {
+		Settings APIClientSettings
+	}
+
+	// GetAPIClientRequest contains params required to fetch an API client
+	GetAPIClientRequest struct {
+		ClientID string
+	}
+
+	// UpdateAPIClientRequest contains params required to update an API client
+	UpdateAPIClientRequest struct {
+		ClientID string
+		Settings APIClientSettings
+	}
+
+	// DeleteAPIClientRequest contains params required to delete an API client
+	DeleteAPIClientRequest struct {
+		ClientID string
+	}
+
+	// Credential is a credential of an API client
+	Credential struct {
+		CredentialID int64  `json:"credentialId"`
+		ClientToken  string `json:"clientToken"`
+		ClientSecret string `json:"clientSecret,omitempty"`
+		CreatedOn    string `json:"createdOn"`
+		ExpiresOn    string `json:"expiresOn"`
+		Status       string `json:"status"`
+		Description  string `json:"description"`
+	}
+
+	// CreateCredentialRequest contains params required to create a credential of an API client
+	CreateCredentialRequest struct {
+		ClientID string
+	}
+
+	// CredentialRequest contains params required to fetch or delete a credential of an API client
+	CredentialRequest struct {
+		ClientID     string
+		CredentialID int64
+	}
+
+	// UpdateCredentialRequest contains params required to update a credential of an API client
+	UpdateCredentialRequest struct {
+		ClientID     string
+		CredentialID int64
+		Description  string
+		ExpiresOn    string
+		Status       string
+	}
+
+	// ListUsersRequest contains params required to list users
+	ListUsersRequest struct {
+		// GroupID only lists the users with a role in the group when not zero
+		GroupID    int64
+		AuthGrants bool
+	}
+
+	// LockUserRequest contains params required to lock a user
+	LockUserRequest struct {
+		IdentityID string
+		// Notify sends the user an email telling the account was locked
+		Notify bool
+	}
+
+	// UnlockUserRequest contains params required to unlock a user
+	UnlockUserRequest struct {
+		IdentityID string
+	}
+
+	// ResetUserTFARequest contains params required to reset the two-factor authentication of a user
+	ResetUserTFARequest struct {
+		IdentityID string
+	}
+
+	// ResetUserPasswordRequest contains params required to send a password reset email to a user
+	ResetUserPasswordRequest struct {
+		IdentityID string
+	}
+
+	groupName struct {
+		GroupName string `json:"groupName"`
+	}
+
+	grantedRoleID struct {
+		GrantedRoleID int64 `json:"grantedRoleId"`
+	}
+
+	roleSettings struct {
+		RoleName        string          `json:"roleName"`
+		RoleDescription string          `json:"roleDescription"`
+		GrantedRoles    []grantedRoleID `json:"grantedRoles"`
+	}
+
+	credentialSettings struct {
+		Description string `json:"description,omitempty"`
+		ExpiresOn   string `json:"expiresOn"`
+		Status      string `json:"status"`
+	}
+
+	client struct {
+		iam.IAM
+		session session.Session
+	}
+)
+
+const (
+	// APIAccessLevelReadOnly grants the API client read access to an API
+	APIAccessLevelReadOnly = "READ-ONLY"
+	// APIAccessLevelReadWrite grants the API client read and write access to an API
+	APIAccessLevelReadWrite = "READ-WRITE"
+
+	// CredentialStatusActive is the status of a credential which can be used to sign requests
+	CredentialStatusActive = "ACTIVE"
+	// CredentialStatusInactive is the status of a disabled credential
+	CredentialStatusInactive = "INACTIVE"
+)
+
+var (
+	// apiClientsEP is the IAM API client management endpoint
+	apiClientsEP = "/identity-management/v3/api-clients"
+
+	// ErrCreateGroup is returned when CreateGroup fails
+	ErrCreateGroup = errors.New("create group")
+	// ErrGetGroup is returned when GetGroup fails
+	ErrGetGroup = errors.New("get group")
+	// ErrUpdateGroupName is returned when UpdateGroupName fails
+	ErrUpdateGroupName = errors.New("update group name")
+	// ErrMoveGroup is returned when MoveGroup fails
+	ErrMoveGroup = errors.New("move group")
+	// ErrRemoveGroup is returned when RemoveGroup fails
+	ErrRemoveGroup = errors.New("remove group")
+	// ErrCreateRole is returned when CreateRole fails
+	ErrCreateRole = errors.New("create role")
+	// ErrGetRole is returned when GetRole fails
+	ErrGetRole = errors.New("get role")
+	// ErrUpdateRole is returned when UpdateRole fails
+	ErrUpdateRole = errors.New("update role")
+	// ErrDeleteRole is returned when DeleteRole fails
+	ErrDeleteRole = errors.New("delete role")
+	// ErrListBlockedProperties is returned when ListBlockedProperties fails
+	ErrListBlockedProperties = errors.New("list blocked properties")
+	// ErrUpdateBlockedProperties is returned when UpdateBlockedProperties fails
+	ErrUpdateBlockedProperties = errors.New("update blocked properties")
+	// ErrCreateAPIClient is returned when CreateAPIClient fails
+	ErrCreateAPIClient = errors.New("create API client")
+	// ErrGetAPIClient is returned when GetAPIClient fails
+	ErrGetAPIClient = errors.New("get API client")
+	// ErrUpdateAPIClient is returned when UpdateAPIClient fails
+	ErrUpdateAPIClient = errors.New("update API client")
+	// ErrDeleteAPIClient is returned when DeleteAPIClient fails
+	ErrDeleteAPIClient = errors.New("delete API client")
+	// ErrCreateCredential is returned when CreateCredential fails
+	ErrCreateCredential = errors.New("create credential")
+	// ErrGetCredential is returned when GetCredential fails
+	ErrGetCredential = errors.New("get credential")
+	// ErrUpdateCredential is returned when UpdateCredential fails
+	ErrUpdateCredential = errors.New("update credential")
+	// ErrDeleteCredential is returned when DeleteCredential fails
+	ErrDeleteCredential = errors.New("delete credential")
+	// ErrListUsers is returned when ListUsers fails
+	ErrListUsers = errors.New("list users")
+	// ErrLockUser is returned when LockUser fails
+	ErrLockUser = errors.New("lock user")
+	// ErrUnlockUser is returned when UnlockUser fails
+	ErrUnlockUser = errors.New("unlock user")
+	// ErrResetUserTFA is returned when ResetUserTFA fails
+	ErrResetUserTFA = errors.New("reset user TFA")
+	// ErrResetUserPassword is returned when ResetUserPassword fails
+	ErrResetUserPassword = errors.New("reset user password")
+)
+
+// Client returns a new IAM API instance with the specified session
+func Client(sess session.Session) API {
+	return &client{
+		IAM:     iam.Client(sess),
+		session: sess,
+	}
+}
+
+// Validate validates CreateGroupRequest
+func (r CreateGroupRequest) Validate() error {
+	return validation.Errors{
+		"parentGroupId": validation.Validate(r.ParentGroupID, validation.Required),
+		"groupName":     validation.Validate(r.GroupName, validation.Required),
+	}.Filter()
+}
+
+// Validate validates GetGroupRequest
+func (r GetGroupRequest) Validate() error {
+	return validation.Errors{
+		"groupId": validation.Validate(r.GroupID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates UpdateGroupNameRequest
+func (r UpdateGroupNameRequest) Validate() error {
+	return validation.Errors{
+		"groupId":   validation.Validate(r.GroupID, validation.Required),
+		"groupName": validation.Validate(r.GroupName, validation.Required),
+	}.Filter()
+}
+
+// Validate validates MoveGroupRequest
+func (r MoveGroupRequest) Validate() error {
+	return validation.Errors{
+		"sourceGroupId":      validation.Validate(r.SourceGroupID, validation.Required),
+		"destinationGroupId": validation.Validate(r.DestinationGroupID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates RemoveGroupRequest
+func (r RemoveGroupRequest) Validate() error {
+	return validation.Errors{
+		"groupId": validation.Validate(r.GroupID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates RoleRequest
+func (r RoleRequest) Validate() error {
+	return validation.Errors{
+		"roleName":     validation.Validate(r.Name, validation.Required),
+		"grantedRoles": validation.Validate(r.GrantedRoles, validation.Required),
+	}.Filter()
+}
+
+// Validate validates GetRoleRequest
+func (r GetRoleRequest) Validate() error {
+	return validation.Errors{
+		"roleId": validation.Validate(r.RoleID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates UpdateRoleRequest
+func (r UpdateRoleRequest) Validate() error {
+	return validation.Errors{
+		"roleId": validation.Validate(r.RoleID, validation.Required),
+		"role":   r.RoleRequest.Validate(),
+	}.Filter()
+}
+
+// Validate validates DeleteRoleRequest
+func (r DeleteRoleRequest) Validate() error {
+	return validation.Errors{
+		"roleId": validation.Validate(r.RoleID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates ListBlockedPropertiesRequest
+func (r ListBlockedPropertiesRequest) Validate() error {
+	return validation.Errors{
+		"uiIdentityId": validation.Validate(r.IdentityID, validation.Required),
+		"groupId":      validation.Validate(r.GroupID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates UpdateBlockedPropertiesRequest
+func (r UpdateBlockedPropertiesRequest) Validate() error {
+	return validation.Errors{
+		"uiIdentityId": validation.Validate(r.IdentityID, validation.Required),
+		"groupId":      validation.Validate(r.GroupID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates APIClientSettings
+func (s APIClientSettings) Validate() error {
+	return validation.Errors{
+		"clientName":      validation.Validate(s.ClientName, validation.Required),
+		"authorizedUsers": validation.Validate(s.AuthorizedUsers, validation.Required),
+		"apiAccess.apis": validation.Validate(s.APIAccess.APIs,
+			validation.When(!s.APIAccess.AllAccessibleAPIs, validation.Required)),
+		"groupAccess.groups": validation.Validate(s.GroupAccess.Groups,
+			validation.When(!s.GroupAccess.CloneAuthorizedUserGroups, validation.Required)),
+	}.Filter()
+}
+
+// Validate validates CreateAPIClientRequest
+func (r CreateAPIClientRequest) Validate() error {
+	return r.Settings.Validate()
+}
+
+// Validate validates GetAPIClientRequest
+func (r GetAPIClientRequest) Validate() error {
+	return validation.Errors{
+		"clientId": validation.Validate(r.ClientID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates UpdateAPIClientRequest
+func (r UpdateAPIClientRequest) Validate() error {
+	return validation.Errors{
+		"clientId": validation.Validate(r.ClientID, validation.Required),
+		"client":   r.Settings.Validate(),
+	}.Filter()
+}
+
+// Validate validates DeleteAPIClientRequest
+func (r DeleteAPIClientRequest) Validate() error {
+	return validation.Errors{
+		"clientId": validation.Validate(r.ClientID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates CreateCredentialRequest
+func (r CreateCredentialRequest) Validate() error {
+	return validation.Errors{
+		"clientId": validation.Validate(r.ClientID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates CredentialRequest
+func (r CredentialRequest) Validate() error {
+	return validation.Errors{
+		"clientId":     validation.Validate(r.ClientID, validation.Required),
+		"credentialId": validation.Validate(r.CredentialID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates UpdateCredentialRequest
+func (r UpdateCredentialRequest) Validate() error {
+	return validation.Errors{
+		"clientId":     validation.Validate(r.ClientID, validation.Required),
+		"credentialId": validation.Validate(r.CredentialID, validation.Required),
+		"expiresOn":    validation.Validate(r.ExpiresOn, validation.Required),
+		"status": validation.Validate(r.Status, validation.Required,
+			validation.In(CredentialStatusActive, CredentialStatusInactive)),
+	}.Filter()
+}
+
+// Validate validates LockUserRequest
+func (r LockUserRequest) Validate() error {
+	return validation.Errors{
+		"uiIdentityId": validation.Validate(r.IdentityID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates UnlockUserRequest
+func (r UnlockUserRequest) Validate() error {
+	return validation.Errors{
+		"uiIdentityId": validation.Validate(r.IdentityID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates ResetUserTFARequest
+func (r ResetUserTFARequest) Validate() error {
+	return validation.Errors{
+		"uiIdentityId": validation.Validate(r.IdentityID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates ResetUserPasswordRequest
+func (r ResetUserPasswordRequest) Validate() error {
+	return validation.Errors{
+		"uiIdentityId": validation.Validate(r.IdentityID, validation.Required),
+	}.Filter()
+}
+
+func (c *client) CreateGroup(ctx context.Context, params CreateGroupRequest) (*iam.Group, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateGroup, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "groups", strconv.FormatInt(params.ParentGroupID, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateGroup, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateGroup, err)
+	}
+
+	var rval iam.Group
+	resp, err := c.session.Exec(req, &rval, groupName{GroupName: params.GroupName})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateGroup, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateGroup, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) GetGroup(ctx context.Context, params GetGroupRequest) (*iam.Group, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetGroup, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "groups", strconv.FormatInt(params.GroupID, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetGroup, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetGroup, err)
+	}
+
+	var rval iam.Group
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetGroup, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetGroup, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) UpdateGroupName(ctx context.Context, params UpdateGroupNameRequest) (*iam.Group, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrUpdateGroupName, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "groups", strconv.FormatInt(params.GroupID, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUpdateGroupName, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateGroupName, err)
+	}
+
+	var rval iam.Group
+	resp, err := c.session.Exec(req, &rval, groupName{GroupName: params.GroupName})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateGroupName, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateGroupName, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) MoveGroup(ctx context.Context, params MoveGroupRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrMoveGroup, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "groups", "move"))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrMoveGroup, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrMoveGroup, err)
+	}
+
+	resp, err := c.session.Exec(req, nil, params)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrMoveGroup, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrMoveGroup, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) RemoveGroup(ctx context.Context, params RemoveGroupRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrRemoveGroup, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "groups", strconv.FormatInt(params.GroupID, 10)))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrRemoveGroup, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrRemoveGroup, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrRemoveGroup, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrRemoveGroup, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) CreateRole(ctx context.Context, params RoleRequest) (*iam.Role, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateRole, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "roles"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateRole, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateRole, err)
+	}
+
+	var rval iam.Role
+	resp, err := c.session.Exec(req, &rval, params.settings())
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateRole, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateRole, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) GetRole(ctx context.Context, params GetRoleRequest) (*iam.Role, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetRole, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "roles", strconv.FormatInt(params.RoleID, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetRole, err)
+	}
+	q := uri.Query()
+	q.Add("grantedRoles", "true")
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetRole, err)
+	}
+
+	var rval iam.Role
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetRole, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetRole, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) UpdateRole(ctx context.Context, params UpdateRoleRequest) (*iam.Role, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrUpdateRole, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "roles", strconv.FormatInt(params.RoleID, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUpdateRole, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateRole, err)
+	}
+
+	var rval iam.Role
+	resp, err := c.session.Exec(req, &rval, params.RoleRequest.settings())
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateRole, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateRole, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) DeleteRole(ctx context.Context, params DeleteRoleRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrDeleteRole, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "roles", strconv.FormatInt(params.RoleID, 10)))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrDeleteRole, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteRole, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrDeleteRole, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrDeleteRole, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) ListBlockedProperties(ctx context.Context, params ListBlockedPropertiesRequest) ([]int64, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrListBlockedProperties, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(blockedPropertiesPath(params.IdentityID, params.GroupID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListBlockedProperties, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListBlockedProperties, err)
+	}
+
+	var rval []int64
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListBlockedProperties, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListBlockedProperties, responseError(resp))
+	}
+
+	return rval, nil
+}
+
+func (c *client) UpdateBlockedProperties(ctx context.Context, params UpdateBlockedPropertiesRequest) ([]int64, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrUpdateBlockedProperties, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(blockedPropertiesPath(params.IdentityID, params.GroupID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUpdateBlockedProperties, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateBlockedProperties, err)
+	}
+
+	properties := params.Properties
+	if properties == nil {
+		properties = []int64{}
+	}
+
+	var rval []int64
+	resp, err := c.session.Exec(req, &rval, properties)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateBlockedProperties, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateBlockedProperties, responseError(resp))
+	}
+
+	return rval, nil
+}
+
+func (c *client) CreateAPIClient(ctx context.Context, params CreateAPIClientRequest) (*APIClient, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateAPIClient, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(apiClientsEP)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateAPIClient, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateAPIClient, err)
+	}
+
+	var rval APIClient
+	resp, err := c.session.Exec(req, &rval, params.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateAPIClient, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateAPIClient, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) GetAPIClient(ctx context.Context, params GetAPIClientRequest) (*APIClient, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetAPIClient, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(apiClientsEP, params.ClientID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetAPIClient, err)
+	}
+	q := uri.Query()
+	q.Add("apiAccess", "true")
+	q.Add("groupAccess", "true")
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetAPIClient, err)
+	}
+
+	var rval APIClient
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetAPIClient, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetAPIClient, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) UpdateAPIClient(ctx context.Context, params UpdateAPIClientRequest) (*APIClient, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrUpdateAPIClient, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(apiClientsEP, params.ClientID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUpdateAPIClient, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateAPIClient, err)
+	}
+
+	var rval APIClient
+	resp, err := c.session.Exec(req, &rval, params.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateAPIClient, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateAPIClient, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) DeleteAPIClient(ctx context.Context, params DeleteAPIClientRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrDeleteAPIClient, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(apiClientsEP, params.ClientID))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrDeleteAPIClient, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteAPIClient, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrDeleteAPIClient, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrDeleteAPIClient, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) CreateCredential(ctx context.Context, params CreateCredentialRequest) (*Credential, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateCredential, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join(apiClientsEP, params.ClientID, "credentials"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateCredential, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateCredential, err)
+	}
+
+	var rval Credential
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateCredential, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateCredential, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) GetCredential(ctx context.Context, params CredentialRequest) (*Credential, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetCredential, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(credentialPath(params.ClientID, params.CredentialID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetCredential, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetCredential, err)
+	}
+
+	var rval Credential
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetCredential, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetCredential, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) UpdateCredential(ctx context.Context, params UpdateCredentialRequest) (*Credential, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrUpdateCredential, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(credentialPath(params.ClientID, params.CredentialID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUpdateCredential, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateCredential, err)
+	}
+
+	var rval Credential
+	resp, err := c.session.Exec(req, &rval, credentialSettings{
+		Description: params.Description,
+		ExpiresOn:   params.ExpiresOn,
+		Status:      params.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateCredential, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateCredential, responseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *client) DeleteCredential(ctx context.Context, params CredentialRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrDeleteCredential, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(credentialPath(params.ClientID, params.CredentialID))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrDeleteCredential, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteCredential, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrDeleteCredential, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrDeleteCredential, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) ListUsers(ctx context.Context, params ListUsersRequest) ([]iam.User, error) {
+	uri, err := url.Parse(path.Join(iam.UserAdminEP, "ui-identities"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListUsers, err)
+	}
+	q := uri.Query()
+	q.Add("authGrants", strconv.FormatBool(params.AuthGrants))
+	if params.GroupID != 0 {
+		q.Add("groupId", strconv.FormatInt(params.GroupID, 10))
+	}
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListUsers, err)
+	}
+
+	var rval []iam.User
+	resp, err := c.session.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListUsers, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListUsers, responseError(resp))
+	}
+
+	return rval, nil
+}
+
+func (c *client) LockUser(ctx context.Context, params LockUserRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrLockUser, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(userPath(params.IdentityID, "lock"))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrLockUser, err)
+	}
+	q := uri.Query()
+	if params.Notify {
+		q.Add("sendEmail", "true")
+	}
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrLockUser, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrLockUser, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrLockUser, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) UnlockUser(ctx context.Context, params UnlockUserRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrUnlockUser, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(userPath(params.IdentityID, "unlock"))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrUnlockUser, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrUnlockUser, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrUnlockUser, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrUnlockUser, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) ResetUserTFA(ctx context.Context, params ResetUserTFARequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrResetUserTFA, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(userPath(params.IdentityID, "tfa"))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrResetUserTFA, err)
+	}
+	q := uri.Query()
+	q.Add("action", "reset")
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrResetUserTFA, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrResetUserTFA, err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("%s: %w", ErrResetUserTFA, responseError(resp))
+	}
+
+	return nil
+}
+
+func (c *client) ResetUserPassword(ctx context.Context, params ResetUserPasswordRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrResetUserPassword, iam.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(userPath(params.IdentityID, "reset-password"))
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrResetUserPassword, err)
+	}
+	q := uri.Query()
+	q.Add("sendEmail", "true")
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrResetUserPassword, err)
+	}
+
+	resp, err := c.session.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrResetUserPassword, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %w", ErrResetUserPassword, responseError(resp))
+	}
+
+	return nil
+}
+
+// settings returns the body of the create and update role requests
+func (r RoleRequest) settings() roleSettings {
+	grantedRoles := make([]grantedRoleID, 0, len(r.GrantedRoles))
+	for _, roleID := range r.GrantedRoles {
+		grantedRoles = append(grantedRoles, grantedRoleID{GrantedRoleID: roleID})
+	}
+	return roleSettings{
+		RoleName:        r.Name,
+		RoleDescription: r.Description,
+		GrantedRoles:    grantedRoles,
+	}
+}
+
+func blockedPropertiesPath(identityID string, groupID int64) string {
+	return path.Join(iam.UserAdminEP, "ui-identities", identityID, "groups", strconv.FormatInt(groupID, 10), "blocked-properties")
+}
+
+func credentialPath(clientID string, credentialID int64) string {
+	return path.Join(apiClientsEP, clientID, "credentials", strconv.FormatInt(credentialID, 10))
+}
+
+func userPath(identityID string, action string) string {
+	return path.Join(iam.UserAdminEP, "ui-identities", identityID, action)
+}
+
+// responseError parses the IAM API error from the response
+func responseError(r *http.Response) error {
+	e := iam.Error{StatusCode: r.StatusCode}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		e.Title = string(body)
+	}
+	e.StatusCode = r.StatusCode
+	return &e
+}
@@ -0,0 +1,1111 @@
+package iam
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockAPIClient(t *testing.T, mockServer *httptest.Server) API {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+
+	s, err := session.New(
+		session.WithClient(httpClient),
+		session.WithSigner(&edgegrid.Config{Host: serverURL.Host}),
+	)
+	require.NoError(t, err)
+	return Client(s)
+}
+
+// mockServer returns a server which checks the method, path and body of the request and responds with given status and body
+func mockServer(t *testing.T, method, expectedPath, expectedBody string, responseStatus int, responseBody string) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, expectedPath, r.URL.String())
+		assert.Equal(t, method, r.Method)
+		if expectedBody != "" {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, expectedBody, string(body))
+		}
+		w.WriteHeader(responseStatus)
+		_, err := w.Write([]byte(responseBody))
+		assert.NoError(t, err)
+	}))
+}
+
+const notFoundBody = `{
+    "type": "/useradmin-api/error-types/1100",
+    "title": "Not Found",
+    "detail": "Resource does not exist"
+}`
+
+var notFoundError = &iam.Error{
+	Type:       "/useradmin-api/error-types/1100",
+	Title:      "Not Found",
+	Detail:     "Resource does not exist",
+	StatusCode: http.StatusNotFound,
+}
+
+func TestCreateGroup(t *testing.T) {
+	tests := map[string]struct {
+		params           CreateGroupRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *iam.Group
+		withError        error
+	}{
+		"201 Created": {
+			params:           CreateGroupRequest{ParentGroupID: 12345, GroupName: "Test group"},
+			responseStatus:   http.StatusCreated,
+			responseBody:     `{"groupId": 98765, "groupName": "Test group", "parentGroupId": 12345}`,
+			expectedResponse: &iam.Group{GroupID: 98765, GroupName: "Test group", ParentGroupID: 12345},
+		},
+		"404 Not Found": {
+			params:         CreateGroupRequest{ParentGroupID: 12345, GroupName: "Test group"},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+		"validation error": {
+			params:    CreateGroupRequest{ParentGroupID: 12345},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPost, "/identity-management/v2/user-admin/groups/12345",
+				`{"groupName": "Test group"}`, test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.CreateGroup(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetGroup(t *testing.T) {
+	tests := map[string]struct {
+		params           GetGroupRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *iam.Group
+		withError        error
+	}{
+		"200 OK": {
+			params:         GetGroupRequest{GroupID: 98765},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "groupId": 98765,
+    "groupName": "Test group",
+    "parentGroupId": 12345,
+    "subGroups": [{"groupId": 98766, "groupName": "Sub-group", "parentGroupId": 98765}]
+}`,
+			expectedResponse: &iam.Group{
+				GroupID:       98765,
+				GroupName:     "Test group",
+				ParentGroupID: 12345,
+				SubGroups:     []iam.Group{{GroupID: 98766, GroupName: "Sub-group", ParentGroupID: 98765}},
+			},
+		},
+		"404 Not Found": {
+			params:         GetGroupRequest{GroupID: 98765},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+		"validation error": {
+			params:    GetGroupRequest{},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodGet, "/identity-management/v2/user-admin/groups/98765", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.GetGroup(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestUpdateGroupName(t *testing.T) {
+	tests := map[string]struct {
+		params           UpdateGroupNameRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *iam.Group
+		withError        error
+	}{
+		"200 OK": {
+			params:           UpdateGroupNameRequest{GroupID: 98765, GroupName: "Renamed group"},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"groupId": 98765, "groupName": "Renamed group", "parentGroupId": 12345}`,
+			expectedResponse: &iam.Group{GroupID: 98765, GroupName: "Renamed group", ParentGroupID: 12345},
+		},
+		"validation error": {
+			params:    UpdateGroupNameRequest{GroupID: 98765},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, "/identity-management/v2/user-admin/groups/98765",
+				`{"groupName": "Renamed group"}`, test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.UpdateGroupName(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestMoveGroup(t *testing.T) {
+	tests := map[string]struct {
+		params         MoveGroupRequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         MoveGroupRequest{SourceGroupID: 98765, DestinationGroupID: 54321},
+			responseStatus: http.StatusNoContent,
+		},
+		"404 Not Found": {
+			params:         MoveGroupRequest{SourceGroupID: 98765, DestinationGroupID: 54321},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+		"validation error": {
+			params:    MoveGroupRequest{SourceGroupID: 98765},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPost, "/identity-management/v2/user-admin/groups/move",
+				`{"sourceGroupId": 98765, "destinationGroupId": 54321}`, test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.MoveGroup(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveGroup(t *testing.T) {
+	tests := map[string]struct {
+		params         RemoveGroupRequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         RemoveGroupRequest{GroupID: 98765},
+			responseStatus: http.StatusNoContent,
+		},
+		"403 Forbidden": {
+			params:         RemoveGroupRequest{GroupID: 98765},
+			responseStatus: http.StatusForbidden,
+			responseBody:   `{"title": "Forbidden", "detail": "Group has sub-groups"}`,
+			withError:      &iam.Error{Title: "Forbidden", Detail: "Group has sub-groups", StatusCode: http.StatusForbidden},
+		},
+		"validation error": {
+			params:    RemoveGroupRequest{},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodDelete, "/identity-management/v2/user-admin/groups/98765", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.RemoveGroup(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateRole(t *testing.T) {
+	tests := map[string]struct {
+		params           RoleRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *iam.Role
+		withError        error
+	}{
+		"201 Created": {
+			params:         RoleRequest{Name: "Test role", Description: "Role description", GrantedRoles: []int64{1, 2}},
+			responseStatus: http.StatusCreated,
+			responseBody: `{
+    "roleId": 555,
+    "roleName": "Test role",
+    "roleDescription": "Role description",
+    "type": "custom",
+    "grantedRoles": [{"grantedRoleId": 1, "grantedRoleName": "A"}, {"grantedRoleId": 2, "grantedRoleName": "B"}]
+}`,
+			expectedResponse: &iam.Role{
+				RoleID:          555,
+				RoleName:        "Test role",
+				RoleDescription: "Role description",
+				RoleType:        iam.RoleTypeCustom,
+				GrantedRoles:    []iam.RoleGrantedRole{{RoleID: 1, RoleName: "A"}, {RoleID: 2, RoleName: "B"}},
+			},
+		},
+		"validation error": {
+			params:    RoleRequest{Name: "Test role"},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPost, "/identity-management/v2/user-admin/roles",
+				`{"roleName": "Test role", "roleDescription": "Role description", "grantedRoles": [{"grantedRoleId": 1}, {"grantedRoleId": 2}]}`,
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.CreateRole(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetRole(t *testing.T) {
+	tests := map[string]struct {
+		params           GetRoleRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *iam.Role
+		withError        error
+	}{
+		"200 OK": {
+			params:           GetRoleRequest{RoleID: 555},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"roleId": 555, "roleName": "Test role", "grantedRoles": [{"grantedRoleId": 1}]}`,
+			expectedResponse: &iam.Role{RoleID: 555, RoleName: "Test role", GrantedRoles: []iam.RoleGrantedRole{{RoleID: 1}}},
+		},
+		"404 Not Found": {
+			params:         GetRoleRequest{RoleID: 555},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+		"validation error": {
+			params:    GetRoleRequest{},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodGet, "/identity-management/v2/user-admin/roles/555?grantedRoles=true", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.GetRole(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	tests := map[string]struct {
+		params           UpdateRoleRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *iam.Role
+		withError        error
+	}{
+		"200 OK": {
+			params: UpdateRoleRequest{
+				RoleID:      555,
+				RoleRequest: RoleRequest{Name: "Test role", Description: "Role description", GrantedRoles: []int64{1, 2}},
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"roleId": 555, "roleName": "Test role"}`,
+			expectedResponse: &iam.Role{RoleID: 555, RoleName: "Test role"},
+		},
+		"validation error": {
+			params:    UpdateRoleRequest{RoleRequest: RoleRequest{Name: "Test role", GrantedRoles: []int64{1}}},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, "/identity-management/v2/user-admin/roles/555",
+				`{"roleName": "Test role", "roleDescription": "Role description", "grantedRoles": [{"grantedRoleId": 1}, {"grantedRoleId": 2}]}`,
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.UpdateRole(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	tests := map[string]struct {
+		params         DeleteRoleRequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         DeleteRoleRequest{RoleID: 555},
+			responseStatus: http.StatusNoContent,
+		},
+		"404 Not Found": {
+			params:         DeleteRoleRequest{RoleID: 555},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodDelete, "/identity-management/v2/user-admin/roles/555", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.DeleteRole(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListBlockedProperties(t *testing.T) {
+	tests := map[string]struct {
+		params           ListBlockedPropertiesRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse []int64
+		withError        error
+	}{
+		"200 OK": {
+			params:           ListBlockedPropertiesRequest{IdentityID: "A-B-123456", GroupID: 12345},
+			responseStatus:   http.StatusOK,
+			responseBody:     `[111, 222]`,
+			expectedResponse: []int64{111, 222},
+		},
+		"validation error": {
+			params:    ListBlockedPropertiesRequest{GroupID: 12345},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodGet, "/identity-management/v2/user-admin/ui-identities/A-B-123456/groups/12345/blocked-properties", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.ListBlockedProperties(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestUpdateBlockedProperties(t *testing.T) {
+	tests := map[string]struct {
+		params           UpdateBlockedPropertiesRequest
+		expectedBody     string
+		responseStatus   int
+		responseBody     string
+		expectedResponse []int64
+		withError        error
+	}{
+		"200 OK": {
+			params:           UpdateBlockedPropertiesRequest{IdentityID: "A-B-123456", GroupID: 12345, Properties: []int64{111, 222}},
+			expectedBody:     `[111, 222]`,
+			responseStatus:   http.StatusOK,
+			responseBody:     `[111, 222]`,
+			expectedResponse: []int64{111, 222},
+		},
+		"unblock all properties": {
+			params:           UpdateBlockedPropertiesRequest{IdentityID: "A-B-123456", GroupID: 12345},
+			expectedBody:     `[]`,
+			responseStatus:   http.StatusOK,
+			responseBody:     `[]`,
+			expectedResponse: []int64{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, "/identity-management/v2/user-admin/ui-identities/A-B-123456/groups/12345/blocked-properties",
+				test.expectedBody, test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.UpdateBlockedProperties(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestCreateAPIClient(t *testing.T) {
+	settings := APIClientSettings{
+		ClientName:      "Test client",
+		AuthorizedUsers: []string{"jdoe"},
+		APIAccess: APIAccess{
+			APIs: []APIScope{{APIID: 5580, AccessLevel: APIAccessLevelReadWrite}},
+		},
+		GroupAccess: GroupAccess{CloneAuthorizedUserGroups: true},
+	}
+
+	tests := map[string]struct {
+		params           CreateAPIClientRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *APIClient
+		withError        error
+	}{
+		"201 Created": {
+			params:         CreateAPIClientRequest{Settings: settings},
+			responseStatus: http.StatusCreated,
+			responseBody: `{
+    "clientId": "abcd1234",
+    "clientName": "Test client",
+    "clientType": "CLIENT",
+    "accessToken": "akab-access-token",
+    "authorizedUsers": ["jdoe"],
+    "apiAccess": {"allAccessibleApis": false, "apis": [{"apiId": 5580, "apiName": "Search Data Feed", "accessLevel": "READ-WRITE"}]},
+    "groupAccess": {"cloneAuthorizedUserGroups": true}
+}`,
+			expectedResponse: &APIClient{
+				ClientID:        "abcd1234",
+				ClientName:      "Test client",
+				ClientType:      "CLIENT",
+				AccessToken:     "akab-access-token",
+				AuthorizedUsers: []string{"jdoe"},
+				APIAccess: APIAccess{
+					APIs: []APIScope{{APIID: 5580, APIName: "Search Data Feed", AccessLevel: APIAccessLevelReadWrite}},
+				},
+				GroupAccess: GroupAccess{CloneAuthorizedUserGroups: true},
+			},
+		},
+		"validation error": {
+			params:    CreateAPIClientRequest{Settings: APIClientSettings{ClientName: "Test client", AuthorizedUsers: []string{"jdoe"}}},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPost, "/identity-management/v3/api-clients", `{
+    "clientName": "Test client",
+    "authorizedUsers": ["jdoe"],
+    "allowAccountSwitch": false,
+    "canAutoCreateCredential": false,
+    "apiAccess": {"allAccessibleApis": false, "apis": [{"apiId": 5580, "accessLevel": "READ-WRITE"}]},
+    "groupAccess": {"cloneAuthorizedUserGroups": true}
+}`, test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.CreateAPIClient(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetAPIClient(t *testing.T) {
+	tests := map[string]struct {
+		params           GetAPIClientRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *APIClient
+		withError        error
+	}{
+		"200 OK": {
+			params:         GetAPIClientRequest{ClientID: "abcd1234"},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "clientId": "abcd1234",
+    "clientName": "Test client",
+    "groupAccess": {"cloneAuthorizedUserGroups": false, "groups": [{"groupId": 12345, "groupName": "Test group", "roleId": 555}]}
+}`,
+			expectedResponse: &APIClient{
+				ClientID:    "abcd1234",
+				ClientName:  "Test client",
+				GroupAccess: GroupAccess{Groups: []ClientGroup{{GroupID: 12345, GroupName: "Test group", RoleID: 555}}},
+			},
+		},
+		"404 Not Found": {
+			params:         GetAPIClientRequest{ClientID: "abcd1234"},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+		"validation error": {
+			params:    GetAPIClientRequest{},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodGet, "/identity-management/v3/api-clients/abcd1234?apiAccess=true&groupAccess=true", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.GetAPIClient(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestUpdateAPIClient(t *testing.T) {
+	settings := APIClientSettings{
+		ClientName:      "Renamed client",
+		AuthorizedUsers: []string{"jdoe"},
+		APIAccess:       APIAccess{AllAccessibleAPIs: true},
+		GroupAccess:     GroupAccess{CloneAuthorizedUserGroups: true},
+	}
+
+	tests := map[string]struct {
+		params           UpdateAPIClientRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *APIClient
+		withError        error
+	}{
+		"200 OK": {
+			params:           UpdateAPIClientRequest{ClientID: "abcd1234", Settings: settings},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"clientId": "abcd1234", "clientName": "Renamed client"}`,
+			expectedResponse: &APIClient{ClientID: "abcd1234", ClientName: "Renamed client"},
+		},
+		"validation error": {
+			params:    UpdateAPIClientRequest{Settings: settings},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, "/identity-management/v3/api-clients/abcd1234", `{
+    "clientName": "Renamed client",
+    "authorizedUsers": ["jdoe"],
+    "allowAccountSwitch": false,
+    "canAutoCreateCredential": false,
+    "apiAccess": {"allAccessibleApis": true},
+    "groupAccess": {"cloneAuthorizedUserGroups": true}
+}`, test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.UpdateAPIClient(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestDeleteAPIClient(t *testing.T) {
+	tests := map[string]struct {
+		params         DeleteAPIClientRequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         DeleteAPIClientRequest{ClientID: "abcd1234"},
+			responseStatus: http.StatusNoContent,
+		},
+		"404 Not Found": {
+			params:         DeleteAPIClientRequest{ClientID: "abcd1234"},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodDelete, "/identity-management/v3/api-clients/abcd1234", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.DeleteAPIClient(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateCredential(t *testing.T) {
+	tests := map[string]struct {
+		params           CreateCredentialRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *Credential
+		withError        error
+	}{
+		"201 Created": {
+			params:         CreateCredentialRequest{ClientID: "abcd1234"},
+			responseStatus: http.StatusCreated,
+			responseBody: `{
+    "credentialId": 777,
+    "clientToken": "akab-client-token",
+    "clientSecret": "secret",
+    "createdOn": "2022-01-01T00:00:00.000Z",
+    "expiresOn": "2024-01-01T00:00:00.000Z",
+    "status": "ACTIVE"
+}`,
+			expectedResponse: &Credential{
+				CredentialID: 777,
+				ClientToken:  "akab-client-token",
+				ClientSecret: "secret",
+				CreatedOn:    "2022-01-01T00:00:00.000Z",
+				ExpiresOn:    "2024-01-01T00:00:00.000Z",
+				Status:       CredentialStatusActive,
+			},
+		},
+		"validation error": {
+			params:    CreateCredentialRequest{},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPost, "/identity-management/v3/api-clients/abcd1234/credentials", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.CreateCredential(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetCredential(t *testing.T) {
+	tests := map[string]struct {
+		params           CredentialRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *Credential
+		withError        error
+	}{
+		"200 OK": {
+			params:           CredentialRequest{ClientID: "abcd1234", CredentialID: 777},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"credentialId": 777, "clientToken": "akab-client-token", "status": "INACTIVE"}`,
+			expectedResponse: &Credential{CredentialID: 777, ClientToken: "akab-client-token", Status: CredentialStatusInactive},
+		},
+		"404 Not Found": {
+			params:         CredentialRequest{ClientID: "abcd1234", CredentialID: 777},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+		"validation error": {
+			params:    CredentialRequest{ClientID: "abcd1234"},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodGet, "/identity-management/v3/api-clients/abcd1234/credentials/777", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.GetCredential(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestUpdateCredential(t *testing.T) {
+	tests := map[string]struct {
+		params           UpdateCredentialRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *Credential
+		withError        error
+	}{
+		"200 OK": {
+			params: UpdateCredentialRequest{
+				ClientID:     "abcd1234",
+				CredentialID: 777,
+				Description:  "CI pipeline",
+				ExpiresOn:    "2023-01-01T00:00:00.000Z",
+				Status:       CredentialStatusInactive,
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"credentialId": 777, "description": "CI pipeline", "expiresOn": "2023-01-01T00:00:00.000Z", "status": "INACTIVE"}`,
+			expectedResponse: &Credential{CredentialID: 777, Description: "CI pipeline", ExpiresOn: "2023-01-01T00:00:00.000Z", Status: CredentialStatusInactive},
+		},
+		"validation error": {
+			params:    UpdateCredentialRequest{ClientID: "abcd1234", CredentialID: 777, ExpiresOn: "2023-01-01T00:00:00.000Z", Status: "DELETED"},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, "/identity-management/v3/api-clients/abcd1234/credentials/777",
+				`{"description": "CI pipeline", "expiresOn": "2023-01-01T00:00:00.000Z", "status": "INACTIVE"}`,
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.UpdateCredential(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestDeleteCredential(t *testing.T) {
+	tests := map[string]struct {
+		params         CredentialRequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         CredentialRequest{ClientID: "abcd1234", CredentialID: 777},
+			responseStatus: http.StatusNoContent,
+		},
+		"400 Bad Request": {
+			params:         CredentialRequest{ClientID: "abcd1234", CredentialID: 777},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"title": "Bad Request", "detail": "Credential is active"}`,
+			withError:      &iam.Error{Title: "Bad Request", Detail: "Credential is active", StatusCode: http.StatusBadRequest},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodDelete, "/identity-management/v3/api-clients/abcd1234/credentials/777", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.DeleteCredential(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	tests := map[string]struct {
+		params           ListUsersRequest
+		expectedPath     string
+		responseStatus   int
+		responseBody     string
+		expectedResponse []iam.User
+		withError        error
+	}{
+		"200 OK": {
+			params:         ListUsersRequest{AuthGrants: true},
+			expectedPath:   "/identity-management/v2/user-admin/ui-identities?authGrants=true",
+			responseStatus: http.StatusOK,
+			responseBody: `[
+    {
+        "uiIdentityId": "A-B-123456",
+        "uiUserName": "jdoe",
+        "firstName": "John",
+        "lastName": "Doe",
+        "email": "jdoe@example.com",
+        "isLocked": false,
+        "tfaEnabled": true,
+        "lastLoginDate": "2022-01-01T00:00:00.000Z",
+        "authGrants": [{"groupId": 12345, "groupName": "Test group", "roleId": 14, "roleName": "Admin"}]
+    }
+]`,
+			expectedResponse: []iam.User{{
+				IdentityID: "A-B-123456",
+				UserBasicInfo: iam.UserBasicInfo{
+					UserName:   "jdoe",
+					FirstName:  "John",
+					LastName:   "Doe",
+					Email:      "jdoe@example.com",
+					TFAEnabled: true,
+				},
+				LastLoginDate: "2022-01-01T00:00:00.000Z",
+				AuthGrants:    []iam.AuthGrant{{GroupID: 12345, GroupName: "Test group", RoleID: tools.IntPtr(14), RoleName: "Admin"}},
+			}},
+		},
+		"200 OK in group": {
+			params:           ListUsersRequest{GroupID: 12345},
+			expectedPath:     "/identity-management/v2/user-admin/ui-identities?authGrants=false&groupId=12345",
+			responseStatus:   http.StatusOK,
+			responseBody:     `[]`,
+			expectedResponse: []iam.User{},
+		},
+		"500 Internal Server Error": {
+			params:         ListUsersRequest{},
+			expectedPath:   "/identity-management/v2/user-admin/ui-identities?authGrants=false",
+			responseStatus: http.StatusInternalServerError,
+			responseBody:   `{"title": "Internal Server Error", "detail": "Error processing request"}`,
+			withError:      &iam.Error{Title: "Internal Server Error", Detail: "Error processing request", StatusCode: http.StatusInternalServerError},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodGet, test.expectedPath, "", test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			result, err := client.ListUsers(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestLockUser(t *testing.T) {
+	tests := map[string]struct {
+		params         LockUserRequest
+		expectedPath   string
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         LockUserRequest{IdentityID: "A-B-123456"},
+			expectedPath:   "/identity-management/v2/user-admin/ui-identities/A-B-123456/lock",
+			responseStatus: http.StatusNoContent,
+		},
+		"204 No Content with notification": {
+			params:         LockUserRequest{IdentityID: "A-B-123456", Notify: true},
+			expectedPath:   "/identity-management/v2/user-admin/ui-identities/A-B-123456/lock?sendEmail=true",
+			responseStatus: http.StatusNoContent,
+		},
+		"404 Not Found": {
+			params:         LockUserRequest{IdentityID: "A-B-123456"},
+			expectedPath:   "/identity-management/v2/user-admin/ui-identities/A-B-123456/lock",
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+		"validation error": {
+			params:    LockUserRequest{Notify: true},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, test.expectedPath, "", test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.LockUser(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUnlockUser(t *testing.T) {
+	tests := map[string]struct {
+		params         UnlockUserRequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         UnlockUserRequest{IdentityID: "A-B-123456"},
+			responseStatus: http.StatusNoContent,
+		},
+		"404 Not Found": {
+			params:         UnlockUserRequest{IdentityID: "A-B-123456"},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, "/identity-management/v2/user-admin/ui-identities/A-B-123456/unlock", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.UnlockUser(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResetUserTFA(t *testing.T) {
+	tests := map[string]struct {
+		params         ResetUserTFARequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			params:         ResetUserTFARequest{IdentityID: "A-B-123456"},
+			responseStatus: http.StatusNoContent,
+		},
+		"400 Bad Request": {
+			params:         ResetUserTFARequest{IdentityID: "A-B-123456"},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"title": "Bad Request", "detail": "TFA is not enabled"}`,
+			withError:      &iam.Error{Title: "Bad Request", Detail: "TFA is not enabled", StatusCode: http.StatusBadRequest},
+		},
+		"validation error": {
+			params:    ResetUserTFARequest{},
+			withError: iam.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPut, "/identity-management/v2/user-admin/ui-identities/A-B-123456/tfa?action=reset", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.ResetUserTFA(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResetUserPassword(t *testing.T) {
+	tests := map[string]struct {
+		params         ResetUserPasswordRequest
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"200 OK": {
+			params:         ResetUserPasswordRequest{IdentityID: "A-B-123456"},
+			responseStatus: http.StatusOK,
+			responseBody:   `{}`,
+		},
+		"404 Not Found": {
+			params:         ResetUserPasswordRequest{IdentityID: "A-B-123456"},
+			responseStatus: http.StatusNotFound,
+			responseBody:   notFoundBody,
+			withError:      notFoundError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := mockServer(t, http.MethodPost, "/identity-management/v2/user-admin/ui-identities/A-B-123456/reset-password?sendEmail=true", "",
+				test.responseStatus, test.responseBody)
+			client := mockAPIClient(t, server)
+			err := client.ResetUserPassword(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
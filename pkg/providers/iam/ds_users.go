@@ -0,0 +1,389 @@
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// usersCSVHeader is the header row of the csv attribute, which has one row per grant of each user
+var usersCSVHeader = []string{
+	"user_id", "user_name", "first_name", "last_name", "email", "is_locked", "tfa_enabled", "tfa_configured", "last_login",
+	"group_id", "group_name", "role_id", "role_name", "is_blocked",
+}
+
+func (p *provider) dsUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "List the users of the account with their grants, for access reviews and for importing them",
+		ReadContext: p.tfCRUD("ds:Users:Read", p.dsUsersRead),
+		Schema: map[string]*schema.Schema{
+			// inputs
+			"group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only list the users with a role in the group",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only list the users granted the role in any group, including roles inherited by sub-groups",
+			},
+			"last_login_older_than_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Only list the users who haven't logged in for at least the number of days, including users who never logged in",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the locked users when true, or the unlocked users when false",
+			},
+
+			// outputs
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users, ordered by user name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the user, which is the ID of the akamai_iam_user resource",
+						},
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A user's `loginId`. Typically, a user's email address",
+						},
+						"first_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user's first name",
+						},
+						"last_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user's surname",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user's email address",
+						},
+						"is_locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The user's lock status",
+						},
+						"tfa_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether two-factor authentication is allowed",
+						},
+						"tfa_configured": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether two-factor authentication is configured",
+						},
+						"last_login": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ISO 8601 timestamp indicating when the user last logged in",
+						},
+						"grants": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The user's role in each group, with the nested groups flattened",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Identifies the group",
+									},
+									"group_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The group's name",
+									},
+									"role_id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Identifies the role the user has in the group, either granted in the group or inherited from its parent",
+									},
+									"role_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the role the user has in the group",
+									},
+									"is_blocked": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Indicates whether the user is blocked from accessing the group",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"csv": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The users in CSV form, with one row per grant of each user",
+			},
+			"import_blocks": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An import block for each user, to adopt the users into akamai_iam_user resources",
+			},
+		},
+	}
+}
+
+// userGrant is a user's role in one group, with the nested grants flattened
+type userGrant struct {
+	GroupID   int
+	GroupName string
+	RoleID    int
+	RoleName  string
+	IsBlocked bool
+}
+
+func (p *provider) dsUsersRead(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	req := ListUsersRequest{
+		GroupID:    int64(d.Get("group_id").(int)),
+		AuthGrants: true,
+	}
+
+	logger.Debug("Fetching users")
+	Users, err := p.client.ListUsers(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("Could not get users")
+		return diag.Errorf("could not get users: %s", err)
+	}
+
+	RoleID := d.Get("role_id").(int)
+	Locked, filterLocked := d.GetOkExists("locked")
+
+	var LoginBefore time.Time
+	if days := d.Get("last_login_older_than_days").(int); days > 0 {
+		LoginBefore = time.Now().AddDate(0, 0, -days)
+	}
+
+	var Filtered []iam.User
+	for _, User := range Users {
+		if filterLocked && User.IsLocked != Locked.(bool) {
+			continue
+		}
+
+		if RoleID != 0 && !hasRole(flattenAuthGrants(User.AuthGrants, nil), RoleID) {
+			continue
+		}
+
+		if !LoginBefore.IsZero() && User.LastLoginDate != "" {
+			LastLogin, err := time.Parse(time.RFC3339, User.LastLoginDate)
+			if err != nil {
+				logger.WithError(err).Error("Could not parse last login date")
+				return diag.Errorf("could not parse last login date of user %q: %s", User.UserName, err)
+			}
+			if !LastLogin.Before(LoginBefore) {
+				continue
+			}
+		}
+
+		Filtered = append(Filtered, User)
+	}
+
+	sort.Slice(Filtered, func(i, j int) bool {
+		return Filtered[i].UserName < Filtered[j].UserName
+	})
+
+	csv, err := usersToCSV(Filtered)
+	if err != nil {
+		logger.WithError(err).Error("Could not render users as CSV")
+		return diag.Errorf("could not render users as CSV: %s", err)
+	}
+
+	err = tools.SetAttrs(d, map[string]interface{}{
+		"users":         usersToState(Filtered),
+		"csv":           csv,
+		"import_blocks": usersImportBlocks(Filtered),
+	})
+	if err != nil {
+		logger.WithError(err).Error("Could not set users in state")
+		return diag.Errorf("could not set users in state: %s", err)
+	}
+
+	d.SetId("akamai_iam_users")
+	return nil
+}
+
+// flattenAuthGrants returns the grants with their sub-groups, which inherit the role of their parent when they have none
+func flattenAuthGrants(AuthGrants []iam.AuthGrant, ParentRole *iam.AuthGrant) []userGrant {
+	var out []userGrant
+
+	for _, ag := range AuthGrants {
+		ag := ag
+		Role := ParentRole
+		if ag.RoleID != nil {
+			Role = &ag
+		}
+
+		g := userGrant{
+			GroupID:   ag.GroupID,
+			GroupName: ag.GroupName,
+			IsBlocked: ag.IsBlocked,
+		}
+		if Role != nil {
+			g.RoleID = *Role.RoleID
+			g.RoleName = Role.RoleName
+		}
+
+		out = append(out, g)
+		out = append(out, flattenAuthGrants(ag.Subgroups, Role)...)
+	}
+
+	return out
+}
+
+func hasRole(Grants []userGrant, RoleID int) bool {
+	for _, g := range Grants {
+		if g.RoleID == RoleID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func usersToState(Users []iam.User) []interface{} {
+	out := make([]interface{}, 0, len(Users))
+
+	for _, u := range Users {
+		var grants []interface{}
+		for _, g := range flattenAuthGrants(u.AuthGrants, nil) {
+			grants = append(grants, map[string]interface{}{
+				"group_id":   g.GroupID,
+				"group_name": g.GroupName,
+				"role_id":    g.RoleID,
+				"role_name":  g.RoleName,
+				"is_blocked": g.IsBlocked,
+			})
+		}
+
+		out = append(out, map[string]interface{}{
+			"user_id":        u.IdentityID,
+			"user_name":      u.UserName,
+			"first_name":     u.FirstName,
+			"last_name":      u.LastName,
+			"email":          u.Email,
+			"is_locked":      u.IsLocked,
+			"tfa_enabled":    u.TFAEnabled,
+			"tfa_configured": u.TFAConfigured,
+			"last_login":     u.LastLoginDate,
+			"grants":         grants,
+		})
+	}
+
+	return out
+}
+
+// usersToCSV renders the users with one row per grant. Users without grants have a row with empty grant columns
+func usersToCSV(Users []iam.User) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(usersCSVHeader); err != nil {
+		return "", err
+	}
+
+	for _, u := range Users {
+		user := []string{
+			u.IdentityID,
+			u.UserName,
+			u.FirstName,
+			u.LastName,
+			u.Email,
+			strconv.FormatBool(u.IsLocked),
+			strconv.FormatBool(u.TFAEnabled),
+			strconv.FormatBool(u.TFAConfigured),
+			u.LastLoginDate,
+		}
+
+		Grants := flattenAuthGrants(u.AuthGrants, nil)
+		if len(Grants) == 0 {
+			if err := w.Write(append(user, "", "", "", "", "")); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		for _, g := range Grants {
+			var roleID string
+			if g.RoleID != 0 {
+				roleID = strconv.Itoa(g.RoleID)
+			}
+
+			row := append(append([]string{}, user...),
+				strconv.Itoa(g.GroupID), g.GroupName, roleID, g.RoleName, strconv.FormatBool(g.IsBlocked))
+			if err := w.Write(row); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// usersImportBlocks renders an import block for each user, named after the user name
+func usersImportBlocks(Users []iam.User) string {
+	var b strings.Builder
+	names := map[string]int{}
+
+	for i, u := range Users {
+		name := userResourceName(u.UserName)
+		names[name]++
+		if names[name] > 1 {
+			name = fmt.Sprintf("%s_%d", name, names[name])
+		}
+
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "import {\n  to = akamai_iam_user.%s\n  id = %q\n}\n", name, u.IdentityID)
+	}
+
+	return b.String()
+}
+
+// userResourceName returns a valid resource name derived from the user name
+func userResourceName(UserName string) string {
+	name := strings.Trim(regexp.MustCompile(`[^a-z0-9_]+`).ReplaceAllLiteralString(strings.ToLower(UserName), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "user_" + name
+	}
+
+	return name
+}
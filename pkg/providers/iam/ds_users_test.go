@@ -0,0 +1,153 @@
+package iam
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/test"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDSUsers(t *testing.T) {
+	t.Parallel()
+
+	recentLogin := time.Now().AddDate(0, 0, -1).UTC().Format(time.RFC3339)
+
+	users := func() []iam.User {
+		return []iam.User{
+			{
+				IdentityID:    "A-B-222222",
+				UserBasicInfo: iam.UserBasicInfo{UserName: "jsmith@example.com", FirstName: "Jane", LastName: "Smith", Email: "jsmith@example.com", TFAEnabled: true},
+				TFAConfigured: true,
+				LastLoginDate: recentLogin,
+				AuthGrants:    []iam.AuthGrant{{GroupID: 12345, GroupName: "Top", RoleID: tools.IntPtr(14), RoleName: "Admin"}},
+			},
+			{
+				IdentityID:    "A-B-111111",
+				UserBasicInfo: iam.UserBasicInfo{UserName: "jdoe", FirstName: "John", LastName: "Doe", Email: "jdoe@example.com"},
+				LastLoginDate: "2020-01-01T00:00:00.000Z",
+				AuthGrants: []iam.AuthGrant{{
+					GroupID:   12345,
+					GroupName: "Top",
+					RoleID:    tools.IntPtr(12),
+					RoleName:  "Editor",
+					Subgroups: []iam.AuthGrant{
+						{GroupID: 23456, GroupName: "Nested", IsBlocked: true},
+						{GroupID: 34567, GroupName: "Admins", RoleID: tools.IntPtr(14), RoleName: "Admin"},
+					},
+				}},
+			},
+			{
+				IdentityID:    "A-B-333333",
+				UserBasicInfo: iam.UserBasicInfo{UserName: "locked", FirstName: "Lock", LastName: "Ed", Email: "locked@example.com"},
+				IsLocked:      true,
+			},
+		}
+	}
+
+	t.Run("all users", func(t *testing.T) {
+		t.Parallel()
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+		client.On("ListUsers", mock.Anything, ListUsersRequest{AuthGrants: true}).Return(users(), nil)
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestDSUsers/all.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.#", "3"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.user_name", "jdoe"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.user_id", "A-B-111111"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.grants.#", "3"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.grants.1.group_id", "23456"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.grants.1.role_id", "12"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.grants.1.role_name", "Editor"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.grants.1.is_blocked", "true"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.grants.2.role_id", "14"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.1.user_name", "jsmith@example.com"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.1.tfa_configured", "true"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.2.is_locked", "true"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.2.grants.#", "0"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "csv",
+							"user_id,user_name,first_name,last_name,email,is_locked,tfa_enabled,tfa_configured,last_login,group_id,group_name,role_id,role_name,is_blocked\n"+
+								"A-B-111111,jdoe,John,Doe,jdoe@example.com,false,false,false,2020-01-01T00:00:00.000Z,12345,Top,12,Editor,false\n"+
+								"A-B-111111,jdoe,John,Doe,jdoe@example.com,false,false,false,2020-01-01T00:00:00.000Z,23456,Nested,12,Editor,true\n"+
+								"A-B-111111,jdoe,John,Doe,jdoe@example.com,false,false,false,2020-01-01T00:00:00.000Z,34567,Admins,14,Admin,false\n"+
+								"A-B-222222,jsmith@example.com,Jane,Smith,jsmith@example.com,false,true,true,"+recentLogin+",12345,Top,14,Admin,false\n"+
+								"A-B-333333,locked,Lock,Ed,locked@example.com,true,false,false,,,,,,\n"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "import_blocks",
+							"import {\n  to = akamai_iam_user.jdoe\n  id = \"A-B-111111\"\n}\n\n"+
+								"import {\n  to = akamai_iam_user.jsmith_example_com\n  id = \"A-B-222222\"\n}\n\n"+
+								"import {\n  to = akamai_iam_user.locked\n  id = \"A-B-333333\"\n}\n"),
+					),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("filtered users", func(t *testing.T) {
+		t.Parallel()
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+		client.On("ListUsers", mock.Anything, ListUsersRequest{GroupID: 12345, AuthGrants: true}).Return(users(), nil)
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					// jsmith logged in recently, and locked has no admin role
+					Config: test.Fixture("testdata/TestDSUsers/filtered.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.#", "1"),
+						resource.TestCheckResourceAttr("data.akamai_iam_users.test", "users.0.user_name", "jdoe"),
+					),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("fail path", func(t *testing.T) {
+		t.Parallel()
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+		client.On("ListUsers", mock.Anything, ListUsersRequest{AuthGrants: true}).Return(nil, errors.New("failed to get users"))
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.Fixture("testdata/TestDSUsers/all.tf"),
+					ExpectError: regexp.MustCompile("failed to get users"),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
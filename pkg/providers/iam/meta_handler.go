@@ -3,7 +3,6 @@ package iam
 import (
 	"context"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/apex/log"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +27,24 @@ func (p *provider) tfCRUD(opName string, impl tfCRUDFunc) tfCRUDFunc {
 	}
 }
 
+// Alias for the TF CustomizeDiff function signature
+type tfCustomizeDiffFunc = func(context.Context, *schema.ResourceDiff, interface{}) error
+
+// Compose a TF CustomizeDiff function that processes the meta and invokes the impl with no meta
+func (p *provider) tfCustomizeDiff(opName string, impl tfCustomizeDiffFunc) tfCustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		p.mtx.Lock() // Serialize any requests which may impact injected dependencies
+		defer p.mtx.Unlock()
+
+		ctx = p.handleMeta(ctx, m, opName)
+
+		p.log(ctx).Debugf("Start of Terraform action")
+		defer p.log(ctx).Debugf("End of Terraform action")
+
+		return impl(ctx, d, nil)
+	}
+}
+
 // Compose a schema.ResourceImporter that processes the meta and invokes the impl with no meta
 func (p *provider) tfImporter(opName string, impl schema.StateContextFunc) *schema.ResourceImporter {
 	return &schema.ResourceImporter{
@@ -56,7 +73,7 @@ func (p *provider) handleMeta(ctx context.Context, m interface{}, opName string)
 	logger := meta.Log("IAM", opName)
 	logger = logger.WithFields(log.Fields{"operation_id": meta.OperationID()})
 
-	p.SetIAM(iam.Client(meta.Session()))
+	p.SetIAM(Client(meta.Session()))
 	p.SetCache(metaCache{p, meta})
 
 	return log.NewContext(ctx, logger)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
 	"github.com/apex/log"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,7 +11,7 @@ import (
 )
 
 type provider struct {
-	client iam.IAM
+	client API
 	cache  Cache
 	mtx    sync.Mutex
 }
@@ -25,7 +24,12 @@ func (p *provider) Schema() map[string]*schema.Schema {
 // Resources returns the subprovider's resource schema map
 func (p *provider) Resources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"akamai_iam_user": p.resUser(),
+		"akamai_iam_user":                  p.resUser(),
+		"akamai_iam_group":                 p.resGroup(),
+		"akamai_iam_role":                  p.resRole(),
+		"akamai_iam_blocked_properties":    p.resBlockedProperties(),
+		"akamai_iam_api_client":            p.resAPIClient(),
+		"akamai_iam_api_client_credential": p.resAPIClientCredential(),
 	}
 }
 
@@ -39,6 +43,7 @@ func (p *provider) DataSources() map[string]*schema.Resource {
 		"akamai_iam_supported_langs":  p.dsLanguages(),
 		"akamai_iam_timeout_policies": p.dsTimeoutPolicies(),
 		"akamai_iam_states":           p.dsStates(),
+		"akamai_iam_users":            p.dsUsers(),
 	}
 }
 
@@ -66,14 +71,14 @@ func (p *provider) Version() string {
 	return "v0.0.1"
 }
 
-// SetIAM allows injection of an IAM.Client
-func (p *provider) SetIAM(c iam.IAM) {
+// SetIAM allows injection of an IAM API client
+func (p *provider) SetIAM(c API) {
 	p.client = c
 }
 
 // SetSession allows injection of a session.Session
 func (p *provider) SetSession(s session.Session) {
-	p.SetIAM(iam.Client(s))
+	p.SetIAM(Client(s))
 }
 
 // SetCache allows injection of a Cache
@@ -0,0 +1,298 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func (p *provider) resAPIClient() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an API client in your account",
+		CreateContext: p.tfCRUD("res:APIClient:Create", p.resAPIClientCreate),
+		ReadContext:   p.tfCRUD("res:APIClient:Read", p.resAPIClientRead),
+		UpdateContext: p.tfCRUD("res:APIClient:Update", p.resAPIClientUpdate),
+		DeleteContext: p.tfCRUD("res:APIClient:Delete", p.resAPIClientDelete),
+		Importer:      p.tfImporter("res:APIClient:Import", schema.ImportStatePassthroughContext),
+		Schema: map[string]*schema.Schema{
+			// Inputs - Required
+			"client_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The API client's name",
+			},
+			"authorized_users": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The user names of the users who can manage the API client and its credentials",
+			},
+			"api_access": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The APIs the API client can access",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_accessible_apis": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the API client can access all APIs available to the authorized users",
+						},
+						"apis": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The APIs the API client can access when all_accessible_apis is false",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_id": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "A unique identifier for the API",
+									},
+									"access_level": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{APIAccessLevelReadOnly, APIAccessLevelReadWrite}, false),
+										Description:  "The API client's access level to the API, either READ-ONLY or READ-WRITE",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"group_access": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The groups the API client can access",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"clone_authorized_user_groups": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the API client has the same group access as the authorized users",
+						},
+						"groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The API client's per-group role assignments when clone_authorized_user_groups is false",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_id": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "A unique identifier for the group",
+									},
+									"role_id": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "A unique identifier for the role the API client has in the group",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Inputs - Optional
+			"client_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The API client's description",
+			},
+			"notification_emails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The email addresses notified about expiring credentials of the API client",
+			},
+			"allow_account_switch": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the API client can manage other accounts the authorized users have access to",
+			},
+
+			// Outputs
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A unique identifier for the API client",
+			},
+			"client_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API client's type",
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access token used together with a credential of the API client to sign requests",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user name of the person who created the API client",
+			},
+			"created_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ISO 8601 timestamp indicating when the API client was created",
+			},
+		},
+	}
+}
+
+func (p *provider) resAPIClientCreate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	Client, err := p.client.CreateAPIClient(ctx, CreateAPIClientRequest{Settings: apiClientSettingsFromState(d)})
+	if err != nil {
+		logger.WithError(err).Error("failed to create API client")
+		return diag.Errorf("failed to create API client: %s", err)
+	}
+
+	d.SetId(Client.ClientID)
+	return p.resAPIClientRead(ctx, d, nil)
+}
+
+func (p *provider) resAPIClientRead(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	Client, err := p.client.GetAPIClient(ctx, GetAPIClientRequest{ClientID: d.Id()})
+	if err != nil {
+		if isNotFound(err) {
+			logger.Warnf("API client %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		logger.WithError(err).Error("failed to fetch API client")
+		return diag.Errorf("failed to fetch API client: %s", err)
+	}
+
+	// The API lists the resulting APIs and groups also when they are derived from the authorized users,
+	// they are only kept in state when set explicitly
+	var APIs []interface{}
+	if !Client.APIAccess.AllAccessibleAPIs {
+		for _, API := range Client.APIAccess.APIs {
+			APIs = append(APIs, map[string]interface{}{
+				"api_id":       int(API.APIID),
+				"access_level": API.AccessLevel,
+			})
+		}
+	}
+
+	var Groups []interface{}
+	if !Client.GroupAccess.CloneAuthorizedUserGroups {
+		for _, Group := range Client.GroupAccess.Groups {
+			Groups = append(Groups, map[string]interface{}{
+				"group_id": int(Group.GroupID),
+				"role_id":  int(Group.RoleID),
+			})
+		}
+	}
+
+	err = tools.SetAttrs(d, map[string]interface{}{
+		"client_name":          Client.ClientName,
+		"client_description":   Client.ClientDescription,
+		"authorized_users":     Client.AuthorizedUsers,
+		"notification_emails":  Client.NotificationEmails,
+		"allow_account_switch": Client.AllowAccountSwitch,
+		"api_access": []interface{}{map[string]interface{}{
+			"all_accessible_apis": Client.APIAccess.AllAccessibleAPIs,
+			"apis":                APIs,
+		}},
+		"group_access": []interface{}{map[string]interface{}{
+			"clone_authorized_user_groups": Client.GroupAccess.CloneAuthorizedUserGroups,
+			"groups":                       Groups,
+		}},
+		"client_id":    Client.ClientID,
+		"client_type":  Client.ClientType,
+		"access_token": Client.AccessToken,
+		"created_by":   Client.CreatedBy,
+		"created_date": Client.CreatedDate,
+	})
+	if err != nil {
+		logger.WithError(err).Error("could not save attributes to state")
+		return diag.Errorf("could not save attributes to state: %s", err)
+	}
+
+	return nil
+}
+
+func (p *provider) resAPIClientUpdate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	req := UpdateAPIClientRequest{
+		ClientID: d.Id(),
+		Settings: apiClientSettingsFromState(d),
+	}
+	if _, err := p.client.UpdateAPIClient(ctx, req); err != nil {
+		logger.WithError(err).Error("failed to update API client")
+		return diag.Errorf("failed to update API client: %s", err)
+	}
+
+	return p.resAPIClientRead(ctx, d, nil)
+}
+
+func (p *provider) resAPIClientDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	if err := p.client.DeleteAPIClient(ctx, DeleteAPIClientRequest{ClientID: d.Id()}); err != nil {
+		logger.WithError(err).Error("could not delete API client")
+		return diag.Errorf("could not delete API client: %s", err)
+	}
+
+	return nil
+}
+
+// apiClientSettingsFromState returns the API client settings from the resource data. Credentials are managed by
+// the akamai_iam_api_client_credential resource, so none is created together with the API client
+func apiClientSettingsFromState(d *schema.ResourceData) APIClientSettings {
+	Settings := APIClientSettings{
+		ClientName:         d.Get("client_name").(string),
+		ClientDescription:  d.Get("client_description").(string),
+		AuthorizedUsers:    stringsFromList(d.Get("authorized_users").([]interface{})),
+		NotificationEmails: stringsFromList(d.Get("notification_emails").([]interface{})),
+		AllowAccountSwitch: d.Get("allow_account_switch").(bool),
+	}
+
+	if APIAccess, ok := d.Get("api_access.0").(map[string]interface{}); ok {
+		Settings.APIAccess.AllAccessibleAPIs = APIAccess["all_accessible_apis"].(bool)
+		for _, v := range APIAccess["apis"].(*schema.Set).List() {
+			API := v.(map[string]interface{})
+			Settings.APIAccess.APIs = append(Settings.APIAccess.APIs, APIScope{
+				APIID:       int64(API["api_id"].(int)),
+				AccessLevel: API["access_level"].(string),
+			})
+		}
+	}
+
+	if GroupAccess, ok := d.Get("group_access.0").(map[string]interface{}); ok {
+		Settings.GroupAccess.CloneAuthorizedUserGroups = GroupAccess["clone_authorized_user_groups"].(bool)
+		for _, v := range GroupAccess["groups"].(*schema.Set).List() {
+			Group := v.(map[string]interface{})
+			Settings.GroupAccess.Groups = append(Settings.GroupAccess.Groups, ClientGroup{
+				GroupID: int64(Group["group_id"].(int)),
+				RoleID:  int64(Group["role_id"].(int)),
+			})
+		}
+	}
+
+	return Settings
+}
+
+func stringsFromList(list []interface{}) []string {
+	var out []string
+	for _, v := range list {
+		out = append(out, v.(string))
+	}
+	return out
+}
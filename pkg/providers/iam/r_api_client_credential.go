@@ -0,0 +1,225 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func (p *provider) resAPIClientCredential() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a credential of an API client",
+		CreateContext: p.tfCRUD("res:APIClientCredential:Create", p.resAPIClientCredentialCreate),
+		ReadContext:   p.tfCRUD("res:APIClientCredential:Read", p.resAPIClientCredentialRead),
+		UpdateContext: p.tfCRUD("res:APIClientCredential:Update", p.resAPIClientCredentialUpdate),
+		DeleteContext: p.tfCRUD("res:APIClientCredential:Delete", p.resAPIClientCredentialDelete),
+		Importer:      p.tfImporter("res:APIClientCredential:Import", p.resAPIClientCredentialImport),
+		Schema: map[string]*schema.Schema{
+			// Inputs
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the API client the credential belongs to",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The credential's description",
+			},
+			"expires_on": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "ISO 8601 timestamp indicating when the credential expires. Defaults to two years after its creation",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      CredentialStatusActive,
+				ValidateFunc: validation.StringInSlice([]string{CredentialStatusActive, CredentialStatusInactive}, false),
+				Description:  "Whether the credential can be used to sign requests, either ACTIVE or INACTIVE",
+			},
+
+			// Outputs
+			"credential_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "A unique identifier for the credential",
+			},
+			"client_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client token of the credential",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client secret of the credential. It is only available when the credential is created, not when it is imported",
+			},
+			"created_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ISO 8601 timestamp indicating when the credential was created",
+			},
+		},
+	}
+}
+
+func (p *provider) resAPIClientCredentialCreate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	ClientID := d.Get("client_id").(string)
+	Credential, err := p.client.CreateCredential(ctx, CreateCredentialRequest{ClientID: ClientID})
+	if err != nil {
+		logger.WithError(err).Error("failed to create credential")
+		return diag.Errorf("failed to create credential: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", ClientID, Credential.CredentialID))
+	// The client secret is only returned when the credential is created
+	if err := d.Set("client_secret", Credential.ClientSecret); err != nil {
+		logger.WithError(err).Error("could not save attributes to state")
+		return diag.Errorf("could not save attributes to state: %s", err)
+	}
+
+	req := UpdateCredentialRequest{
+		ClientID:     ClientID,
+		CredentialID: Credential.CredentialID,
+		Description:  d.Get("description").(string),
+		ExpiresOn:    Credential.ExpiresOn,
+		Status:       d.Get("status").(string),
+	}
+	if ExpiresOn, ok := d.GetOk("expires_on"); ok {
+		req.ExpiresOn = ExpiresOn.(string)
+	}
+	if req.Description != Credential.Description || req.ExpiresOn != Credential.ExpiresOn || req.Status != Credential.Status {
+		if _, err := p.client.UpdateCredential(ctx, req); err != nil {
+			logger.WithError(err).Error("failed to update credential")
+			return diag.Errorf("failed to update credential: %s", err)
+		}
+	}
+
+	return p.resAPIClientCredentialRead(ctx, d, nil)
+}
+
+func (p *provider) resAPIClientCredentialRead(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	req, err := credentialRequestFromID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	Credential, err := p.client.GetCredential(ctx, req)
+	if err != nil {
+		if isNotFound(err) {
+			logger.Warnf("credential %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		logger.WithError(err).Error("failed to fetch credential")
+		return diag.Errorf("failed to fetch credential: %s", err)
+	}
+
+	err = tools.SetAttrs(d, map[string]interface{}{
+		"client_id":     req.ClientID,
+		"description":   Credential.Description,
+		"expires_on":    Credential.ExpiresOn,
+		"status":        Credential.Status,
+		"credential_id": int(Credential.CredentialID),
+		"client_token":  Credential.ClientToken,
+		"created_on":    Credential.CreatedOn,
+	})
+	if err != nil {
+		logger.WithError(err).Error("could not save attributes to state")
+		return diag.Errorf("could not save attributes to state: %s", err)
+	}
+
+	return nil
+}
+
+func (p *provider) resAPIClientCredentialUpdate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	CredentialReq, err := credentialRequestFromID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := UpdateCredentialRequest{
+		ClientID:     CredentialReq.ClientID,
+		CredentialID: CredentialReq.CredentialID,
+		Description:  d.Get("description").(string),
+		ExpiresOn:    d.Get("expires_on").(string),
+		Status:       d.Get("status").(string),
+	}
+	if _, err := p.client.UpdateCredential(ctx, req); err != nil {
+		logger.WithError(err).Error("failed to update credential")
+		return diag.Errorf("failed to update credential: %s", err)
+	}
+
+	return p.resAPIClientCredentialRead(ctx, d, nil)
+}
+
+func (p *provider) resAPIClientCredentialDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	req, err := credentialRequestFromID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only inactive credentials can be deleted
+	if d.Get("status").(string) != CredentialStatusInactive {
+		_, err := p.client.UpdateCredential(ctx, UpdateCredentialRequest{
+			ClientID:     req.ClientID,
+			CredentialID: req.CredentialID,
+			Description:  d.Get("description").(string),
+			ExpiresOn:    d.Get("expires_on").(string),
+			Status:       CredentialStatusInactive,
+		})
+		if err != nil {
+			logger.WithError(err).Error("could not deactivate credential")
+			return diag.Errorf("could not deactivate credential: %s", err)
+		}
+	}
+
+	if err := p.client.DeleteCredential(ctx, req); err != nil {
+		logger.WithError(err).Error("could not delete credential")
+		return diag.Errorf("could not delete credential: %s", err)
+	}
+
+	return nil
+}
+
+// resAPIClientCredentialImport validates the client_id:credential_id import ID
+func (p *provider) resAPIClientCredentialImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, err := credentialRequestFromID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// credentialRequestFromID parses the client_id:credential_id resource ID
+func credentialRequestFromID(id string) (CredentialRequest, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" {
+		return CredentialRequest{}, fmt.Errorf("ID %q must be in the form client_id:credential_id", id)
+	}
+
+	CredentialID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return CredentialRequest{}, fmt.Errorf("invalid credential ID %q: %s", parts[1], err)
+	}
+
+	return CredentialRequest{ClientID: parts[0], CredentialID: CredentialID}, nil
+}
@@ -0,0 +1,143 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResAPIClientCredential(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create, update and import", func(t *testing.T) {
+		t.Parallel()
+
+		Cred := Credential{
+			CredentialID: 777,
+			ClientToken:  "akab-client-token",
+			ClientSecret: "secret",
+			CreatedOn:    "2022-01-01T00:00:00Z",
+			ExpiresOn:    "2024-01-01T00:00:00Z",
+			Status:       CredentialStatusActive,
+		}
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		client.On("CreateCredential", mock.Anything, CreateCredentialRequest{ClientID: "abcd1234"}).
+			Return(func(context.Context, CreateCredentialRequest) *Credential {
+				c := Cred
+				return &c
+			}, nil).Once()
+
+		client.On("GetCredential", mock.Anything, CredentialRequest{ClientID: "abcd1234", CredentialID: 777}).Return(
+			func(context.Context, CredentialRequest) *Credential {
+				c := Cred
+				// the client secret is only returned when the credential is created
+				c.ClientSecret = ""
+				return &c
+			}, nil)
+
+		// the description is set after the credential is created, keeping its default expiration
+		for _, req := range []UpdateCredentialRequest{
+			{Description: "CI pipeline", ExpiresOn: "2024-01-01T00:00:00Z", Status: CredentialStatusActive},
+			{Description: "CI pipeline", ExpiresOn: "2023-06-30T00:00:00Z", Status: CredentialStatusInactive},
+		} {
+			req := req
+			req.ClientID = "abcd1234"
+			req.CredentialID = 777
+			client.On("UpdateCredential", mock.Anything, req).Run(func(mock.Arguments) {
+				Cred.Description = req.Description
+				Cred.ExpiresOn = req.ExpiresOn
+				Cred.Status = req.Status
+			}).Return(&Cred, nil).Once()
+		}
+
+		// the credential is inactive, so it is deleted without being deactivated
+		client.On("DeleteCredential", mock.Anything, CredentialRequest{ClientID: "abcd1234", CredentialID: 777}).Return(nil).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResAPIClientCredential/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "id", "abcd1234:777"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "credential_id", "777"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "client_token", "akab-client-token"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "client_secret", "secret"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "description", "CI pipeline"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "expires_on", "2024-01-01T00:00:00Z"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "status", "ACTIVE"),
+					),
+				},
+				{
+					Config: test.Fixture("testdata/TestResAPIClientCredential/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "client_secret", "secret"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "expires_on", "2023-06-30T00:00:00Z"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "status", "INACTIVE"),
+					),
+				},
+				{
+					ResourceName:            "akamai_iam_api_client_credential.test",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"client_secret"},
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("active credential is deactivated before it is deleted", func(t *testing.T) {
+		t.Parallel()
+
+		Cred := Credential{
+			CredentialID: 777,
+			ClientToken:  "akab-client-token",
+			ClientSecret: "secret",
+			ExpiresOn:    "2024-01-01T00:00:00Z",
+			Description:  "CI pipeline",
+			Status:       CredentialStatusActive,
+		}
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		client.On("CreateCredential", mock.Anything, CreateCredentialRequest{ClientID: "abcd1234"}).Return(&Cred, nil).Once()
+		client.On("GetCredential", mock.Anything, CredentialRequest{ClientID: "abcd1234", CredentialID: 777}).Return(&Cred, nil)
+		client.On("UpdateCredential", mock.Anything, UpdateCredentialRequest{
+			ClientID:     "abcd1234",
+			CredentialID: 777,
+			Description:  "CI pipeline",
+			ExpiresOn:    "2024-01-01T00:00:00Z",
+			Status:       CredentialStatusInactive,
+		}).Return(&Cred, nil).Once()
+		client.On("DeleteCredential", mock.Anything, CredentialRequest{ClientID: "abcd1234", CredentialID: 777}).Return(nil).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResAPIClientCredential/create.tf"),
+					Check:  resource.TestCheckResourceAttr("akamai_iam_api_client_credential.test", "status", "ACTIVE"),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
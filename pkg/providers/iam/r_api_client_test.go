@@ -0,0 +1,118 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResAPIClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create, update and import", func(t *testing.T) {
+		t.Parallel()
+
+		Client := APIClient{
+			ClientID:        "abcd1234",
+			ClientName:      "Test client",
+			ClientType:      "CLIENT",
+			AccessToken:     "akab-access-token",
+			AuthorizedUsers: []string{"jdoe"},
+			APIAccess: APIAccess{
+				APIs: []APIScope{{APIID: 5580, APIName: "Search Data Feed", AccessLevel: APIAccessLevelReadWrite}},
+			},
+			GroupAccess: GroupAccess{
+				Groups: []ClientGroup{{GroupID: 12345, GroupName: "Test group", RoleID: 555, RoleName: "Test role"}},
+			},
+			CreatedBy:   "jdoe",
+			CreatedDate: "2022-01-01T00:00:00.000Z",
+		}
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		client.On("CreateAPIClient", mock.Anything, CreateAPIClientRequest{Settings: APIClientSettings{
+			ClientName:      "Test client",
+			AuthorizedUsers: []string{"jdoe"},
+			APIAccess: APIAccess{
+				APIs: []APIScope{{APIID: 5580, AccessLevel: APIAccessLevelReadWrite}},
+			},
+			GroupAccess: GroupAccess{
+				Groups: []ClientGroup{{GroupID: 12345, RoleID: 555}},
+			},
+		}}).Return(&Client, nil).Once()
+
+		client.On("GetAPIClient", mock.Anything, GetAPIClientRequest{ClientID: "abcd1234"}).Return(
+			func(context.Context, GetAPIClientRequest) *APIClient {
+				c := Client
+				return &c
+			}, nil)
+
+		client.On("UpdateAPIClient", mock.Anything, UpdateAPIClientRequest{ClientID: "abcd1234", Settings: APIClientSettings{
+			ClientName:         "Test client",
+			ClientDescription:  "Deploys the configuration",
+			AuthorizedUsers:    []string{"jdoe"},
+			NotificationEmails: []string{"jdoe@example.com"},
+			APIAccess:          APIAccess{AllAccessibleAPIs: true},
+			GroupAccess:        GroupAccess{CloneAuthorizedUserGroups: true},
+		}}).Run(func(mock.Arguments) {
+			Client.ClientDescription = "Deploys the configuration"
+			Client.NotificationEmails = []string{"jdoe@example.com"}
+			// the API lists the APIs and groups derived from the authorized users
+			Client.APIAccess.AllAccessibleAPIs = true
+			Client.GroupAccess.CloneAuthorizedUserGroups = true
+		}).Return(&Client, nil).Once()
+
+		client.On("DeleteAPIClient", mock.Anything, DeleteAPIClientRequest{ClientID: "abcd1234"}).Return(nil).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResAPIClient/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "id", "abcd1234"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "client_id", "abcd1234"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "client_type", "CLIENT"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "access_token", "akab-access-token"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "api_access.0.apis.#", "1"),
+						resource.TestCheckTypeSetElemNestedAttrs("akamai_iam_api_client.test", "api_access.0.apis.*", map[string]string{
+							"api_id":       "5580",
+							"access_level": "READ-WRITE",
+						}),
+						resource.TestCheckTypeSetElemNestedAttrs("akamai_iam_api_client.test", "group_access.0.groups.*", map[string]string{
+							"group_id": "12345",
+							"role_id":  "555",
+						}),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "created_by", "jdoe"),
+					),
+				},
+				{
+					Config: test.Fixture("testdata/TestResAPIClient/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "client_description", "Deploys the configuration"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "notification_emails.0", "jdoe@example.com"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "api_access.0.all_accessible_apis", "true"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "api_access.0.apis.#", "0"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "group_access.0.clone_authorized_user_groups", "true"),
+						resource.TestCheckResourceAttr("akamai_iam_api_client.test", "group_access.0.groups.#", "0"),
+					),
+				},
+				{
+					ResourceName:      "akamai_iam_api_client.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,130 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func (p *provider) resBlockedProperties() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the properties a user can't access in a group",
+		CreateContext: p.tfCRUD("res:BlockedProperties:Create", p.resBlockedPropertiesUpdate),
+		ReadContext:   p.tfCRUD("res:BlockedProperties:Read", p.resBlockedPropertiesRead),
+		UpdateContext: p.tfCRUD("res:BlockedProperties:Update", p.resBlockedPropertiesUpdate),
+		DeleteContext: p.tfCRUD("res:BlockedProperties:Delete", p.resBlockedPropertiesDelete),
+		Importer:      p.tfImporter("res:BlockedProperties:Import", p.resBlockedPropertiesImport),
+		Schema: map[string]*schema.Schema{
+			"identity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the user",
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the group the properties belong to",
+			},
+			"blocked_properties": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the properties the user can't access in the group",
+			},
+		},
+	}
+}
+
+func (p *provider) resBlockedPropertiesRead(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	req := ListBlockedPropertiesRequest{
+		IdentityID: d.Get("identity_id").(string),
+		GroupID:    int64(d.Get("group_id").(int)),
+	}
+
+	Properties, err := p.client.ListBlockedProperties(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("failed to fetch blocked properties")
+		return diag.Errorf("failed to fetch blocked properties: %s", err)
+	}
+
+	var BlockedProperties []interface{}
+	for _, PropertyID := range Properties {
+		BlockedProperties = append(BlockedProperties, int(PropertyID))
+	}
+
+	if err := d.Set("blocked_properties", BlockedProperties); err != nil {
+		logger.WithError(err).Error("could not save attributes to state")
+		return diag.Errorf("could not save attributes to state: %s", err)
+	}
+
+	return nil
+}
+
+func (p *provider) resBlockedPropertiesUpdate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	req := UpdateBlockedPropertiesRequest{
+		IdentityID: d.Get("identity_id").(string),
+		GroupID:    int64(d.Get("group_id").(int)),
+		Properties: int64sFromSet(d.Get("blocked_properties").(*schema.Set)),
+	}
+
+	if _, err := p.client.UpdateBlockedProperties(ctx, req); err != nil {
+		logger.WithError(err).Error("failed to update blocked properties")
+		return diag.Errorf("failed to update blocked properties: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", req.IdentityID, req.GroupID))
+	return p.resBlockedPropertiesRead(ctx, d, nil)
+}
+
+func (p *provider) resBlockedPropertiesDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	// The user can access all properties of the group again once the resource is destroyed
+	req := UpdateBlockedPropertiesRequest{
+		IdentityID: d.Get("identity_id").(string),
+		GroupID:    int64(d.Get("group_id").(int)),
+		Properties: []int64{},
+	}
+
+	if _, err := p.client.UpdateBlockedProperties(ctx, req); err != nil {
+		logger.WithError(err).Error("could not unblock properties")
+		return diag.Errorf("could not unblock properties: %s", err)
+	}
+
+	return nil
+}
+
+// resBlockedPropertiesImport imports the blocked properties by the identity_id:group_id import ID
+func (p *provider) resBlockedPropertiesImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, fmt.Errorf("import ID %q must be in the form identity_id:group_id", d.Id())
+	}
+
+	GroupID, err := strconv.Atoi(strings.TrimPrefix(parts[1], "grp_"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid group ID %q: %s", parts[1], err)
+	}
+
+	err = tools.SetAttrs(d, map[string]interface{}{
+		"identity_id": parts[0],
+		"group_id":    GroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", parts[0], GroupID))
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,102 @@
+package iam
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResBlockedProperties(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create, update and import", func(t *testing.T) {
+		t.Parallel()
+
+		var Properties []int64
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		listReq := ListBlockedPropertiesRequest{IdentityID: "A-B-123456", GroupID: 12345}
+		client.On("ListBlockedProperties", mock.Anything, listReq).Return(
+			func(context.Context, ListBlockedPropertiesRequest) []int64 {
+				return Properties
+			}, nil)
+
+		for _, Update := range [][]int64{{111, 222}, {333}, nil} {
+			Update := Update
+			req := UpdateBlockedPropertiesRequest{IdentityID: "A-B-123456", GroupID: 12345, Properties: Update}
+			if Update == nil {
+				// all properties are unblocked on destroy
+				req.Properties = []int64{}
+			}
+			client.On("UpdateBlockedProperties", mock.Anything, req).Run(func(mock.Arguments) {
+				Properties = Update
+			}).Return(Update, nil).Once()
+		}
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResBlockedProperties/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_blocked_properties.test", "id", "A-B-123456:12345"),
+						resource.TestCheckResourceAttr("akamai_iam_blocked_properties.test", "blocked_properties.#", "2"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_blocked_properties.test", "blocked_properties.*", "111"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_blocked_properties.test", "blocked_properties.*", "222"),
+					),
+				},
+				{
+					Config: test.Fixture("testdata/TestResBlockedProperties/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_blocked_properties.test", "blocked_properties.#", "1"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_blocked_properties.test", "blocked_properties.*", "333"),
+					),
+				},
+				{
+					ResourceName:      "akamai_iam_blocked_properties.test",
+					ImportState:       true,
+					ImportStateId:     "A-B-123456:grp_12345",
+					ImportStateVerify: true,
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("invalid import ID", func(t *testing.T) {
+		t.Parallel()
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:        test.Fixture("testdata/TestResBlockedProperties/create.tf"),
+					ResourceName:  "akamai_iam_blocked_properties.test",
+					ImportState:   true,
+					ImportStateId: "A-B-123456",
+					ExpectError:   regexp.MustCompile("must be in the form identity_id:group_id"),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
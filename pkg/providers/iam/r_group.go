@@ -0,0 +1,159 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func (p *provider) resGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a group in your account",
+		CreateContext: p.tfCRUD("res:Group:Create", p.resGroupCreate),
+		ReadContext:   p.tfCRUD("res:Group:Read", p.resGroupRead),
+		UpdateContext: p.tfCRUD("res:Group:Update", p.resGroupUpdate),
+		DeleteContext: p.tfCRUD("res:Group:Delete", p.resGroupDelete),
+		Importer:      p.tfImporter("res:Group:Import", schema.ImportStatePassthroughContext),
+		Schema: map[string]*schema.Schema{
+			// Inputs
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The group's name",
+			},
+			"parent_group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the parent group. Changing it moves the group, with its sub-groups, under the new parent",
+			},
+
+			// Outputs
+			"group_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "A unique identifier for the group",
+			},
+			"sub_groups": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the groups nested directly in the group",
+			},
+		},
+	}
+}
+
+func (p *provider) resGroupCreate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	Group, err := p.client.CreateGroup(ctx, CreateGroupRequest{
+		ParentGroupID: int64(d.Get("parent_group_id").(int)),
+		GroupName:     d.Get("name").(string),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to create group")
+		return diag.Errorf("failed to create group: %s", err)
+	}
+
+	d.SetId(strconv.FormatInt(Group.GroupID, 10))
+	return p.resGroupRead(ctx, d, nil)
+}
+
+func (p *provider) resGroupRead(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	GroupID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid group ID %q: %s", d.Id(), err)
+	}
+
+	Group, err := p.client.GetGroup(ctx, GetGroupRequest{GroupID: GroupID})
+	if err != nil {
+		if isNotFound(err) {
+			logger.Warnf("group %d not found, removing from state", GroupID)
+			d.SetId("")
+			return nil
+		}
+		logger.WithError(err).Error("failed to fetch group")
+		return diag.Errorf("failed to fetch group: %s", err)
+	}
+
+	var SubGroups []interface{}
+	for _, SubGroup := range Group.SubGroups {
+		SubGroups = append(SubGroups, int(SubGroup.GroupID))
+	}
+
+	err = tools.SetAttrs(d, map[string]interface{}{
+		"name":            Group.GroupName,
+		"parent_group_id": int(Group.ParentGroupID),
+		"group_id":        int(Group.GroupID),
+		"sub_groups":      SubGroups,
+	})
+	if err != nil {
+		logger.WithError(err).Error("could not save attributes to state")
+		return diag.Errorf("could not save attributes to state: %s", err)
+	}
+
+	return nil
+}
+
+func (p *provider) resGroupUpdate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	GroupID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid group ID %q: %s", d.Id(), err)
+	}
+
+	if d.HasChange("parent_group_id") {
+		req := MoveGroupRequest{
+			SourceGroupID:      GroupID,
+			DestinationGroupID: int64(d.Get("parent_group_id").(int)),
+		}
+		if err := p.client.MoveGroup(ctx, req); err != nil {
+			logger.WithError(err).Error("failed to move group")
+			return diag.Errorf("failed to move group: %s", err)
+		}
+	}
+
+	if d.HasChange("name") {
+		req := UpdateGroupNameRequest{
+			GroupID:   GroupID,
+			GroupName: d.Get("name").(string),
+		}
+		if _, err := p.client.UpdateGroupName(ctx, req); err != nil {
+			logger.WithError(err).Error("failed to rename group")
+			return diag.Errorf("failed to rename group: %s", err)
+		}
+	}
+
+	return p.resGroupRead(ctx, d, nil)
+}
+
+func (p *provider) resGroupDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	GroupID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid group ID %q: %s", d.Id(), err)
+	}
+
+	if err := p.client.RemoveGroup(ctx, RemoveGroupRequest{GroupID: GroupID}); err != nil {
+		logger.WithError(err).Error("could not remove group")
+		return diag.Errorf("could not remove group: %s", err)
+	}
+
+	return nil
+}
+
+// isNotFound returns true if the IAM API responded with 404 Not Found
+func isNotFound(err error) bool {
+	var e *iam.Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
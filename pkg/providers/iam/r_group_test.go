@@ -0,0 +1,167 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create, move, rename and import", func(t *testing.T) {
+		t.Parallel()
+
+		Group := iam.Group{
+			GroupID:       98765,
+			GroupName:     "Test group",
+			ParentGroupID: 12345,
+			SubGroups:     []iam.Group{{GroupID: 98766, ParentGroupID: 98765}},
+		}
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		client.On("CreateGroup", mock.Anything, CreateGroupRequest{ParentGroupID: 12345, GroupName: "Test group"}).
+			Return(&Group, nil).Once()
+
+		client.On("GetGroup", mock.Anything, GetGroupRequest{GroupID: 98765}).Return(
+			func(context.Context, GetGroupRequest) *iam.Group {
+				g := Group
+				return &g
+			}, nil)
+
+		client.On("MoveGroup", mock.Anything, MoveGroupRequest{SourceGroupID: 98765, DestinationGroupID: 54321}).
+			Run(func(mock.Arguments) {
+				Group.ParentGroupID = 54321
+			}).Return(nil).Once()
+
+		client.On("UpdateGroupName", mock.Anything, UpdateGroupNameRequest{GroupID: 98765, GroupName: "Renamed group"}).
+			Run(func(mock.Arguments) {
+				Group.GroupName = "Renamed group"
+			}).Return(&Group, nil).Once()
+
+		client.On("RemoveGroup", mock.Anything, RemoveGroupRequest{GroupID: 98765}).Return(nil).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResGroup/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "id", "98765"),
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "group_id", "98765"),
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "name", "Test group"),
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "parent_group_id", "12345"),
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "sub_groups.#", "1"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_group.test", "sub_groups.*", "98766"),
+					),
+				},
+				{
+					Config: test.Fixture("testdata/TestResGroup/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "id", "98765"),
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "name", "Renamed group"),
+						resource.TestCheckResourceAttr("akamai_iam_group.test", "parent_group_id", "54321"),
+					),
+				},
+				{
+					ResourceName:      "akamai_iam_group.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("group removed outside of terraform", func(t *testing.T) {
+		t.Parallel()
+
+		Group := iam.Group{GroupID: 98765, GroupName: "Test group", ParentGroupID: 12345}
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		client.On("CreateGroup", mock.Anything, CreateGroupRequest{ParentGroupID: 12345, GroupName: "Test group"}).
+			Return(&Group, nil).Twice()
+
+		var Removed bool
+		client.On("GetGroup", mock.Anything, GetGroupRequest{GroupID: 98765}).Return(
+			func(context.Context, GetGroupRequest) *iam.Group {
+				if Removed {
+					return nil
+				}
+				return &Group
+			},
+			func(context.Context, GetGroupRequest) error {
+				if Removed {
+					Removed = false
+					return &iam.Error{StatusCode: http.StatusNotFound}
+				}
+				return nil
+			})
+
+		client.On("RemoveGroup", mock.Anything, RemoveGroupRequest{GroupID: 98765}).Return(nil).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResGroup/create.tf"),
+				},
+				{
+					// the group is created again, as it no longer exists
+					PreConfig: func() {
+						Removed = true
+					},
+					Config: test.Fixture("testdata/TestResGroup/create.tf"),
+					Check:  resource.TestCheckResourceAttr("akamai_iam_group.test", "id", "98765"),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("create group error", func(t *testing.T) {
+		t.Parallel()
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+		client.On("CreateGroup", mock.Anything, CreateGroupRequest{ParentGroupID: 12345, GroupName: "Test group"}).
+			Return(nil, errors.New("group name already exists")).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.Fixture("testdata/TestResGroup/create.tf"),
+					ExpectError: regexp.MustCompile("failed to create group: group name already exists"),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
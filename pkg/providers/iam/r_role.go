@@ -0,0 +1,160 @@
+package iam
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func (p *provider) resRole() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a custom role in your account",
+		CreateContext: p.tfCRUD("res:Role:Create", p.resRoleCreate),
+		ReadContext:   p.tfCRUD("res:Role:Read", p.resRoleRead),
+		UpdateContext: p.tfCRUD("res:Role:Update", p.resRoleUpdate),
+		DeleteContext: p.tfCRUD("res:Role:Delete", p.resRoleDelete),
+		Importer:      p.tfImporter("res:Role:Import", schema.ImportStatePassthroughContext),
+		Schema: map[string]*schema.Schema{
+			// Inputs
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The role's name",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The role's description",
+			},
+			"granted_roles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the roles whose permissions the role grants. The value can be any role_id available from the akamai_iam_roles data source",
+			},
+
+			// Outputs
+			"role_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "A unique identifier for the role",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the role is a standard role or a custom role",
+			},
+		},
+	}
+}
+
+func (p *provider) resRoleCreate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	Role, err := p.client.CreateRole(ctx, roleRequestFromState(d))
+	if err != nil {
+		logger.WithError(err).Error("failed to create role")
+		return diag.Errorf("failed to create role: %s", err)
+	}
+
+	d.SetId(strconv.FormatInt(Role.RoleID, 10))
+	return p.resRoleRead(ctx, d, nil)
+}
+
+func (p *provider) resRoleRead(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	RoleID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid role ID %q: %s", d.Id(), err)
+	}
+
+	Role, err := p.client.GetRole(ctx, GetRoleRequest{RoleID: RoleID})
+	if err != nil {
+		if isNotFound(err) {
+			logger.Warnf("role %d not found, removing from state", RoleID)
+			d.SetId("")
+			return nil
+		}
+		logger.WithError(err).Error("failed to fetch role")
+		return diag.Errorf("failed to fetch role: %s", err)
+	}
+
+	var GrantedRoles []interface{}
+	for _, GrantedRole := range Role.GrantedRoles {
+		GrantedRoles = append(GrantedRoles, int(GrantedRole.RoleID))
+	}
+
+	err = tools.SetAttrs(d, map[string]interface{}{
+		"name":          Role.RoleName,
+		"description":   Role.RoleDescription,
+		"granted_roles": GrantedRoles,
+		"role_id":       int(Role.RoleID),
+		"type":          string(Role.RoleType),
+	})
+	if err != nil {
+		logger.WithError(err).Error("could not save attributes to state")
+		return diag.Errorf("could not save attributes to state: %s", err)
+	}
+
+	return nil
+}
+
+func (p *provider) resRoleUpdate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	RoleID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid role ID %q: %s", d.Id(), err)
+	}
+
+	req := UpdateRoleRequest{
+		RoleID:      RoleID,
+		RoleRequest: roleRequestFromState(d),
+	}
+	if _, err := p.client.UpdateRole(ctx, req); err != nil {
+		logger.WithError(err).Error("failed to update role")
+		return diag.Errorf("failed to update role: %s", err)
+	}
+
+	return p.resRoleRead(ctx, d, nil)
+}
+
+func (p *provider) resRoleDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	logger := p.log(ctx)
+
+	RoleID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid role ID %q: %s", d.Id(), err)
+	}
+
+	if err := p.client.DeleteRole(ctx, DeleteRoleRequest{RoleID: RoleID}); err != nil {
+		logger.WithError(err).Error("could not delete role")
+		return diag.Errorf("could not delete role: %s", err)
+	}
+
+	return nil
+}
+
+func roleRequestFromState(d *schema.ResourceData) RoleRequest {
+	return RoleRequest{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		GrantedRoles: int64sFromSet(d.Get("granted_roles").(*schema.Set)),
+	}
+}
+
+// int64sFromSet converts a set of integers to a sorted slice of int64
+func int64sFromSet(set *schema.Set) []int64 {
+	var out []int64
+	for _, v := range set.List() {
+		out = append(out, int64(v.(int)))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
@@ -0,0 +1,125 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResRole(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create, update and import", func(t *testing.T) {
+		t.Parallel()
+
+		Role := iam.Role{
+			RoleID:          555,
+			RoleName:        "Test role",
+			RoleDescription: "Role description",
+			RoleType:        iam.RoleTypeCustom,
+			GrantedRoles:    []iam.RoleGrantedRole{{RoleID: 1}, {RoleID: 2}},
+		}
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		client.On("CreateRole", mock.Anything, RoleRequest{
+			Name:         "Test role",
+			Description:  "Role description",
+			GrantedRoles: []int64{1, 2},
+		}).Return(&Role, nil).Once()
+
+		client.On("GetRole", mock.Anything, GetRoleRequest{RoleID: 555}).Return(
+			func(context.Context, GetRoleRequest) *iam.Role {
+				r := Role
+				return &r
+			}, nil)
+
+		client.On("UpdateRole", mock.Anything, UpdateRoleRequest{
+			RoleID: 555,
+			RoleRequest: RoleRequest{
+				Name:         "Test role",
+				Description:  "Updated description",
+				GrantedRoles: []int64{1, 3},
+			},
+		}).Run(func(mock.Arguments) {
+			Role.RoleDescription = "Updated description"
+			Role.GrantedRoles = []iam.RoleGrantedRole{{RoleID: 1}, {RoleID: 3}}
+		}).Return(&Role, nil).Once()
+
+		client.On("DeleteRole", mock.Anything, DeleteRoleRequest{RoleID: 555}).Return(nil).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResRole/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "id", "555"),
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "role_id", "555"),
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "name", "Test role"),
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "description", "Role description"),
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "type", "custom"),
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "granted_roles.#", "2"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_role.test", "granted_roles.*", "1"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_role.test", "granted_roles.*", "2"),
+					),
+				},
+				{
+					Config: test.Fixture("testdata/TestResRole/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "description", "Updated description"),
+						resource.TestCheckResourceAttr("akamai_iam_role.test", "granted_roles.#", "2"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_role.test", "granted_roles.*", "1"),
+						resource.TestCheckTypeSetElemAttr("akamai_iam_role.test", "granted_roles.*", "3"),
+					),
+				},
+				{
+					ResourceName:      "akamai_iam_role.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("create role error", func(t *testing.T) {
+		t.Parallel()
+
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+		client.On("CreateRole", mock.Anything, RoleRequest{
+			Name:         "Test role",
+			Description:  "Role description",
+			GrantedRoles: []int64{1, 2},
+		}).Return(nil, errors.New("granted role 2 does not exist")).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.Fixture("testdata/TestResRole/create.tf"),
+					ExpectError: regexp.MustCompile("failed to create role: granted role 2 does not exist"),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
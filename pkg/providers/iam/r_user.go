@@ -14,6 +14,14 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// offboardingModeDelete removes the user when the resource is destroyed
+	offboardingModeDelete = "DELETE"
+	// offboardingModeLock locks the user instead of removing it when the resource is destroyed
+	offboardingModeLock = "LOCK"
 )
 
 func (p *provider) resUser() *schema.Resource {
@@ -104,6 +112,11 @@ func (p *provider) resUser() *schema.Resource {
 		return strings.ToLower(v.(string))
 	}
 
+	authGrants := NestedAuthGrantsSchema(50) // Can handle grants with nesting up to 50 levels deep
+	authGrants.Computed = true
+	authGrants.ExactlyOneOf = []string{"auth_grants", "auth_grants_json"}
+	authGrants.Description = "A user's per-group role assignments"
+
 	return &schema.Resource{
 		Description:   "Manage a user in your account",
 		CreateContext: p.tfCRUD("res:User:Create", p.resUserCreate),
@@ -111,6 +124,13 @@ func (p *provider) resUser() *schema.Resource {
 		UpdateContext: p.tfCRUD("res:User:Update", p.resUserUpdate),
 		DeleteContext: p.tfCRUD("res:User:Delete", p.resUserDelete),
 		Importer:      p.tfImporter("res:User:Import", schema.ImportStatePassthroughContext),
+		CustomizeDiff: p.tfCustomizeDiff("res:User:CustomizeDiff", p.resUserValidateAuthGrants),
+		StateUpgraders: []schema.StateUpgrader{{
+			Version: 0,
+			Type:    resUserV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: upgradeUserV0,
+		}},
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			// Inputs - Required
 			"first_name": {
@@ -148,9 +168,12 @@ func (p *provider) resUser() *schema.Resource {
 				Required:    true,
 				Description: "Indicates whether two-factor authentication is allowed",
 			},
+			"auth_grants": authGrants,
 			"auth_grants_json": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"auth_grants", "auth_grants_json"},
 				Description:      "A user's per-group role assignments, in JSON form",
 				ValidateDiagFunc: validateAuthGrantJS,
 				DiffSuppressFunc: suppressAuthGrantsJS,
@@ -223,6 +246,33 @@ func (p *provider) resUser() *schema.Resource {
 				Computed:    true,
 				Description: "The number of seconds it takes for the user's Control Center session to time out if there hasn't been any activity",
 			},
+			"lock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the user is locked. When it is not configured, the lock status is left as it is",
+			},
+			"reset_tfa": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Changing the value resets the user's two-factor authentication. The value, for example a date, is recorded in state so the reset is done once",
+			},
+			"send_password_reset": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Changing the value sends the user a password reset email. The value, for example a date, is recorded in state so the email is sent once",
+			},
+			"offboarding_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{offboardingModeDelete, offboardingModeLock}, false),
+				Description:  "What happens to the user when the resource is destroyed, either DELETE (default) or LOCK",
+			},
+			"offboarding_notify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the user is notified by email when locked on destroy. Only used with offboarding_mode LOCK",
+			},
 
 			// Purely computed
 			"user_name": {
@@ -262,10 +312,10 @@ func (p *provider) resUser() *schema.Resource {
 func (p *provider) resUserCreate(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	logger := p.log(ctx)
 
-	AuthGrantsJSON := []byte(d.Get("auth_grants_json").(string))
-
 	var AuthGrants []iam.AuthGrant
-	if len(AuthGrantsJSON) > 0 {
+	if v, ok := d.GetOk("auth_grants"); ok {
+		AuthGrants = authGrantsFromState(v.(*schema.Set))
+	} else if AuthGrantsJSON := []byte(d.Get("auth_grants_json").(string)); len(AuthGrantsJSON) > 0 {
 		if err := json.Unmarshal(AuthGrantsJSON, &AuthGrants); err != nil {
 			logger.WithError(err).Errorf("auth_grants is not valid")
 			return diag.Errorf("auth_grants is not valid: %s", err)
@@ -314,6 +364,14 @@ func (p *provider) resUserCreate(ctx context.Context, d *schema.ResourceData, _
 	}
 
 	d.SetId(User.IdentityID)
+
+	if d.Get("lock").(bool) {
+		if err := p.client.LockUser(ctx, LockUserRequest{IdentityID: User.IdentityID}); err != nil {
+			logger.WithError(err).Errorf("failed to lock user")
+			return diag.Errorf("failed to lock user: %s", err)
+		}
+	}
+
 	return p.resUserRead(ctx, d, nil)
 }
 
@@ -364,12 +422,14 @@ func (p *provider) resUserRead(ctx context.Context, d *schema.ResourceData, _ in
 		"contact_type":           User.ContactType,
 		"preferred_language":     User.PreferredLanguage,
 		"is_locked":              User.IsLocked,
+		"lock":                   User.IsLocked,
 		"last_login":             User.LastLoginDate,
 		"password_expired_after": User.PasswordExpiryDate,
 		"tfa_configured":         User.TFAConfigured,
 		"email_update_pending":   User.EmailUpdatePending,
 		"session_timeout":        *User.SessionTimeOut,
 		"auth_grants_json":       string(AuthGrantsJSON),
+		"auth_grants":            authGrantsToState(User.AuthGrants),
 	})
 	if err != nil {
 		logger.WithError(err).Error("could not save attributes to state")
@@ -449,12 +509,13 @@ func (p *provider) resUserUpdate(ctx context.Context, d *schema.ResourceData, _
 		needRead = true
 	}
 
-	// AuthGrants
-	if d.HasChange("auth_grants_json") {
+	// AuthGrants - only the form present in the configuration changes, the other one is computed from it on read
+	if d.HasChanges("auth_grants", "auth_grants_json") {
 		var AuthGrants []iam.AuthGrant
 
-		AuthGrantsJSON := []byte(d.Get("auth_grants_json").(string))
-		if len(AuthGrantsJSON) > 0 {
+		if d.HasChange("auth_grants") {
+			AuthGrants = authGrantsFromState(d.Get("auth_grants").(*schema.Set))
+		} else if AuthGrantsJSON := []byte(d.Get("auth_grants_json").(string)); len(AuthGrantsJSON) > 0 {
 			if err := json.Unmarshal(AuthGrantsJSON, &AuthGrants); err != nil {
 				d.Partial(true)
 				logger.WithError(err).Errorf("auth_grants is not valid")
@@ -475,6 +536,46 @@ func (p *provider) resUserUpdate(ctx context.Context, d *schema.ResourceData, _
 		needRead = true
 	}
 
+	// Lock status - only changes when lock is configured, as it is otherwise computed
+	if d.HasChange("lock") {
+		if d.Get("lock").(bool) {
+			err := p.client.LockUser(ctx, LockUserRequest{IdentityID: d.Id()})
+			if err != nil {
+				d.Partial(true)
+				logger.WithError(err).Errorf("failed to lock user")
+				return diag.Errorf("failed to lock user: %s", err)
+			}
+		} else {
+			err := p.client.UnlockUser(ctx, UnlockUserRequest{IdentityID: d.Id()})
+			if err != nil {
+				d.Partial(true)
+				logger.WithError(err).Errorf("failed to unlock user")
+				return diag.Errorf("failed to unlock user: %s", err)
+			}
+		}
+
+		needRead = true
+	}
+
+	// Actions - each value is recorded in state, so the action is only taken when the value changes
+	if d.HasChange("reset_tfa") && d.Get("reset_tfa").(string) != "" {
+		if err := p.client.ResetUserTFA(ctx, ResetUserTFARequest{IdentityID: d.Id()}); err != nil {
+			d.Partial(true)
+			logger.WithError(err).Errorf("failed to reset user TFA")
+			return diag.Errorf("failed to reset user TFA: %s", err)
+		}
+
+		needRead = true
+	}
+
+	if d.HasChange("send_password_reset") && d.Get("send_password_reset").(string) != "" {
+		if err := p.client.ResetUserPassword(ctx, ResetUserPasswordRequest{IdentityID: d.Id()}); err != nil {
+			d.Partial(true)
+			logger.WithError(err).Errorf("failed to send password reset")
+			return diag.Errorf("failed to send password reset: %s", err)
+		}
+	}
+
 	if needRead {
 		d.Partial(false)
 		return p.resUserRead(ctx, d, nil)
@@ -487,6 +588,20 @@ func (p *provider) resUserUpdate(ctx context.Context, d *schema.ResourceData, _
 func (p *provider) resUserDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	logger := p.log(ctx)
 
+	if d.Get("offboarding_mode").(string) == offboardingModeLock {
+		req := LockUserRequest{
+			IdentityID: d.Id(),
+			Notify:     d.Get("offboarding_notify").(bool),
+		}
+		if err := p.client.LockUser(ctx, req); err != nil {
+			logger.WithError(err).Error("could not lock user")
+			return diag.Errorf("could not lock user: %s", err)
+		}
+
+		logger.Info("user was locked instead of being removed")
+		return nil
+	}
+
 	if err := p.client.RemoveUser(ctx, iam.RemoveUserRequest{IdentityID: d.Id()}); err != nil {
 		logger.WithError(err).Error("could not remove user")
 		return diag.Errorf("could not remove user: %s", err)
@@ -0,0 +1,156 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NestedAuthGrantsSchema builds a nested auth grants schema to the given depth
+func NestedAuthGrantsSchema(depth int) *schema.Schema {
+	schem := map[string]*schema.Schema{
+		"group_id": {
+			Type:        schema.TypeInt,
+			Required:    true,
+			Description: "Identifies the group the role is granted in",
+		},
+		"role_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Identifies the role granted in the group. Sub-groups without a role inherit the role of their parent",
+		},
+		"is_blocked": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Indicates whether the user is blocked from accessing the group",
+		},
+	}
+
+	if depth > 1 {
+		sub := NestedAuthGrantsSchema(depth - 1)
+		sub.Description = "Role assignments in the sub-groups of the group"
+		schem["sub_groups"] = sub
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Resource{Schema: schem},
+	}
+}
+
+// Convert the auth_grants set (or a sub_groups set) to auth grants accepted by the service
+func authGrantsFromState(set *schema.Set) []iam.AuthGrant {
+	var out []iam.AuthGrant
+
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+
+		ag := iam.AuthGrant{
+			GroupID:   m["group_id"].(int),
+			IsBlocked: m["is_blocked"].(bool),
+		}
+
+		if RoleID := m["role_id"].(int); RoleID != 0 {
+			ag.RoleID = &RoleID
+		}
+
+		if sub, ok := m["sub_groups"].(*schema.Set); ok && sub.Len() > 0 {
+			ag.Subgroups = authGrantsFromState(sub)
+		}
+
+		out = append(out, ag)
+	}
+
+	return out
+}
+
+// Convert auth grants to a value that can be stored in state
+func authGrantsToState(grants []iam.AuthGrant) []interface{} {
+	var out []interface{}
+
+	for _, ag := range grants {
+		m := map[string]interface{}{
+			"group_id":   ag.GroupID,
+			"is_blocked": ag.IsBlocked,
+		}
+
+		if ag.RoleID != nil {
+			m["role_id"] = *ag.RoleID
+		}
+
+		if len(ag.Subgroups) > 0 {
+			m["sub_groups"] = authGrantsToState(ag.Subgroups)
+		}
+
+		out = append(out, m)
+	}
+
+	return out
+}
+
+// Verify that all groups and roles referenced by the auth_grants block exist in the account
+func (p *provider) resUserValidateAuthGrants(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	logger := p.log(ctx)
+
+	if !d.HasChange("auth_grants") || !d.NewValueKnown("auth_grants") {
+		return nil
+	}
+
+	grants := authGrantsFromState(d.Get("auth_grants").(*schema.Set))
+	if len(grants) == 0 {
+		return nil
+	}
+
+	logger.Debug("Fetching groups")
+	groups, err := p.client.ListGroups(ctx, iam.ListGroupsRequest{})
+	if err != nil {
+		logger.WithError(err).Error("could not get groups")
+		return fmt.Errorf("could not get groups: %w", err)
+	}
+
+	logger.Debug("Fetching roles")
+	roles, err := p.client.ListRoles(ctx, iam.ListRolesRequest{})
+	if err != nil {
+		logger.WithError(err).Error("could not get roles")
+		return fmt.Errorf("could not get roles: %w", err)
+	}
+
+	GroupIDs := map[int64]bool{}
+	var collectGroups func([]iam.Group)
+	collectGroups = func(groups []iam.Group) {
+		for _, g := range groups {
+			GroupIDs[g.GroupID] = true
+			collectGroups(g.SubGroups)
+		}
+	}
+	collectGroups(groups)
+
+	RoleIDs := map[int64]bool{}
+	for _, r := range roles {
+		RoleIDs[r.RoleID] = true
+	}
+
+	var checkGrants func([]iam.AuthGrant) error
+	checkGrants = func(grants []iam.AuthGrant) error {
+		for _, ag := range grants {
+			if !GroupIDs[int64(ag.GroupID)] {
+				return fmt.Errorf(`auth_grants: group %d does not exist in the account. Tip: Use the "akamai_iam_groups" data source to get possible values for "group_id"`, ag.GroupID)
+			}
+
+			if ag.RoleID != nil && !RoleIDs[int64(*ag.RoleID)] {
+				return fmt.Errorf(`auth_grants: role %d does not exist in the account. Tip: Use the "akamai_iam_roles" data source to get possible values for "role_id"`, *ag.RoleID)
+			}
+
+			if err := checkGrants(ag.Subgroups); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return checkGrants(grants)
+}
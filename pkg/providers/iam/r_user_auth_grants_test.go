@@ -0,0 +1,76 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthGrantsState(t *testing.T) {
+	t.Parallel()
+
+	RoleID := 12
+	grants := []iam.AuthGrant{
+		{
+			GroupID: 1,
+			RoleID:  &RoleID,
+			Subgroups: []iam.AuthGrant{
+				{GroupID: 2, IsBlocked: true},
+			},
+		},
+		{GroupID: 3, RoleID: &RoleID},
+	}
+
+	res := schema.Resource{Schema: map[string]*schema.Schema{"auth_grants": NestedAuthGrantsSchema(3)}}
+	d := res.TestResourceData()
+	require.NoError(t, d.Set("auth_grants", authGrantsToState(grants)))
+
+	assert.ElementsMatch(t, grants, authGrantsFromState(d.Get("auth_grants").(*schema.Set)))
+}
+
+func TestUpgradeUserV0(t *testing.T) {
+	t.Parallel()
+
+	t.Run("auth grants copied from JSON", func(t *testing.T) {
+		t.Parallel()
+
+		rawState := map[string]interface{}{
+			"first_name":       "first name",
+			"auth_grants_json": `[{"groupId":1,"groupName":"A","isBlocked":false,"roleDescription":"","roleId":12,"roleName":"R","subGroups":[{"groupId":2,"groupName":"B","isBlocked":true,"roleDescription":"","roleName":""}]}]`,
+		}
+
+		res, err := upgradeUserV0(context.Background(), rawState, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "first name", res["first_name"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"group_id":   1,
+				"role_id":    12,
+				"is_blocked": false,
+				"sub_groups": []interface{}{
+					map[string]interface{}{"group_id": 2, "is_blocked": true},
+				},
+			},
+		}, res["auth_grants"])
+	})
+
+	t.Run("no auth grants", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := upgradeUserV0(context.Background(), map[string]interface{}{"auth_grants_json": ""}, nil)
+		require.NoError(t, err)
+		assert.NotContains(t, res, "auth_grants")
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := upgradeUserV0(context.Background(), map[string]interface{}{"auth_grants_json": "{"}, nil)
+		assert.Error(t, err)
+	})
+}
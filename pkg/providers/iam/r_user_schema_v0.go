@@ -0,0 +1,61 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SchemaVersion 0 of the user resource -- this is referenced in migrations to SchemaVersion 1
+func resUserV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// Unchanged in SchemaVersion 1
+			"first_name":             {Type: schema.TypeString, Required: true},
+			"last_name":              {Type: schema.TypeString, Required: true},
+			"email":                  {Type: schema.TypeString, Required: true},
+			"country":                {Type: schema.TypeString, Required: true},
+			"phone":                  {Type: schema.TypeString, Required: true},
+			"enable_tfa":             {Type: schema.TypeBool, Required: true},
+			"contact_type":           {Type: schema.TypeString, Optional: true, Computed: true},
+			"job_title":              {Type: schema.TypeString, Optional: true},
+			"time_zone":              {Type: schema.TypeString, Optional: true, Computed: true},
+			"secondary_email":        {Type: schema.TypeString, Optional: true},
+			"mobile_phone":           {Type: schema.TypeString, Optional: true},
+			"address":                {Type: schema.TypeString, Optional: true, Computed: true},
+			"city":                   {Type: schema.TypeString, Optional: true},
+			"state":                  {Type: schema.TypeString, Optional: true},
+			"zip_code":               {Type: schema.TypeString, Optional: true},
+			"preferred_language":     {Type: schema.TypeString, Optional: true, Computed: true},
+			"session_timeout":        {Type: schema.TypeInt, Optional: true, Computed: true},
+			"user_name":              {Type: schema.TypeString, Computed: true},
+			"is_locked":              {Type: schema.TypeBool, Computed: true},
+			"last_login":             {Type: schema.TypeString, Computed: true},
+			"password_expired_after": {Type: schema.TypeString, Computed: true},
+			"tfa_configured":         {Type: schema.TypeBool, Computed: true},
+			"email_update_pending":   {Type: schema.TypeBool, Computed: true},
+
+			// Optional in SchemaVersion 1 (copied to auth_grants in state migration)
+			"auth_grants_json": {Type: schema.TypeString, Required: true},
+		},
+	}
+}
+
+func upgradeUserV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	// auth_grants_json is parsed and copied to auth_grants
+	js, _ := rawState["auth_grants_json"].(string) // Schema guarantees this is a string
+	if js == "" {
+		return rawState, nil
+	}
+
+	var AuthGrants []iam.AuthGrant
+	if err := json.Unmarshal([]byte(js), &AuthGrants); err != nil {
+		return nil, fmt.Errorf("auth_grants_json is not valid: %w", err)
+	}
+
+	rawState["auth_grants"] = authGrantsToState(AuthGrants)
+	return rawState, nil
+}
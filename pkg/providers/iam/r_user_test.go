@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/test"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -653,3 +655,194 @@ func CopyAuthGrant(AuthGrant iam.AuthGrant) iam.AuthGrant {
 
 	return AuthGrant
 }
+
+func TestResUserActions(t *testing.T) {
+	t.Parallel()
+
+	User := iam.User{
+		IdentityID: "test uiIdentityId",
+		UserBasicInfo: iam.UserBasicInfo{
+			FirstName:  "first name A",
+			LastName:   "last name A",
+			Email:      "email@akamai.net",
+			Phone:      "0000000000",
+			TFAEnabled: true,
+			Country:    "country A",
+		},
+		AuthGrants:    []iam.AuthGrant{{GroupID: 1, GroupName: "A"}},
+		TFAConfigured: true,
+	}
+
+	client := &IAM{}
+	client.Test(test.TattleT{T: t})
+
+	client.On("CreateUser", mock.Anything, mock.AnythingOfType("iam.CreateUserRequest")).Return(&User, nil).Once()
+	client.On("GetUser", mock.Anything, iam.GetUserRequest{IdentityID: "test uiIdentityId", AuthGrants: true}).Return(
+		func(context.Context, iam.GetUserRequest) *iam.User {
+			u := CopyUser(User)
+			return &u
+		}, nil)
+
+	client.On("LockUser", mock.Anything, LockUserRequest{IdentityID: "test uiIdentityId"}).Run(func(mock.Arguments) {
+		User.IsLocked = true
+	}).Return(nil).Once()
+	client.On("UnlockUser", mock.Anything, UnlockUserRequest{IdentityID: "test uiIdentityId"}).Run(func(mock.Arguments) {
+		User.IsLocked = false
+	}).Return(nil).Once()
+
+	// each action is only taken once, even though the configuration is applied again
+	client.On("ResetUserTFA", mock.Anything, ResetUserTFARequest{IdentityID: "test uiIdentityId"}).Run(func(mock.Arguments) {
+		User.TFAConfigured = false
+	}).Return(nil).Once()
+	client.On("ResetUserPassword", mock.Anything, ResetUserPasswordRequest{IdentityID: "test uiIdentityId"}).Return(nil).Once()
+
+	// the user is locked instead of being removed on destroy
+	client.On("LockUser", mock.Anything, LockUserRequest{IdentityID: "test uiIdentityId", Notify: true}).Return(nil).Once()
+
+	p := provider{}
+	p.SetCache(metaCache{})
+	p.SetIAM(client)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: p.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: test.Fixture("testdata/TestResUserActions/create.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akamai_iam_user.test", "lock", "true"),
+					resource.TestCheckResourceAttr("akamai_iam_user.test", "is_locked", "true"),
+				),
+			},
+			{
+				Config: test.Fixture("testdata/TestResUserActions/update.tf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("akamai_iam_user.test", "lock", "false"),
+					resource.TestCheckResourceAttr("akamai_iam_user.test", "is_locked", "false"),
+					resource.TestCheckResourceAttr("akamai_iam_user.test", "tfa_configured", "false"),
+					resource.TestCheckResourceAttr("akamai_iam_user.test", "reset_tfa", "2022-03-01"),
+					resource.TestCheckResourceAttr("akamai_iam_user.test", "send_password_reset", "2022-03-01"),
+				),
+			},
+			{
+				Config: test.Fixture("testdata/TestResUserActions/update.tf"),
+				Check:  resource.TestCheckResourceAttr("akamai_iam_user.test", "is_locked", "false"),
+			},
+		},
+	})
+
+	client.AssertExpectations(t)
+}
+
+func TestResUserAuthGrants(t *testing.T) {
+	t.Parallel()
+
+	grants := func(RoleID int) []iam.AuthGrant {
+		return []iam.AuthGrant{{
+			GroupID:   1,
+			RoleID:    &RoleID,
+			Subgroups: []iam.AuthGrant{{GroupID: 2, IsBlocked: true}},
+		}}
+	}
+
+	expectAccount := func(client *IAM) {
+		groups := []iam.Group{{GroupID: 1, SubGroups: []iam.Group{{GroupID: 2}}}}
+		roles := []iam.Role{{RoleID: 12}, {RoleID: 13}}
+		client.On("ListGroups", mock.Anything, iam.ListGroupsRequest{}).Return(groups, nil)
+		client.On("ListRoles", mock.Anything, iam.ListRolesRequest{}).Return(roles, nil)
+	}
+
+	checkGrants := func(RoleID string) resource.TestCheckFunc {
+		return resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("akamai_iam_user.test", "auth_grants.#", "1"),
+			resource.TestCheckTypeSetElemNestedAttrs("akamai_iam_user.test", "auth_grants.*", map[string]string{
+				"group_id":                "1",
+				"role_id":                 RoleID,
+				"is_blocked":              "false",
+				"sub_groups.#":            "1",
+				"sub_groups.0.group_id":   "2",
+				"sub_groups.0.is_blocked": "true",
+			}),
+			resource.TestCheckResourceAttrSet("akamai_iam_user.test", "auth_grants_json"),
+		)
+	}
+
+	t.Run("create and update auth_grants", func(t *testing.T) {
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+		expectAccount(client)
+
+		User := iam.User{
+			IdentityID: "test uiIdentityId",
+			UserBasicInfo: iam.UserBasicInfo{
+				FirstName:  "first name A",
+				LastName:   "last name A",
+				Email:      "email@akamai.net",
+				Phone:      "0000000000",
+				TFAEnabled: true,
+				Country:    "country A",
+			},
+		}
+
+		client.On("CreateUser", mock.Anything, mock.MatchedBy(func(req iam.CreateUserRequest) bool {
+			return assert.ObjectsAreEqual(grants(12), req.AuthGrants)
+		})).Run(func(mock.Arguments) {
+			User.AuthGrants = grants(12)
+		}).Return(&User, nil).Once()
+
+		client.On("UpdateUserAuthGrants", mock.Anything, iam.UpdateUserAuthGrantsRequest{
+			IdentityID: "test uiIdentityId",
+			AuthGrants: grants(13),
+		}).Run(func(mock.Arguments) {
+			User.AuthGrants = grants(13)
+		}).Return(grants(13), nil).Once()
+
+		client.On("GetUser", mock.Anything, iam.GetUserRequest{IdentityID: "test uiIdentityId", AuthGrants: true}).Return(
+			func(context.Context, iam.GetUserRequest) *iam.User {
+				u := CopyUser(User)
+				return &u
+			}, nil)
+
+		client.On("RemoveUser", mock.Anything, iam.RemoveUserRequest{IdentityID: "test uiIdentityId"}).Return(nil).Once()
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config: test.Fixture("testdata/TestResUserAuthGrants/create.tf"),
+					Check:  checkGrants("12"),
+				},
+				{
+					Config: test.Fixture("testdata/TestResUserAuthGrants/update.tf"),
+					Check:  checkGrants("13"),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("auth_grants and auth_grants_json both set", func(t *testing.T) {
+		client := &IAM{}
+		client.Test(test.TattleT{T: t})
+
+		p := provider{}
+		p.SetCache(metaCache{})
+		p.SetIAM(client)
+
+		resource.UnitTest(t, resource.TestCase{
+			ProviderFactories: p.ProviderFactories(),
+			Steps: []resource.TestStep{
+				{
+					Config:      test.Fixture("testdata/TestResUserAuthGrants/both_set.tf"),
+					ExpectError: regexp.MustCompile(`only one of .auth_grants,auth_grants_json. can be specified`),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
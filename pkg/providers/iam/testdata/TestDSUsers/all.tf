@@ -0,0 +1 @@
+data "akamai_iam_users" "test" {}
@@ -0,0 +1,6 @@
+data "akamai_iam_users" "test" {
+  group_id                   = 12345
+  role_id                    = 14
+  last_login_older_than_days = 90
+  locked                     = false
+}
@@ -0,0 +1,18 @@
+resource "akamai_iam_api_client" "test" {
+  client_name      = "Test client"
+  authorized_users = ["jdoe"]
+
+  api_access {
+    apis {
+      api_id       = 5580
+      access_level = "READ-WRITE"
+    }
+  }
+
+  group_access {
+    groups {
+      group_id = 12345
+      role_id  = 555
+    }
+  }
+}
@@ -0,0 +1,14 @@
+resource "akamai_iam_api_client" "test" {
+  client_name         = "Test client"
+  client_description  = "Deploys the configuration"
+  authorized_users    = ["jdoe"]
+  notification_emails = ["jdoe@example.com"]
+
+  api_access {
+    all_accessible_apis = true
+  }
+
+  group_access {
+    clone_authorized_user_groups = true
+  }
+}
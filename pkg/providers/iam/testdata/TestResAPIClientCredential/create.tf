@@ -0,0 +1,4 @@
+resource "akamai_iam_api_client_credential" "test" {
+  client_id   = "abcd1234"
+  description = "CI pipeline"
+}
@@ -0,0 +1,6 @@
+resource "akamai_iam_api_client_credential" "test" {
+  client_id   = "abcd1234"
+  description = "CI pipeline"
+  expires_on  = "2023-06-30T00:00:00Z"
+  status      = "INACTIVE"
+}
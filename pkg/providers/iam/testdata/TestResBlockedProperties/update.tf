@@ -0,0 +1,5 @@
+resource "akamai_iam_blocked_properties" "test" {
+  identity_id        = "A-B-123456"
+  group_id           = 12345
+  blocked_properties = [333]
+}
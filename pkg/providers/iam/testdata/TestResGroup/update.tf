@@ -0,0 +1,4 @@
+resource "akamai_iam_group" "test" {
+  name            = "Renamed group"
+  parent_group_id = 54321
+}
@@ -0,0 +1,5 @@
+resource "akamai_iam_role" "test" {
+  name          = "Test role"
+  description   = "Role description"
+  granted_roles = [2, 1]
+}
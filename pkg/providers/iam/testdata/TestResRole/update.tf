@@ -0,0 +1,5 @@
+resource "akamai_iam_role" "test" {
+  name          = "Test role"
+  description   = "Updated description"
+  granted_roles = [1, 3]
+}
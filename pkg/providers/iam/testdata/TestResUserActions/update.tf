@@ -0,0 +1,15 @@
+resource "akamai_iam_user" "test" {
+  first_name       = "first name A"
+  last_name        = "last name A"
+  email            = "email@akamai.net"
+  country          = "country A"
+  phone            = "(000) 000-0000"
+  enable_tfa       = true
+  auth_grants_json = "[{\"groupId\":1,\"groupName\":\"A\"}]"
+
+  lock                = false
+  reset_tfa           = "2022-03-01"
+  send_password_reset = "2022-03-01"
+  offboarding_mode    = "LOCK"
+  offboarding_notify  = true
+}
@@ -0,0 +1,15 @@
+resource "akamai_iam_user" "test" {
+  first_name = "first name A"
+  last_name  = "last name A"
+  email      = "email@akamai.net"
+  country    = "country A"
+  phone      = "(000) 000-0000"
+  enable_tfa = true
+
+  auth_grants {
+    group_id = 1
+    role_id  = 12
+  }
+
+  auth_grants_json = "[{\"groupId\":1,\"groupName\":\"A\",\"roleDescription\":\"\",\"roleId\":12,\"roleName\":\"\"}]"
+}
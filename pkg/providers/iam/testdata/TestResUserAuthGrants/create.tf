@@ -0,0 +1,18 @@
+resource "akamai_iam_user" "test" {
+  first_name = "first name A"
+  last_name  = "last name A"
+  email      = "email@akamai.net"
+  country    = "country A"
+  phone      = "(000) 000-0000"
+  enable_tfa = true
+
+  auth_grants {
+    group_id = 1
+    role_id  = 12
+
+    sub_groups {
+      group_id   = 2
+      is_blocked = true
+    }
+  }
+}
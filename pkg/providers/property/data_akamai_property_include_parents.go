@@ -0,0 +1,96 @@
+package property
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataSourcePropertyIncludeParents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyIncludeParentsRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"include_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"parents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The properties using the include",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                            {Type: schema.TypeString, Computed: true},
+						"name":                          {Type: schema.TypeString, Computed: true},
+						"staging_version":               {Type: schema.TypeInt, Computed: true},
+						"production_version":            {Type: schema.TypeInt, Computed: true},
+						"is_include_used_in_staging":    {Type: schema.TypeBool, Computed: true},
+						"is_include_used_in_production": {Type: schema.TypeBool, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataPropertyIncludeParentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "dataPropertyIncludeParentsRead")
+	client := inst.Client(meta)
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	logger.Debug("Listing include parents")
+
+	// Schema guarantees these types
+	contractID := tools.AddPrefix(d.Get("contract_id").(string), "ctr_")
+	groupID := tools.AddPrefix(d.Get("group_id").(string), "grp_")
+	includeID := tools.AddPrefix(d.Get("include_id").(string), "inc_")
+
+	res, err := client.ListIncludeParents(ctx, ListIncludeParentsRequest{
+		ContractID: contractID,
+		GroupID:    groupID,
+		IncludeID:  includeID,
+	})
+	if err != nil {
+		return diag.Errorf("error listing include parents: %s", err)
+	}
+
+	parents := make([]interface{}, 0, len(res.Properties.Items))
+	for _, item := range res.Properties.Items {
+		parents = append(parents, map[string]interface{}{
+			"id":                            item.PropertyID,
+			"name":                          item.PropertyName,
+			"staging_version":               decodeVersion(item.StagingVersion),
+			"production_version":            decodeVersion(item.ProductionVersion),
+			"is_include_used_in_staging":    item.IsIncludeUsedInStagingVersion,
+			"is_include_used_in_production": item.IsIncludeUsedInProductionVersion,
+		})
+	}
+
+	if err := d.Set("parents", parents); err != nil {
+		return diag.Errorf("error setting include parents: %s", err)
+	}
+
+	d.SetId(includeID)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDSPropertyIncludeParents(t *testing.T) {
+	t.Run("list include parents", func(t *testing.T) {
+		client := &mockpapi{}
+		productionVersion := 3
+		client.On("ListIncludeParents", mock.Anything, ListIncludeParentsRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			IncludeID:  "inc_123",
+		}).Return(&ListIncludeParentsResponse{Properties: IncludeParentItems{Items: []IncludeParent{{
+			PropertyID:                       "prp_1",
+			PropertyName:                     "example.com",
+			ProductionVersion:                &productionVersion,
+			IsIncludeUsedInProductionVersion: true,
+		}}}}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDSPropertyIncludeParents/parents.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "id", "inc_123"),
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "parents.#", "1"),
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "parents.0.id", "prp_1"),
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "parents.0.name", "example.com"),
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "parents.0.staging_version", "0"),
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "parents.0.production_version", "3"),
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "parents.0.is_include_used_in_staging", "false"),
+						resource.TestCheckResourceAttr("data.akamai_property_include_parents.test", "parents.0.is_include_used_in_production", "true"),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,103 @@
+package property
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataSourcePropertyIncludes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyIncludesRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					string(IncludeTypeMicroServices), string(IncludeTypeCommonSettings),
+				}, false)),
+				Description: "Only list the includes of the type, either MICROSERVICES or COMMON_SETTINGS",
+			},
+			"includes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of includes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                 {Type: schema.TypeString, Computed: true},
+						"name":               {Type: schema.TypeString, Computed: true},
+						"type":               {Type: schema.TypeString, Computed: true},
+						"latest_version":     {Type: schema.TypeInt, Computed: true},
+						"staging_version":    {Type: schema.TypeInt, Computed: true},
+						"production_version": {Type: schema.TypeInt, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataPropertyIncludesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "dataPropertyIncludesRead")
+	client := inst.Client(meta)
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+	logger.Debug("Listing includes")
+
+	// Schema guarantees these types
+	contractID := tools.AddPrefix(d.Get("contract_id").(string), "ctr_")
+	groupID := tools.AddPrefix(d.Get("group_id").(string), "grp_")
+	includeType := d.Get("type").(string)
+
+	res, err := client.ListIncludes(ctx, ListIncludesRequest{
+		ContractID: contractID,
+		GroupID:    groupID,
+	})
+	if err != nil {
+		return diag.Errorf("error listing includes: %s", err)
+	}
+
+	includes := make([]interface{}, 0, len(res.Includes.Items))
+	for _, item := range res.Includes.Items {
+		if includeType != "" && string(item.IncludeType) != includeType {
+			continue
+		}
+		includes = append(includes, map[string]interface{}{
+			"id":                 item.IncludeID,
+			"name":               item.IncludeName,
+			"type":               string(item.IncludeType),
+			"latest_version":     item.LatestVersion,
+			"staging_version":    decodeVersion(item.StagingVersion),
+			"production_version": decodeVersion(item.ProductionVersion),
+		})
+	}
+
+	if err := d.Set("includes", includes); err != nil {
+		return diag.Errorf("error setting includes: %s", err)
+	}
+
+	// setting concatenated id to uniquely identify data
+	d.SetId(contractID + groupID + includeType)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDSPropertyIncludes(t *testing.T) {
+	stagingVersion := 1
+	includes := IncludeItems{Items: []Include{
+		{
+			IncludeID:      "inc_123",
+			IncludeName:    "microservice",
+			IncludeType:    IncludeTypeMicroServices,
+			LatestVersion:  2,
+			StagingVersion: &stagingVersion,
+		},
+		{
+			IncludeID:     "inc_456",
+			IncludeName:   "common",
+			IncludeType:   IncludeTypeCommonSettings,
+			LatestVersion: 1,
+		},
+	}}
+
+	t.Run("list includes", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("ListIncludes", mock.Anything, ListIncludesRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+		}).Return(&ListIncludesResponse{Includes: includes}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDSPropertyIncludes/includes.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "id", "ctr_1grp_2"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.#", "2"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.0.id", "inc_123"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.0.type", "MICROSERVICES"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.0.latest_version", "2"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.0.staging_version", "1"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.0.production_version", "0"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.1.id", "inc_456"),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("list includes of a type without prefixes", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("ListIncludes", mock.Anything, ListIncludesRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+		}).Return(&ListIncludesResponse{Includes: includes}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDSPropertyIncludes/includes_type.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "id", "ctr_1grp_2COMMON_SETTINGS"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.#", "1"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.0.id", "inc_456"),
+						resource.TestCheckResourceAttr("data.akamai_property_includes.test", "includes.0.name", "common"),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
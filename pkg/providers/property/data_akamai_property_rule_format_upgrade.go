@@ -0,0 +1,192 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataPropertyRuleFormatUpgrade() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyRuleFormatUpgradeRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        addPrefixToState("ctr_"),
+				RequiredWith:     []string{"group_id"},
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        addPrefixToState("grp_"),
+				RequiredWith:     []string{"contract_id"},
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"property_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        addPrefixToState("prp_"),
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Property version to upgrade. Defaults to the latest version",
+			},
+			"target_rule_format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Rule format to upgrade the rule tree to",
+			},
+			"rule_format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rule format the property version currently uses",
+			},
+			"rules": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON rule tree converted to the target rule format",
+			},
+			"changes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Behavior and criteria changes made by the upgrade, one changed path per entry",
+			},
+			"errors": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Validation errors of the upgraded rule tree",
+			},
+		},
+	}
+}
+
+func dataPropertyRuleFormatUpgradeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("PAPI", "dataPropertyRuleFormatUpgradeRead")
+
+	// since contractID && groupID is optional, we should not return an error.
+	contractID, _ := tools.GetStringValue("contract_id", d)
+	groupID, _ := tools.GetStringValue("group_id", d)
+
+	propertyID, err := tools.GetStringValue("property_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	propertyID = tools.AddPrefix(propertyID, "prp_")
+
+	targetRuleFormat, err := tools.GetStringValue("target_rule_format", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ok, err := isValidRuleFormat(ctx, client, targetRuleFormat)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !ok {
+		return diag.Errorf("given 'target_rule_format' is not supported: %q", targetRuleFormat)
+	}
+
+	if contractID != "" {
+		contractID = tools.AddPrefix(contractID, "ctr_")
+	}
+	if groupID != "" {
+		groupID = tools.AddPrefix(groupID, "grp_")
+	}
+
+	version, err := tools.GetIntValue("version", d)
+	if err != nil {
+		latestVersion, err := client.GetLatestVersion(ctx, papi.GetLatestVersionRequest{
+			PropertyID: propertyID,
+			ContractID: contractID,
+			GroupID:    groupID,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		version = latestVersion.Version.PropertyVersion
+		contractID = latestVersion.ContractID
+		groupID = latestVersion.GroupID
+	}
+
+	currentRules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+		PropertyID:      propertyID,
+		PropertyVersion: version,
+		ContractID:      contractID,
+		GroupID:         groupID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	upgradedRules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+		PropertyID:      propertyID,
+		PropertyVersion: version,
+		ContractID:      contractID,
+		GroupID:         groupID,
+		ValidateRules:   true,
+		ValidateMode:    papi.RuleValidateModeFull,
+		RuleFormat:      targetRuleFormat,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentRulesJSON, err := json.Marshal(papi.RulesUpdate{Rules: currentRules.Rules, Comments: currentRules.Comments})
+	if err != nil {
+		return diag.Errorf("invalid JSON result: %s", err)
+	}
+	upgradedRulesJSON, err := json.MarshalIndent(papi.RulesUpdate{Rules: upgradedRules.Rules, Comments: upgradedRules.Comments}, "", "  ")
+	if err != nil {
+		logger.Debugf("Creating rule tree resulted in invalid JSON: %s", err)
+		return diag.Errorf("invalid JSON result: %s", err)
+	}
+
+	changes, err := diffRulesJSON(string(currentRulesJSON), string(upgradedRulesJSON))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ruleErrors string
+	if len(upgradedRules.Errors) != 0 {
+		errorsJSON, err := json.Marshal(upgradedRules.Errors)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ruleErrors = string(errorsJSON)
+	}
+
+	attrs := map[string]interface{}{
+		"contract_id": contractID,
+		"group_id":    groupID,
+		"property_id": propertyID,
+		"version":     version,
+		"rule_format": currentRules.RuleFormat,
+		"rules":       string(upgradedRulesJSON),
+		"changes":     changes,
+		"errors":      ruleErrors,
+	}
+	if err := tools.SetAttrs(d, attrs); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d:%s", propertyID, version, targetRuleFormat))
+	return nil
+}
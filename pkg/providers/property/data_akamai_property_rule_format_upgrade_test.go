@@ -0,0 +1,117 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestDSPropertyRuleFormatUpgrade(t *testing.T) {
+	expectGetRuleFormats := func(m *mockpapi) {
+		m.On("GetRuleFormats", mock.Anything).Return(&papi.GetRuleFormatsResponse{
+			RuleFormats: papi.RuleFormatItems{
+				Items: []string{
+					"latest",
+					"v2021-09-22",
+				},
+			},
+		}, nil)
+	}
+
+	t.Run("upgrade latest version rule tree", func(t *testing.T) {
+		client := &mockpapi{}
+		expectGetRuleFormats(client)
+		client.On("GetLatestVersion", mock.Anything, papi.GetLatestVersionRequest{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+			PropertyID: "prp_2",
+		}).Return(&papi.GetPropertyVersionsResponse{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+			Version: papi.PropertyVersionGetItem{
+				PropertyVersion: 3,
+			},
+		}, nil)
+		client.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{
+			ContractID:      "ctr_2",
+			GroupID:         "grp_2",
+			PropertyID:      "prp_2",
+			PropertyVersion: 3,
+		}).Return(&papi.GetRuleTreeResponse{
+			RuleFormat: "v2020-03-04",
+			Rules: papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "example.com"}},
+				},
+			},
+		}, nil)
+		client.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{
+			ContractID:      "ctr_2",
+			GroupID:         "grp_2",
+			PropertyID:      "prp_2",
+			PropertyVersion: 3,
+			ValidateRules:   true,
+			ValidateMode:    papi.RuleValidateModeFull,
+			RuleFormat:      "v2021-09-22",
+		}).Return(&papi.GetRuleTreeResponse{
+			RuleFormat: "v2021-09-22",
+			Rules: papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "example.com", "ipVersion": "IPV4"}},
+				},
+			},
+			Response: papi.Response{
+				Errors: []*papi.Error{
+					{
+						Title: "some error",
+					},
+				},
+			},
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDSPropertyRuleFormatUpgrade/ds_property_rule_format_upgrade.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.upgrade", "id", "prp_2:3:v2021-09-22"),
+							resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.upgrade", "version", "3"),
+							resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.upgrade", "rule_format", "v2020-03-04"),
+							resource.TestCheckResourceAttrSet("data.akamai_property_rule_format_upgrade.upgrade", "rules"),
+							resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.upgrade", "changes.#", "1"),
+							resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.upgrade", "changes.0", "/rules/behaviors[origin].options.ipVersion: <none> → IPV4"),
+							resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.upgrade", "errors", `[{"type":"","title":"some error","detail":""}]`),
+						),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error unsupported target rule format", func(t *testing.T) {
+		client := &mockpapi{}
+		expectGetRuleFormats(client)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestDSPropertyRuleFormatUpgrade/unsupported_rule_format.tf"),
+						ExpectError: regexp.MustCompile("given 'target_rule_format' is not supported: \"v2015-08-17\""),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+}
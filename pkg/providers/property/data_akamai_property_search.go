@@ -0,0 +1,284 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spf13/cast"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataSourcePropertySearch() *schema.Resource {
+	searchKeys := []string{"hostname", "edge_hostname", "property_name", "group_id"}
+
+	return &schema.Resource{
+		ReadContext: dataPropertySearchRead,
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     searchKeys,
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Finds properties with active versions serving the hostname",
+			},
+			"edge_hostname": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     searchKeys,
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Finds properties with active versions using the edge hostname",
+			},
+			"property_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     searchKeys,
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Finds properties by name",
+			},
+			"group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     searchKeys,
+				RequiredWith:     []string{"contract_id"},
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Lists the latest and active versions of all properties in the group",
+			},
+			"contract_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"group_id"},
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"origin_hostname": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Only returns property versions whose rule tree uses the origin hostname",
+			},
+			"cp_code": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Only returns property versions whose rule tree uses the CP code",
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id":       {Type: schema.TypeString, Computed: true},
+						"property_name":     {Type: schema.TypeString, Computed: true},
+						"version":           {Type: schema.TypeInt, Computed: true},
+						"contract_id":       {Type: schema.TypeString, Computed: true},
+						"group_id":          {Type: schema.TypeString, Computed: true},
+						"staging_status":    {Type: schema.TypeString, Computed: true},
+						"production_status": {Type: schema.TypeString, Computed: true},
+						"hostname":          {Type: schema.TypeString, Computed: true},
+						"edge_hostname":     {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataPropertySearchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "dataPropertySearchRead")
+	client := inst.Client(meta)
+
+	var (
+		results []papi.SearchItem
+		id      string
+	)
+	if groupID, err := tools.GetStringValue("group_id", d); err == nil {
+		contractID, err := tools.GetStringValue("contract_id", d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		contractID = tools.AddPrefix(contractID, "ctr_")
+		groupID = tools.AddPrefix(groupID, "grp_")
+
+		logger.Debugf("listing properties in %s, %s", contractID, groupID)
+		properties, err := client.GetProperties(ctx, papi.GetPropertiesRequest{ContractID: contractID, GroupID: groupID})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		results = propertyVersionsToSearchItems(properties.Properties.Items)
+		id = fmt.Sprintf("%s:%s", contractID, groupID)
+	} else {
+		var req papi.SearchRequest
+		for key, searchKey := range map[string]string{
+			"hostname":      papi.SearchKeyHostname,
+			"edge_hostname": papi.SearchKeyEdgeHostname,
+			"property_name": papi.SearchKeyPropertyName,
+		} {
+			if value, err := tools.GetStringValue(key, d); err == nil {
+				req = papi.SearchRequest{Key: searchKey, Value: value}
+			}
+		}
+
+		logger.Debugf("searching properties by %s %q", req.Key, req.Value)
+		res, err := client.SearchProperties(ctx, req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		results = res.Versions.Items
+		id = fmt.Sprintf("%s:%s", req.Key, req.Value)
+	}
+
+	originHostname, _ := tools.GetStringValue("origin_hostname", d)
+	cpCode, _ := tools.GetStringValue("cp_code", d)
+	if originHostname != "" || cpCode != "" {
+		cpCode = strings.TrimPrefix(cpCode, "cpc_")
+
+		var filtered []papi.SearchItem
+		for _, item := range results {
+			logger.Debugf("scanning rules of %s version %d", item.PropertyID, item.PropertyVersion)
+			rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+				PropertyID:      item.PropertyID,
+				PropertyVersion: item.PropertyVersion,
+				ContractID:      item.ContractID,
+				GroupID:         item.GroupID,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if ruleTreeUses(&rules.Rules, originHostname, cpCode) {
+				filtered = append(filtered, item)
+			}
+		}
+		results = filtered
+		id = fmt.Sprintf("%s:%s:%s", id, originHostname, cpCode)
+	}
+
+	if err := d.Set("properties", searchItemsToState(results)); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
+	d.SetId(id)
+	return nil
+}
+
+// propertyVersionsToSearchItems returns the latest, staging and production versions of the properties as search results
+func propertyVersionsToSearchItems(properties []*papi.Property) []papi.SearchItem {
+	var items []papi.SearchItem
+	for _, property := range properties {
+		versions := []int{property.LatestVersion}
+		if property.StagingVersion != nil {
+			versions = append(versions, *property.StagingVersion)
+		}
+		if property.ProductionVersion != nil {
+			versions = append(versions, *property.ProductionVersion)
+		}
+
+		seen := map[int]bool{}
+		for _, version := range versions {
+			if seen[version] {
+				continue
+			}
+			seen[version] = true
+
+			item := papi.SearchItem{
+				AccountID:        property.AccountID,
+				AssetID:          property.AssetID,
+				ContractID:       property.ContractID,
+				GroupID:          property.GroupID,
+				PropertyID:       property.PropertyID,
+				PropertyName:     property.PropertyName,
+				PropertyVersion:  version,
+				StagingStatus:    string(papi.VersionStatusInactive),
+				ProductionStatus: string(papi.VersionStatusInactive),
+			}
+			if property.StagingVersion != nil && *property.StagingVersion == version {
+				item.StagingStatus = string(papi.VersionStatusActive)
+			}
+			if property.ProductionVersion != nil && *property.ProductionVersion == version {
+				item.ProductionStatus = string(papi.VersionStatusActive)
+			}
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ruleTreeUses reports whether the rule or any of its children has an origin behavior with the given hostname
+// and a cpCode behavior with the given CP code ID. Empty values are not checked
+func ruleTreeUses(rules *papi.Rules, originHostname, cpCode string) bool {
+	usesOrigin, usesCPCode := originHostname == "", cpCode == ""
+
+	var scan func(*papi.Rules)
+	scan = func(r *papi.Rules) {
+		for _, behavior := range r.Behaviors {
+			switch behavior.Name {
+			case "origin":
+				if hostname, ok := behavior.Options["hostname"].(string); ok && strings.EqualFold(hostname, originHostname) {
+					usesOrigin = true
+				}
+			case "cpCode":
+				value, ok := behavior.Options["value"].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				// CP code IDs decoded from JSON are float64, which fmt would print in exponent notation
+				if id, err := cast.ToInt64E(value["id"]); err == nil && strconv.FormatInt(id, 10) == cpCode {
+					usesCPCode = true
+				}
+			}
+		}
+		for i := range r.Children {
+			scan(&r.Children[i])
+		}
+	}
+	scan(rules)
+
+	return usesOrigin && usesCPCode
+}
+
+// searchItemsToState returns one entry per property version, ordered by property name and version
+func searchItemsToState(items []papi.SearchItem) []interface{} {
+	type key struct {
+		propertyID string
+		version    int
+	}
+	seen := map[key]bool{}
+	var unique []papi.SearchItem
+	for _, item := range items {
+		k := key{item.PropertyID, item.PropertyVersion}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		unique = append(unique, item)
+	}
+	sort.SliceStable(unique, func(i, j int) bool {
+		if unique[i].PropertyName != unique[j].PropertyName {
+			return unique[i].PropertyName < unique[j].PropertyName
+		}
+		return unique[i].PropertyVersion < unique[j].PropertyVersion
+	})
+
+	properties := make([]interface{}, 0, len(unique))
+	for _, item := range unique {
+		properties = append(properties, map[string]interface{}{
+			"property_id":       item.PropertyID,
+			"property_name":     item.PropertyName,
+			"version":           item.PropertyVersion,
+			"contract_id":       item.ContractID,
+			"group_id":          item.GroupID,
+			"staging_status":    item.StagingStatus,
+			"production_status": item.ProductionStatus,
+			"hostname":          item.Hostname,
+			"edge_hostname":     item.EdgeHostname,
+		})
+	}
+	return properties
+}
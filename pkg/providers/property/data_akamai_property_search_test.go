@@ -0,0 +1,132 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestDSPropertySearch(t *testing.T) {
+	t.Run("search by hostname filtered by origin", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("SearchProperties", mock.Anything, papi.SearchRequest{
+			Key:   papi.SearchKeyHostname,
+			Value: "www.example.com",
+		}).Return(&papi.SearchResponse{
+			Versions: papi.SearchItems{Items: []papi.SearchItem{
+				{PropertyID: "prp_1", PropertyName: "www", PropertyVersion: 3, ContractID: "ctr_1", GroupID: "grp_1", StagingStatus: "ACTIVE", ProductionStatus: "INACTIVE", Hostname: "www.example.com", EdgeHostname: "www.example.com.edgesuite.net"},
+				{PropertyID: "prp_1", PropertyName: "www", PropertyVersion: 2, ContractID: "ctr_1", GroupID: "grp_1", StagingStatus: "INACTIVE", ProductionStatus: "ACTIVE", Hostname: "www.example.com", EdgeHostname: "www.example.com.edgesuite.net"},
+			}},
+		}, nil)
+		client.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{
+			PropertyID:      "prp_1",
+			PropertyVersion: 3,
+			ContractID:      "ctr_1",
+			GroupID:         "grp_1",
+		}).Return(&papi.GetRuleTreeResponse{
+			Rules: papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "origin.example.com"}},
+				},
+			},
+		}, nil)
+		client.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{
+			PropertyID:      "prp_1",
+			PropertyVersion: 2,
+			ContractID:      "ctr_1",
+			GroupID:         "grp_1",
+		}).Return(&papi.GetRuleTreeResponse{
+			Rules: papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "old-origin.example.com"}},
+				},
+			},
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDSPropertySearch/search_hostname_origin.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_search.search", "id", "hostname:www.example.com:origin.example.com:"),
+							resource.TestCheckResourceAttr("data.akamai_property_search.search", "properties.#", "1"),
+							resource.TestCheckResourceAttr("data.akamai_property_search.search", "properties.0.property_id", "prp_1"),
+							resource.TestCheckResourceAttr("data.akamai_property_search.search", "properties.0.version", "3"),
+							resource.TestCheckResourceAttr("data.akamai_property_search.search", "properties.0.staging_status", "ACTIVE"),
+							resource.TestCheckResourceAttr("data.akamai_property_search.search", "properties.0.production_status", "INACTIVE"),
+						),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+}
+
+func TestRuleTreeUses(t *testing.T) {
+	rules := papi.Rules{
+		Name: "default",
+		Behaviors: []papi.RuleBehavior{
+			{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "Origin.Example.com"}},
+		},
+		Children: []papi.Rules{
+			{
+				Name: "Static",
+				Behaviors: []papi.RuleBehavior{
+					{Name: "cpCode", Options: papi.RuleOptionsMap{"value": map[string]interface{}{"id": float64(12345)}}},
+				},
+			},
+			{
+				Name: "Dynamic",
+				Behaviors: []papi.RuleBehavior{
+					{Name: "cpCode", Options: papi.RuleOptionsMap{"value": map[string]interface{}{"id": float64(1234567)}}},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		originHostname, cpCode string
+		expected               bool
+	}{
+		"origin hostname matches":       {originHostname: "origin.example.com", expected: true},
+		"cp code in child rule matches": {cpCode: "12345", expected: true},
+		"both match":                    {originHostname: "origin.example.com", cpCode: "12345", expected: true},
+		"7-digit cp code matches":       {cpCode: "1234567", expected: true},
+		"cp code does not match":        {originHostname: "origin.example.com", cpCode: "1", expected: false},
+		"origin does not match":         {originHostname: "other.example.com", expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ruleTreeUses(&rules, test.originHostname, test.cpCode))
+		})
+	}
+}
+
+func TestPropertyVersionsToSearchItems(t *testing.T) {
+	staging, production := 3, 2
+	items := propertyVersionsToSearchItems([]*papi.Property{
+		{PropertyID: "prp_1", PropertyName: "www", LatestVersion: 3, StagingVersion: &staging, ProductionVersion: &production},
+		{PropertyID: "prp_2", PropertyName: "api", LatestVersion: 1},
+	})
+
+	assert.Equal(t, []papi.SearchItem{
+		{PropertyID: "prp_1", PropertyName: "www", PropertyVersion: 3, StagingStatus: "ACTIVE", ProductionStatus: "INACTIVE"},
+		{PropertyID: "prp_1", PropertyName: "www", PropertyVersion: 2, StagingStatus: "INACTIVE", ProductionStatus: "ACTIVE"},
+		{PropertyID: "prp_2", PropertyName: "api", PropertyVersion: 1, StagingStatus: "INACTIVE", ProductionStatus: "INACTIVE"},
+	}, items)
+
+	state := searchItemsToState(items)
+	assert.Len(t, state, 3)
+	assert.Equal(t, "api", state[0].(map[string]interface{})["property_name"])
+	assert.Equal(t, 2, state[1].(map[string]interface{})["version"])
+}
@@ -0,0 +1,301 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+const (
+	// userVariablePrefix is the prefix required for names of user-defined variables
+	userVariablePrefix = "PMUSER_"
+
+	// userVariableMaxLength is the maximum length of a user-defined variable name, including its prefix
+	userVariableMaxLength = 32
+)
+
+var (
+	userVariableNameRegexp      = regexp.MustCompile(`^PMUSER_[A-Z0-9_]+$`)
+	userVariableReferenceRegexp = regexp.MustCompile(`{{user\.(PMUSER_[A-Za-z0-9_]+)}}`)
+)
+
+func dataSourcePropertyVariables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyVariablesRead,
+		Schema: map[string]*schema.Schema{
+			"rules": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"rules", "property_id"},
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "JSON rule tree to read the variables from",
+			},
+			"property_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				StateFunc:        addPrefixToState("prp_"),
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Property to read the variables from",
+			},
+			"contract_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        addPrefixToState("ctr_"),
+				RequiredWith:     []string{"group_id", "property_id"},
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        addPrefixToState("grp_"),
+				RequiredWith:     []string{"contract_id", "property_id"},
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"property_id"},
+				Description:  "Property version to read the variables from. Defaults to the latest version",
+			},
+			"variables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "User-defined variables declared in the rule tree",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":        {Type: schema.TypeString, Computed: true},
+						"value":       {Type: schema.TypeString, Computed: true},
+						"description": {Type: schema.TypeString, Computed: true},
+						"hidden":      {Type: schema.TypeBool, Computed: true},
+						"sensitive":   {Type: schema.TypeBool, Computed: true},
+					},
+				},
+			},
+			"undeclared_variables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "User-defined variables referenced in the rule tree but not declared",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON representation of the declared variables",
+			},
+		},
+	}
+}
+
+func dataPropertyVariablesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "dataPropertyVariablesRead")
+
+	var Rules papi.RulesUpdate
+	var id string
+	if rulesJSON, err := tools.GetStringValue("rules", d); err == nil {
+		if err := json.Unmarshal([]byte(rulesJSON), &Rules); err != nil {
+			return diag.Errorf("rules are not valid JSON: %s", err)
+		}
+		id = tools.GetSHAString(rulesJSON)
+	} else {
+		client := inst.Client(meta)
+
+		// since contractID && groupID is optional, we should not return an error.
+		contractID, _ := tools.GetStringValue("contract_id", d)
+		groupID, _ := tools.GetStringValue("group_id", d)
+		if contractID != "" {
+			contractID = tools.AddPrefix(contractID, "ctr_")
+		}
+		if groupID != "" {
+			groupID = tools.AddPrefix(groupID, "grp_")
+		}
+
+		propertyID, err := tools.GetStringValue("property_id", d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		propertyID = tools.AddPrefix(propertyID, "prp_")
+
+		version, err := tools.GetIntValue("version", d)
+		if err != nil {
+			latestVersion, err := client.GetLatestVersion(ctx, papi.GetLatestVersionRequest{
+				PropertyID: propertyID,
+				ContractID: contractID,
+				GroupID:    groupID,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			version = latestVersion.Version.PropertyVersion
+			contractID = latestVersion.ContractID
+			groupID = latestVersion.GroupID
+		}
+
+		res, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+			PropertyID:      propertyID,
+			PropertyVersion: version,
+			ContractID:      contractID,
+			GroupID:         groupID,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		Rules = papi.RulesUpdate{Rules: res.Rules, Comments: res.Comments}
+
+		attrs := map[string]interface{}{
+			"property_id": propertyID,
+			"contract_id": contractID,
+			"group_id":    groupID,
+			"version":     version,
+		}
+		if err := tools.SetAttrs(d, attrs); err != nil {
+			return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+		}
+		id = fmt.Sprintf("%s:%d", propertyID, version)
+	}
+
+	variables := ruleTreeVariables(&Rules.Rules)
+
+	var diags diag.Diagnostics
+	for _, err := range validateRuleVariables(variables) {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	undeclared, err := undeclaredRuleVariables(Rules, variables)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(undeclared) > 0 {
+		logger.Warnf("rule tree references undeclared variables: %s", strings.Join(undeclared, ", "))
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Undeclared variables",
+			Detail:   fmt.Sprintf("the rule tree references variables which are not declared: %s", strings.Join(undeclared, ", ")),
+		})
+	}
+
+	variablesJSON, err := json.Marshal(variables)
+	if err != nil {
+		return diag.Errorf("invalid JSON result: %s", err)
+	}
+
+	stateVariables := make([]interface{}, 0, len(variables))
+	for _, v := range variables {
+		stateVariables = append(stateVariables, map[string]interface{}{
+			"name":        v.Name,
+			"value":       v.Value,
+			"description": v.Description,
+			"hidden":      v.Hidden,
+			"sensitive":   v.Sensitive,
+		})
+	}
+	attrs := map[string]interface{}{
+		"variables":            stateVariables,
+		"undeclared_variables": undeclared,
+		"json":                 string(variablesJSON),
+	}
+	if err := tools.SetAttrs(d, attrs); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
+	d.SetId(id)
+	return diags
+}
+
+// ruleTreeVariables returns the variables declared in the given rule and its children
+func ruleTreeVariables(rules *papi.Rules) []papi.RuleVariable {
+	variables := append([]papi.RuleVariable{}, rules.Variables...)
+	for i := range rules.Children {
+		variables = append(variables, ruleTreeVariables(&rules.Children[i])...)
+	}
+	return variables
+}
+
+// validateRuleVariables returns an error for each invalid or repeated user-defined variable name
+func validateRuleVariables(variables []papi.RuleVariable) []error {
+	var errs []error
+	declared := make(map[string]bool, len(variables))
+	for _, v := range variables {
+		if err := validateRuleVariableName(v.Name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if declared[v.Name] {
+			errs = append(errs, fmt.Errorf("variable %q is declared more than once", v.Name))
+		}
+		declared[v.Name] = true
+	}
+	return errs
+}
+
+// validateRuleVariableName checks that the name is a valid user-defined variable name which does not conflict with built-in variables
+func validateRuleVariableName(name string) error {
+	switch {
+	case strings.HasPrefix(name, "AK_"):
+		return fmt.Errorf("variable %q conflicts with built-in variables, user-defined variable names must start with %q", name, userVariablePrefix)
+	case !strings.HasPrefix(name, userVariablePrefix):
+		return fmt.Errorf("variable %q must start with %q", name, userVariablePrefix)
+	case len(name) > userVariableMaxLength:
+		return fmt.Errorf("variable %q is longer than %d characters", name, userVariableMaxLength)
+	case !userVariableNameRegexp.MatchString(name):
+		return fmt.Errorf("variable %q can only contain uppercase letters, digits and underscores after the %q prefix", name, userVariablePrefix)
+	}
+	return nil
+}
+
+// undeclaredRuleVariables returns the sorted names of user-defined variables which are referenced in the rule tree,
+// either as {{user.PMUSER_*}} expressions or as targets of setVariable behaviors, but are not declared
+func undeclaredRuleVariables(rules papi.RulesUpdate, variables []papi.RuleVariable) ([]string, error) {
+	declared := make(map[string]bool, len(variables))
+	for _, v := range variables {
+		declared[v.Name] = true
+	}
+
+	referenced := make(map[string]bool)
+	var collectSetVariables func(*papi.Rules)
+	collectSetVariables = func(r *papi.Rules) {
+		for _, behavior := range r.Behaviors {
+			if name, ok := behavior.Options["variableName"].(string); ok && behavior.Name == "setVariable" && name != "" {
+				referenced[name] = true
+			}
+		}
+		for i := range r.Children {
+			collectSetVariables(&r.Children[i])
+		}
+	}
+	collectSetVariables(&rules.Rules)
+
+	rulesJSON, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range userVariableReferenceRegexp.FindAllStringSubmatch(string(rulesJSON), -1) {
+		referenced[match[1]] = true
+	}
+
+	var undeclared []string
+	for name := range referenced {
+		if !declared[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+	sort.Strings(undeclared)
+	return undeclared, nil
+}
@@ -0,0 +1,216 @@
+package property
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestValidateRuleVariableName(t *testing.T) {
+	tests := map[string]struct {
+		name      string
+		withError string
+	}{
+		"valid name": {
+			name: "PMUSER_ORIGIN_1",
+		},
+		"missing prefix": {
+			name:      "ORIGIN",
+			withError: `variable "ORIGIN" must start with "PMUSER_"`,
+		},
+		"built-in variable": {
+			name:      "AK_HOST",
+			withError: `variable "AK_HOST" conflicts with built-in variables, user-defined variable names must start with "PMUSER_"`,
+		},
+		"too long": {
+			name:      "PMUSER_A_VERY_LONG_VARIABLE_NAME_",
+			withError: `variable "PMUSER_A_VERY_LONG_VARIABLE_NAME_" is longer than 32 characters`,
+		},
+		"invalid characters": {
+			name:      "PMUSER_origin-1",
+			withError: `variable "PMUSER_origin-1" can only contain uppercase letters, digits and underscores after the "PMUSER_" prefix`,
+		},
+		"prefix only": {
+			name:      "PMUSER_",
+			withError: `variable "PMUSER_" can only contain uppercase letters, digits and underscores after the "PMUSER_" prefix`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateRuleVariableName(test.name)
+			if test.withError != "" {
+				assert.EqualError(t, err, test.withError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateRuleVariables(t *testing.T) {
+	errs := validateRuleVariables([]papi.RuleVariable{
+		{Name: "PMUSER_A"},
+		{Name: "PMUSER_B"},
+		{Name: "PMUSER_A"},
+		{Name: "B"},
+	})
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], `variable "PMUSER_A" is declared more than once`)
+	assert.EqualError(t, errs[1], `variable "B" must start with "PMUSER_"`)
+}
+
+func TestUndeclaredRuleVariables(t *testing.T) {
+	rulesJSON := `{
+  "rules": {
+    "name": "default",
+    "variables": [
+      {"name": "PMUSER_ORIGIN", "value": "origin.example.com", "hidden": false, "sensitive": false}
+    ],
+    "behaviors": [
+      {"name": "origin", "options": {"hostname": "{{user.PMUSER_ORIGIN}}"}}
+    ],
+    "children": [
+      {
+        "name": "Headers",
+        "behaviors": [
+          {"name": "setVariable", "options": {"variableName": "PMUSER_PATH", "valueSource": "EXPRESSION"}},
+          {"name": "modifyOutgoingResponseHeader", "options": {"newHeaderValue": "{{user.PMUSER_HEADER}}-{{user.PMUSER_ORIGIN}}"}}
+        ],
+        "criteria": [
+          {"name": "path", "options": {"values": ["{{user.PMUSER_HEADER}}"]}}
+        ]
+      }
+    ]
+  }
+}`
+	var rules papi.RulesUpdate
+	require.NoError(t, json.Unmarshal([]byte(rulesJSON), &rules))
+
+	variables := ruleTreeVariables(&rules.Rules)
+	require.Len(t, variables, 1)
+	assert.Equal(t, "PMUSER_ORIGIN", variables[0].Name)
+
+	undeclared, err := undeclaredRuleVariables(rules, variables)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PMUSER_HEADER", "PMUSER_PATH"}, undeclared)
+}
+
+func TestDSPropertyVariables(t *testing.T) {
+	t.Run("variables from rules", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDSPropertyVariables/rules.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.#", "1"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.0.name", "PMUSER_ORIGIN"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.0.value", "origin.example.com"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.0.description", "Origin hostname"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.0.hidden", "false"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.0.sensitive", "false"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "undeclared_variables.#", "0"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "json",
+								`[{"description":"Origin hostname","hidden":false,"name":"PMUSER_ORIGIN","sensitive":false,"value":"origin.example.com"}]`),
+						),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("variables from property", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetLatestVersion", mock.Anything, papi.GetLatestVersionRequest{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+			PropertyID: "prp_2",
+		}).Return(&papi.GetPropertyVersionsResponse{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+			Version: papi.PropertyVersionGetItem{
+				PropertyVersion: 3,
+			},
+		}, nil)
+		client.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{
+			ContractID:      "ctr_2",
+			GroupID:         "grp_2",
+			PropertyID:      "prp_2",
+			PropertyVersion: 3,
+		}).Return(&papi.GetRuleTreeResponse{
+			Rules: papi.Rules{
+				Name: "default",
+				Children: []papi.Rules{{
+					Name:      "Origin",
+					Variables: []papi.RuleVariable{{Name: "PMUSER_ORIGIN", Value: "origin.example.com", Sensitive: true}},
+				}},
+			},
+		}, nil)
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDSPropertyVariables/property.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "id", "prp_2:3"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "version", "3"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.#", "1"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.0.name", "PMUSER_ORIGIN"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.0.sensitive", "true"),
+						),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("invalid variable name", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestDSPropertyVariables/invalid_name.tf"),
+						ExpectError: regexp.MustCompile(`variable "ORIGIN" must start with "PMUSER_"`),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("undeclared variables", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDSPropertyVariables/undeclared.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "variables.#", "1"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "undeclared_variables.#", "2"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "undeclared_variables.0", "PMUSER_HOST"),
+							resource.TestCheckResourceAttr("data.akamai_property_variables.variables", "undeclared_variables.1", "PMUSER_PATH"),
+						),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+}
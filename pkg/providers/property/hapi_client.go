@@ -0,0 +1,143 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/hapi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// HAPI is the HAPI interface used by the provider. It extends the edgegrid client
+	// with the edge hostname update request which the client does not support yet
+	HAPI interface {
+		hapi.HAPI
+
+		// PatchEdgeHostname updates the IP version behavior, TTL or certificate of an edge hostname in place.
+		// The change is applied asynchronously, the response describes the submitted change
+		PatchEdgeHostname(context.Context, PatchEdgeHostnameRequest) (*EdgeHostnameChange, error)
+	}
+
+	// PatchEdgeHostnameRequest contains params required to update an edge hostname
+	PatchEdgeHostnameRequest struct {
+		DNSZone    string
+		RecordName string
+		Operations []EdgeHostnamePatch
+	}
+
+	// EdgeHostnamePatch is a JSON patch operation on an edge hostname
+	EdgeHostnamePatch struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		Value string `json:"value"`
+	}
+
+	// EdgeHostnameChange is a change submitted for an edge hostname
+	EdgeHostnameChange struct {
+		Action        string              `json:"action"`
+		ChangeID      int                 `json:"changeId"`
+		Status        string              `json:"status"`
+		StatusMessage string              `json:"statusMessage"`
+		SubmitDate    string              `json:"submitDate"`
+		EdgeHostnames []hapi.EdgeHostname `json:"edgeHostnames"`
+	}
+
+	hapiClient struct {
+		hapi.HAPI
+		session session.Session
+	}
+)
+
+const (
+	// EdgeHostnamePathIPVersionBehavior is the patch path of the edge hostname IP version behavior
+	EdgeHostnamePathIPVersionBehavior = "/ipVersionBehavior"
+	// EdgeHostnamePathTTL is the patch path of the edge hostname TTL
+	EdgeHostnamePathTTL = "/ttl"
+	// EdgeHostnamePathSlotNumber is the patch path of the certificate slot the edge hostname is associated with
+	EdgeHostnamePathSlotNumber = "/slotNumber"
+)
+
+var (
+	// ErrPatchEdgeHostname is returned when PatchEdgeHostname fails
+	ErrPatchEdgeHostname = errors.New("patching edge hostname")
+)
+
+// NewHapiClient returns a new HAPI instance with the specified session
+func NewHapiClient(sess session.Session) HAPI {
+	return &hapiClient{
+		HAPI:    hapi.Client(sess),
+		session: sess,
+	}
+}
+
+// Validate validates PatchEdgeHostnameRequest
+func (r PatchEdgeHostnameRequest) Validate() error {
+	return validation.Errors{
+		"DNSZone":    validation.Validate(r.DNSZone, validation.Required),
+		"RecordName": validation.Validate(r.RecordName, validation.Required),
+		"Operations": validation.Validate(r.Operations, validation.Required),
+	}.Filter()
+}
+
+// Validate validates EdgeHostnamePatch
+func (p EdgeHostnamePatch) Validate() error {
+	return validation.Errors{
+		"op": validation.Validate(p.Op, validation.Required, validation.In("replace")),
+		"path": validation.Validate(p.Path, validation.Required,
+			validation.In(EdgeHostnamePathIPVersionBehavior, EdgeHostnamePathTTL, EdgeHostnamePathSlotNumber)),
+		"value": validation.Validate(p.Value, validation.Required),
+	}.Filter()
+}
+
+func (c *hapiClient) PatchEdgeHostname(ctx context.Context, params PatchEdgeHostnameRequest) (*EdgeHostnameChange, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrPatchEdgeHostname, hapi.ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(path.Join("/hapi/v1/dns-zones", params.DNSZone, "edge-hostnames", params.RecordName))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrPatchEdgeHostname, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrPatchEdgeHostname, err)
+	}
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	var rval EdgeHostnameChange
+	resp, err := c.session.Exec(req, &rval, params.Operations)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrPatchEdgeHostname, err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("%s: %w", ErrPatchEdgeHostname, hapiResponseError(resp))
+	}
+
+	return &rval, nil
+}
+
+// hapiResponseError parses the HAPI error from the response
+func hapiResponseError(r *http.Response) error {
+	e := hapi.Error{Status: r.StatusCode}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		e.Title = string(body)
+	}
+	e.Status = r.StatusCode
+	return &e
+}
@@ -0,0 +1,129 @@
+package property
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/hapi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockHapiClient(t *testing.T, mockServer *httptest.Server) HAPI {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+
+	s, err := session.New(
+		session.WithClient(httpClient),
+		session.WithSigner(&edgegrid.Config{Host: serverURL.Host}),
+	)
+	require.NoError(t, err)
+	return NewHapiClient(s)
+}
+
+func TestPatchEdgeHostname(t *testing.T) {
+	tests := map[string]struct {
+		params           PatchEdgeHostnameRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *EdgeHostnameChange
+		withError        error
+	}{
+		"202 Accepted": {
+			params: PatchEdgeHostnameRequest{
+				DNSZone:    "edgesuite.net",
+				RecordName: "test",
+				Operations: []EdgeHostnamePatch{
+					{Op: "replace", Path: EdgeHostnamePathIPVersionBehavior, Value: "IPV6_COMPLIANCE"},
+					{Op: "replace", Path: EdgeHostnamePathTTL, Value: "300"},
+				},
+			},
+			responseStatus: http.StatusAccepted,
+			responseBody: `{
+    "action": "EDIT",
+    "changeId": 66025603,
+    "status": "PENDING",
+    "submitDate": "2022-03-01T00:00:00Z",
+    "edgeHostnames": [{"edgeHostnameId": 4558392, "recordName": "test", "dnsZone": "edgesuite.net", "ttl": 300, "ipVersionBehavior": "IPV6_COMPLIANCE"}]
+}`,
+			expectedResponse: &EdgeHostnameChange{
+				Action:     "EDIT",
+				ChangeID:   66025603,
+				Status:     "PENDING",
+				SubmitDate: "2022-03-01T00:00:00Z",
+				EdgeHostnames: []hapi.EdgeHostname{{
+					EdgeHostnameID:    4558392,
+					RecordName:        "test",
+					DNSZone:           "edgesuite.net",
+					TTL:               300,
+					IPVersionBehavior: "IPV6_COMPLIANCE",
+				}},
+			},
+		},
+		"400 Bad Request": {
+			params: PatchEdgeHostnameRequest{
+				DNSZone:    "edgesuite.net",
+				RecordName: "test",
+				Operations: []EdgeHostnamePatch{{Op: "replace", Path: EdgeHostnamePathTTL, Value: "300"}},
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "/hapi/problems/invalid-patch", "title": "Invalid Patch", "detail": "TTL is out of range"}`,
+			withError: &hapi.Error{
+				Type:   "/hapi/problems/invalid-patch",
+				Title:  "Invalid Patch",
+				Detail: "TTL is out of range",
+				Status: http.StatusBadRequest,
+			},
+		},
+		"validation error": {
+			params: PatchEdgeHostnameRequest{
+				DNSZone:    "edgesuite.net",
+				RecordName: "test",
+				Operations: []EdgeHostnamePatch{{Op: "replace", Path: "/map", Value: "a;example.akamai.net"}},
+			},
+			withError: hapi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/hapi/v1/dns-zones/edgesuite.net/edge-hostnames/test", r.URL.String())
+				assert.Equal(t, http.MethodPatch, r.Method)
+				assert.Equal(t, "application/json-patch+json", r.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), `"op":"replace"`)
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockHapiClient(t, mockServer)
+			result, err := client.PatchEdgeHostname(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package property
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/hapi"
+)
+
+type mockhapi struct {
+	mock.Mock
+}
+
+func (h *mockhapi) DeleteEdgeHostname(ctx context.Context, r hapi.DeleteEdgeHostnameRequest) (*hapi.DeleteEdgeHostnameResponse, error) {
+	args := h.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*hapi.DeleteEdgeHostnameResponse), args.Error(1)
+}
+
+func (h *mockhapi) PatchEdgeHostname(ctx context.Context, r PatchEdgeHostnameRequest) (*EdgeHostnameChange, error) {
+	args := h.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*EdgeHostnameChange), args.Error(1)
+}
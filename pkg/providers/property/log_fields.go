@@ -99,6 +99,44 @@ func toLogFielder(given interface{}) log.Fielder {
 
 	case papi.GetPropertyVersionsResponse:
 		return getPropertyVersionResFields(v)
+	case CreateIncludeRequest:
+		return createIncludeReqFields(v)
+
+	case CreateIncludeResponse:
+		return log.Fields{"include_id": v.IncludeID}
+
+	case GetIncludeRequest:
+		return includeReqFields{IncludeID: v.IncludeID, ContractID: v.ContractID, GroupID: v.GroupID}
+
+	case DeleteIncludeRequest:
+		return includeReqFields{IncludeID: v.IncludeID, ContractID: v.ContractID, GroupID: v.GroupID}
+
+	case Include:
+		return includeFields(v)
+
+	case GetIncludeVersionRequest:
+		return includeVersionReqFields{IncludeID: v.IncludeID, ContractID: v.ContractID, GroupID: v.GroupID, Version: v.Version}
+
+	case IncludeVersion:
+		return includeVersionFields(v)
+
+	case CreateIncludeVersionRequest:
+		return includeVersionReqFields{IncludeID: v.IncludeID, ContractID: v.ContractID, GroupID: v.GroupID, Version: v.CreateFromVersion}
+
+	case CreateIncludeVersionResponse:
+		return log.Fields{"include_version": v.Version}
+
+	case GetIncludeRuleTreeRequest:
+		return includeVersionReqFields{IncludeID: v.IncludeID, ContractID: v.ContractID, GroupID: v.GroupID, Version: v.Version}
+
+	case UpdateIncludeRuleTreeRequest:
+		return includeVersionReqFields{IncludeID: v.IncludeID, ContractID: v.ContractID, GroupID: v.GroupID, Version: v.Version}
+
+	case GetIncludeRuleTreeResponse:
+		return includeRuleTreeResFields(v)
+
+	case UpdateIncludeRuleTreeResponse:
+		return includeRuleTreeResFields(v)
 	}
 
 	panic(fmt.Sprintf("no known log.Fielder for %T", given))
@@ -336,3 +374,83 @@ func (res getPropertyVersionHostnamesResFields) Fields() log.Fields {
 		"hostnames":        hostnames,
 	}
 }
+
+type createIncludeReqFields CreateIncludeRequest
+
+func (req createIncludeReqFields) Fields() log.Fields {
+	return log.Fields{
+		"include_name": req.IncludeName,
+		"include_type": req.IncludeType,
+		"contract_id":  req.ContractID,
+		"group_id":     req.GroupID,
+		"product_id":   req.ProductID,
+		"rule_format":  req.RuleFormat,
+	}
+}
+
+type includeReqFields struct {
+	IncludeID  string
+	ContractID string
+	GroupID    string
+}
+
+func (req includeReqFields) Fields() log.Fields {
+	return log.Fields{
+		"include_id":  req.IncludeID,
+		"contract_id": req.ContractID,
+		"group_id":    req.GroupID,
+	}
+}
+
+type includeVersionReqFields struct {
+	IncludeID  string
+	ContractID string
+	GroupID    string
+	Version    int
+}
+
+func (req includeVersionReqFields) Fields() log.Fields {
+	return log.Fields{
+		"include_id":      req.IncludeID,
+		"contract_id":     req.ContractID,
+		"group_id":        req.GroupID,
+		"include_version": req.Version,
+	}
+}
+
+type includeFields Include
+
+func (res includeFields) Fields() log.Fields {
+	return log.Fields{
+		"include_id":         res.IncludeID,
+		"include_name":       res.IncludeName,
+		"contract_id":        res.ContractID,
+		"group_id":           res.GroupID,
+		"latest_version":     res.LatestVersion,
+		"staging_version":    decodeVersion(res.StagingVersion),
+		"production_version": decodeVersion(res.ProductionVersion),
+	}
+}
+
+type includeVersionFields IncludeVersion
+
+func (res includeVersionFields) Fields() log.Fields {
+	return log.Fields{
+		"include_version":   res.IncludeVersion,
+		"staging_status":    res.StagingStatus,
+		"production_status": res.ProductionStatus,
+		"rule_format":       res.RuleFormat,
+	}
+}
+
+type includeRuleTreeResFields GetIncludeRuleTreeResponse
+
+func (res includeRuleTreeResFields) Fields() log.Fields {
+	return log.Fields{
+		"include_id":      res.IncludeID,
+		"contract_id":     res.ContractID,
+		"group_id":        res.GroupID,
+		"include_version": res.IncludeVersion,
+		"rule_format":     res.RuleFormat,
+	}
+}
@@ -0,0 +1,91 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+)
+
+type (
+	// PAPI is the PAPI interface used by the provider. It extends the edgegrid client
+	// with the include, hostname bucket, rule tree and activation requests which the client does not support yet
+	PAPI interface {
+		papi.PAPI
+		Includes
+		HostnameBuckets
+		RuleTrees
+		Activations
+	}
+
+	papiClient struct {
+		papi.PAPI
+		session session.Session
+	}
+)
+
+// NewClient returns a new PAPI instance with the specified session
+func NewClient(sess session.Session) PAPI {
+	return &papiClient{
+		PAPI:    papi.Client(sess),
+		session: sess,
+	}
+}
+
+// exec executes the request with the PAPI-Use-Prefixes header the edgegrid client sends, so IDs are returned prefixed
+func (c *papiClient) exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+	r.Header.Set("PAPI-Use-Prefixes", "true")
+
+	return c.session.Exec(r, out, in...)
+}
+
+// papiURL returns the URL of a PAPI endpoint with the contract and group query parameters, when they are set
+func papiURL(ContractID, GroupID string, elem ...string) (*url.URL, error) {
+	uri, err := url.Parse(path.Join(append([]string{"/papi/v1"}, elem...)...))
+	if err != nil {
+		return nil, err
+	}
+
+	q := uri.Query()
+	if ContractID != "" {
+		q.Add("contractId", ContractID)
+	}
+	if GroupID != "" {
+		q.Add("groupId", GroupID)
+	}
+	uri.RawQuery = q.Encode()
+
+	return uri, nil
+}
+
+// linkID returns the ID at the end of the path of a link returned by PAPI
+func linkID(link string) (string, error) {
+	uri, err := url.Parse(link)
+	if err != nil {
+		return "", err
+	}
+
+	return path.Base(uri.Path), nil
+}
+
+// papiResponseError parses the PAPI error from the response
+func papiResponseError(r *http.Response) error {
+	e := papi.Error{StatusCode: r.StatusCode}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		e.Title = "Failed to unmarshal error body"
+		e.Detail = fmt.Sprintf("%s: %s", err, body)
+	}
+	e.StatusCode = r.StatusCode
+	return &e
+}
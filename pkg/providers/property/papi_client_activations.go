@@ -0,0 +1,123 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// Activations contains the activation operations the edgegrid client does not support
+	Activations interface {
+		// CreateComplianceActivation creates a property activation with a compliance record, which the
+		// edgegrid client cannot send
+		CreateComplianceActivation(context.Context, CreateComplianceActivationRequest) (*papi.CreateActivationResponse, error)
+	}
+
+	// CreateComplianceActivationRequest is the request of CreateComplianceActivation
+	CreateComplianceActivationRequest struct {
+		papi.CreateActivationRequest
+		ComplianceRecord *ComplianceRecord
+	}
+
+	// ComplianceRecord records the change management compliance of an activation
+	ComplianceRecord struct {
+		NoncomplianceReason      string `json:"noncomplianceReason"`
+		OtherNoncomplianceReason string `json:"otherNoncomplianceReason,omitempty"`
+		TicketID                 string `json:"ticketId,omitempty"`
+		PeerReviewedBy           string `json:"peerReviewedBy,omitempty"`
+		CustomerEmail            string `json:"customerEmail,omitempty"`
+		UnitTested               bool   `json:"unitTested"`
+	}
+
+	complianceActivation struct {
+		papi.Activation
+		ComplianceRecord *ComplianceRecord `json:"complianceRecord,omitempty"`
+	}
+)
+
+const (
+	// NoncomplianceReasonNone is used when the activation complies with change management
+	NoncomplianceReasonNone = "NONE"
+	// NoncomplianceReasonOther is used with a custom reason in OtherNoncomplianceReason
+	NoncomplianceReasonOther = "OTHER"
+	// NoncomplianceReasonNoProductionTraffic is used when the property serves no production traffic
+	NoncomplianceReasonNoProductionTraffic = "NO_PRODUCTION_TRAFFIC"
+	// NoncomplianceReasonEmergency is used for emergency changes
+	NoncomplianceReasonEmergency = "EMERGENCY"
+)
+
+var (
+	// ErrCreateComplianceActivation is returned when CreateComplianceActivation fails
+	ErrCreateComplianceActivation = errors.New("creating activation")
+
+	// NoncomplianceReasons lists the accepted noncompliance reasons
+	NoncomplianceReasons = []string{
+		NoncomplianceReasonNone,
+		NoncomplianceReasonOther,
+		NoncomplianceReasonNoProductionTraffic,
+		NoncomplianceReasonEmergency,
+	}
+)
+
+// Validate validates CreateComplianceActivationRequest
+func (r CreateComplianceActivationRequest) Validate() error {
+	if err := r.CreateActivationRequest.Validate(); err != nil {
+		return err
+	}
+	if r.ComplianceRecord == nil {
+		return nil
+	}
+	return validation.Errors{
+		"ComplianceRecord.NoncomplianceReason": validation.Validate(r.ComplianceRecord.NoncomplianceReason,
+			validation.Required,
+			validation.In(NoncomplianceReasonNone, NoncomplianceReasonOther, NoncomplianceReasonNoProductionTraffic, NoncomplianceReasonEmergency)),
+		"ComplianceRecord.OtherNoncomplianceReason": validation.Validate(r.ComplianceRecord.OtherNoncomplianceReason,
+			validation.When(r.ComplianceRecord.NoncomplianceReason == NoncomplianceReasonOther, validation.Required)),
+	}.Filter()
+}
+
+func (c *papiClient) CreateComplianceActivation(ctx context.Context, params CreateComplianceActivationRequest) (*papi.CreateActivationResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateComplianceActivation, papi.ErrStructValidation, err)
+	}
+
+	// the edgegrid client defaults the activation type the same way
+	if params.Activation.ActivationType == "" {
+		params.Activation.ActivationType = papi.ActivationTypeActivate
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "properties", params.PropertyID, "activations")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateComplianceActivation, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateComplianceActivation, err)
+	}
+
+	var rval papi.CreateActivationResponse
+	resp, err := c.exec(req, &rval, complianceActivation{
+		Activation:       params.Activation,
+		ComplianceRecord: params.ComplianceRecord,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateComplianceActivation, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateComplianceActivation, papiResponseError(resp))
+	}
+
+	rval.ActivationID, err = linkID(rval.ActivationLink)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse activation link: %s", ErrCreateComplianceActivation, err)
+	}
+
+	return &rval, nil
+}
@@ -0,0 +1,118 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateComplianceActivation(t *testing.T) {
+	activation := papi.CreateActivationRequest{
+		PropertyID: "prp_1",
+		Activation: papi.Activation{
+			PropertyVersion: 2,
+			Network:         papi.ActivationNetworkProduction,
+			NotifyEmails:    []string{"user@example.com"},
+		},
+	}
+	tests := map[string]struct {
+		params           CreateComplianceActivationRequest
+		responseStatus   int
+		responseBody     string
+		expectedBody     string
+		expectedResponse *papi.CreateActivationResponse
+		withError        error
+	}{
+		"201 Created": {
+			params: CreateComplianceActivationRequest{
+				CreateActivationRequest: activation,
+				ComplianceRecord: &ComplianceRecord{
+					NoncomplianceReason: NoncomplianceReasonNone,
+					TicketID:            "JIRA-1234",
+					PeerReviewedBy:      "reviewer@example.com",
+					UnitTested:          true,
+				},
+			},
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"activationLink": "/papi/v1/properties/prp_1/activations/atv_1?contractId=ctr_1&groupId=grp_2"}`,
+			expectedBody: `{
+    "activationType": "ACTIVATE",
+    "useFastFallback": false,
+    "acknowledgeAllWarnings": false,
+    "propertyVersion": 2,
+    "network": "PRODUCTION",
+    "notifyEmails": ["user@example.com"],
+    "complianceRecord": {
+        "noncomplianceReason": "NONE",
+        "ticketId": "JIRA-1234",
+        "peerReviewedBy": "reviewer@example.com",
+        "unitTested": true
+    }
+}`,
+			expectedResponse: &papi.CreateActivationResponse{
+				ActivationID:   "atv_1",
+				ActivationLink: "/papi/v1/properties/prp_1/activations/atv_1?contractId=ctr_1&groupId=grp_2",
+			},
+		},
+		"400 Bad Request": {
+			params: CreateComplianceActivationRequest{
+				CreateActivationRequest: activation,
+				ComplianceRecord:        &ComplianceRecord{NoncomplianceReason: NoncomplianceReasonEmergency},
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "/papi/v1/errors/bad-request", "title": "Bad request", "detail": "Missing ticket ID"}`,
+			withError: &papi.Error{
+				Type:       "/papi/v1/errors/bad-request",
+				Title:      "Bad request",
+				Detail:     "Missing ticket ID",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		"other reason without description": {
+			params: CreateComplianceActivationRequest{
+				CreateActivationRequest: activation,
+				ComplianceRecord:        &ComplianceRecord{NoncomplianceReason: NoncomplianceReasonOther},
+			},
+			withError: papi.ErrStructValidation,
+		},
+		"invalid reason": {
+			params: CreateComplianceActivationRequest{
+				CreateActivationRequest: activation,
+				ComplianceRecord:        &ComplianceRecord{NoncomplianceReason: "LATE"},
+			},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/properties/prp_1/activations", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				if test.expectedBody != "" {
+					body, err := ioutil.ReadAll(r.Body)
+					require.NoError(t, err)
+					assert.JSONEq(t, test.expectedBody, string(body))
+				}
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.CreateComplianceActivation(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// HostnameBuckets contains the operations on the hostname bucket of a property, the hostnames which are
+	// activated per network independently of the property versions
+	HostnameBuckets interface {
+		// PatchPropertyHostnameBucket adds and removes hostnames of the property on a network, which starts a hostname activation
+		PatchPropertyHostnameBucket(context.Context, PatchPropertyHostnameBucketRequest) (*PatchPropertyHostnameBucketResponse, error)
+
+		// ListPropertyHostnameBucket lists a page of the hostnames of the property on a network
+		ListPropertyHostnameBucket(context.Context, ListPropertyHostnameBucketRequest) (*ListPropertyHostnameBucketResponse, error)
+
+		// GetPropertyHostnameActivation gets a hostname activation with the hostnames it adds and removes
+		GetPropertyHostnameActivation(context.Context, GetPropertyHostnameActivationRequest) (*GetPropertyHostnameActivationResponse, error)
+	}
+
+	// BucketHostnameAdd is a hostname added to the hostname bucket
+	BucketHostnameAdd struct {
+		CnameFrom            string                 `json:"cnameFrom"`
+		CnameType            papi.HostnameCnameType `json:"cnameType"`
+		EdgeHostnameID       string                 `json:"edgeHostnameId"`
+		CertProvisioningType string                 `json:"certProvisioningType"`
+	}
+
+	// PatchPropertyHostnameBucketRequest contains params required to add and remove hostnames of a property on a network
+	PatchPropertyHostnameBucketRequest struct {
+		PropertyID   string
+		ContractID   string
+		GroupID      string
+		Network      papi.ActivationNetwork
+		Add          []BucketHostnameAdd
+		Remove       []string
+		NotifyEmails []string
+		Note         string
+	}
+
+	// PatchPropertyHostnameBucketResponse is the response of PatchPropertyHostnameBucket
+	PatchPropertyHostnameBucketResponse struct {
+		HostnameActivationID   string `json:"-"`
+		HostnameActivationLink string `json:"activationLink"`
+	}
+
+	// ListPropertyHostnameBucketRequest contains params required to list a page of the hostnames of a property on a network
+	ListPropertyHostnameBucketRequest struct {
+		PropertyID string
+		ContractID string
+		GroupID    string
+		Network    papi.ActivationNetwork
+		Offset     int
+		Limit      int
+	}
+
+	// BucketHostname is a hostname of the hostname bucket with its settings on each network
+	BucketHostname struct {
+		CnameFrom                string                 `json:"cnameFrom"`
+		CnameType                papi.HostnameCnameType `json:"cnameType"`
+		StagingCertType          string                 `json:"stagingCertType,omitempty"`
+		StagingCnameTo           string                 `json:"stagingCnameTo,omitempty"`
+		StagingEdgeHostnameID    string                 `json:"stagingEdgeHostnameId,omitempty"`
+		ProductionCertType       string                 `json:"productionCertType,omitempty"`
+		ProductionCnameTo        string                 `json:"productionCnameTo,omitempty"`
+		ProductionEdgeHostnameID string                 `json:"productionEdgeHostnameId,omitempty"`
+	}
+
+	// BucketHostnameItems are the hostname bucket items of a response
+	BucketHostnameItems struct {
+		Items            []BucketHostname `json:"items"`
+		CurrentItemCount int              `json:"currentItemCount"`
+		TotalItems       int              `json:"totalItems"`
+		NextLink         string           `json:"nextLink,omitempty"`
+	}
+
+	// ListPropertyHostnameBucketResponse is the response of ListPropertyHostnameBucket
+	ListPropertyHostnameBucketResponse struct {
+		papi.Response
+		PropertyID string              `json:"propertyId"`
+		Hostnames  BucketHostnameItems `json:"hostnames"`
+	}
+
+	// GetPropertyHostnameActivationRequest contains params required to get a hostname activation
+	GetPropertyHostnameActivationRequest struct {
+		PropertyID           string
+		ContractID           string
+		GroupID              string
+		HostnameActivationID string
+	}
+
+	// HostnameActivationChange is a hostname added or removed by a hostname activation
+	HostnameActivationChange struct {
+		Action               string `json:"action"`
+		CnameFrom            string `json:"cnameFrom"`
+		CnameTo              string `json:"cnameTo,omitempty"`
+		EdgeHostnameID       string `json:"edgeHostnameId,omitempty"`
+		CertProvisioningType string `json:"certProvisioningType,omitempty"`
+	}
+
+	// HostnameActivation is an activation of changes to the hostname bucket of a property
+	HostnameActivation struct {
+		HostnameActivationID string                     `json:"hostnameActivationId"`
+		PropertyID           string                     `json:"propertyId"`
+		PropertyName         string                     `json:"propertyName"`
+		ActivationType       papi.ActivationType        `json:"activationType"`
+		Network              papi.ActivationNetwork     `json:"network"`
+		Status               papi.ActivationStatus      `json:"status"`
+		SubmitDate           string                     `json:"submitDate"`
+		UpdateDate           string                     `json:"updateDate"`
+		Note                 string                     `json:"note,omitempty"`
+		NotifyEmails         []string                   `json:"notifyEmails"`
+		Hostnames            []HostnameActivationChange `json:"hostnames,omitempty"`
+	}
+
+	// HostnameActivationItems are the hostname activation items of a response
+	HostnameActivationItems struct {
+		Items []HostnameActivation `json:"items"`
+	}
+
+	// GetPropertyHostnameActivationResponse is the response of GetPropertyHostnameActivation
+	GetPropertyHostnameActivationResponse struct {
+		papi.Response
+		HostnameActivations HostnameActivationItems `json:"hostnameActivations"`
+		HostnameActivation  HostnameActivation      `json:"-"`
+	}
+
+	patchHostnameBucketBody struct {
+		Network      papi.ActivationNetwork `json:"network"`
+		Add          []BucketHostnameAdd    `json:"add"`
+		Remove       []string               `json:"remove"`
+		NotifyEmails []string               `json:"notifyEmails,omitempty"`
+		Note         string                 `json:"note,omitempty"`
+	}
+)
+
+const (
+	// HostnameActivationActionAdd is the action of a hostname added by a hostname activation
+	HostnameActivationActionAdd = "ADD"
+	// HostnameActivationActionRemove is the action of a hostname removed by a hostname activation
+	HostnameActivationActionRemove = "REMOVE"
+
+	// maxHostnameBucketChanges is the maximum number of hostnames added or removed by one patch of the hostname bucket
+	maxHostnameBucketChanges = 1000
+)
+
+var (
+	// ErrPatchPropertyHostnameBucket is returned when PatchPropertyHostnameBucket fails
+	ErrPatchPropertyHostnameBucket = errors.New("patching property hostname bucket")
+	// ErrListPropertyHostnameBucket is returned when ListPropertyHostnameBucket fails
+	ErrListPropertyHostnameBucket = errors.New("listing property hostname bucket")
+	// ErrGetPropertyHostnameActivation is returned when GetPropertyHostnameActivation fails
+	ErrGetPropertyHostnameActivation = errors.New("fetching property hostname activation")
+)
+
+// Validate validates PatchPropertyHostnameBucketRequest
+func (r PatchPropertyHostnameBucketRequest) Validate() error {
+	return validation.Errors{
+		"PropertyID": validation.Validate(r.PropertyID, validation.Required),
+		"ContractID": validation.Validate(r.ContractID, validation.Required),
+		"GroupID":    validation.Validate(r.GroupID, validation.Required),
+		"Network": validation.Validate(r.Network, validation.Required,
+			validation.In(papi.ActivationNetworkStaging, papi.ActivationNetworkProduction)),
+		"Add": validation.Validate(r.Add, validation.Length(0, maxHostnameBucketChanges),
+			validation.When(len(r.Remove) == 0, validation.Required.Error("at least one hostname must be added or removed"))),
+		"Remove": validation.Validate(r.Remove, validation.Length(0, maxHostnameBucketChanges)),
+	}.Filter()
+}
+
+// Validate validates BucketHostnameAdd
+func (h BucketHostnameAdd) Validate() error {
+	return validation.Errors{
+		"CnameFrom":            validation.Validate(h.CnameFrom, validation.Required),
+		"CnameType":            validation.Validate(h.CnameType, validation.Required),
+		"EdgeHostnameID":       validation.Validate(h.EdgeHostnameID, validation.Required),
+		"CertProvisioningType": validation.Validate(h.CertProvisioningType, validation.Required),
+	}.Filter()
+}
+
+// Validate validates ListPropertyHostnameBucketRequest
+func (r ListPropertyHostnameBucketRequest) Validate() error {
+	return validation.Errors{
+		"PropertyID": validation.Validate(r.PropertyID, validation.Required),
+		"ContractID": validation.Validate(r.ContractID, validation.Required),
+		"GroupID":    validation.Validate(r.GroupID, validation.Required),
+		"Network": validation.Validate(r.Network, validation.Required,
+			validation.In(papi.ActivationNetworkStaging, papi.ActivationNetworkProduction)),
+		"Offset": validation.Validate(r.Offset, validation.Min(0)),
+		"Limit":  validation.Validate(r.Limit, validation.Min(0)),
+	}.Filter()
+}
+
+// Validate validates GetPropertyHostnameActivationRequest
+func (r GetPropertyHostnameActivationRequest) Validate() error {
+	return validation.Errors{
+		"PropertyID":           validation.Validate(r.PropertyID, validation.Required),
+		"ContractID":           validation.Validate(r.ContractID, validation.Required),
+		"GroupID":              validation.Validate(r.GroupID, validation.Required),
+		"HostnameActivationID": validation.Validate(r.HostnameActivationID, validation.Required),
+	}.Filter()
+}
+
+func (c *papiClient) PatchPropertyHostnameBucket(ctx context.Context, params PatchPropertyHostnameBucketRequest) (*PatchPropertyHostnameBucketResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrPatchPropertyHostnameBucket, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "properties", params.PropertyID, "hostnames")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrPatchPropertyHostnameBucket, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrPatchPropertyHostnameBucket, err)
+	}
+
+	body := patchHostnameBucketBody{
+		Network:      params.Network,
+		Add:          params.Add,
+		Remove:       params.Remove,
+		NotifyEmails: params.NotifyEmails,
+		Note:         params.Note,
+	}
+	if body.Add == nil {
+		body.Add = []BucketHostnameAdd{}
+	}
+	if body.Remove == nil {
+		body.Remove = []string{}
+	}
+
+	var rval PatchPropertyHostnameBucketResponse
+	resp, err := c.exec(req, &rval, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrPatchPropertyHostnameBucket, err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("%s: %w", ErrPatchPropertyHostnameBucket, papiResponseError(resp))
+	}
+
+	if rval.HostnameActivationID, err = linkID(rval.HostnameActivationLink); err != nil {
+		return nil, fmt.Errorf("%w: invalid activation link: %s", ErrPatchPropertyHostnameBucket, err)
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) ListPropertyHostnameBucket(ctx context.Context, params ListPropertyHostnameBucketRequest) (*ListPropertyHostnameBucketResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrListPropertyHostnameBucket, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "properties", params.PropertyID, "hostnames")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListPropertyHostnameBucket, err)
+	}
+	q := uri.Query()
+	q.Add("network", string(params.Network))
+	if params.Offset > 0 {
+		q.Add("offset", strconv.Itoa(params.Offset))
+	}
+	if params.Limit > 0 {
+		q.Add("limit", strconv.Itoa(params.Limit))
+	}
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListPropertyHostnameBucket, err)
+	}
+
+	var rval ListPropertyHostnameBucketResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListPropertyHostnameBucket, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListPropertyHostnameBucket, papiResponseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) GetPropertyHostnameActivation(ctx context.Context, params GetPropertyHostnameActivationRequest) (*GetPropertyHostnameActivationResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetPropertyHostnameActivation, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "properties", params.PropertyID, "hostname-activations", params.HostnameActivationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetPropertyHostnameActivation, err)
+	}
+	q := uri.Query()
+	q.Add("includeHostnames", "true")
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetPropertyHostnameActivation, err)
+	}
+
+	var rval GetPropertyHostnameActivationResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetPropertyHostnameActivation, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetPropertyHostnameActivation, papiResponseError(resp))
+	}
+
+	if len(rval.HostnameActivations.Items) == 0 {
+		return nil, fmt.Errorf("%s: %w: HostnameActivationID: %s", ErrGetPropertyHostnameActivation, papi.ErrNotFound, params.HostnameActivationID)
+	}
+	rval.HostnameActivation = rval.HostnameActivations.Items[0]
+
+	return &rval, nil
+}
@@ -0,0 +1,246 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchPropertyHostnameBucket(t *testing.T) {
+	tests := map[string]struct {
+		params           PatchPropertyHostnameBucketRequest
+		responseStatus   int
+		responseBody     string
+		expectedBody     string
+		expectedResponse *PatchPropertyHostnameBucketResponse
+		withError        error
+	}{
+		"202 Accepted": {
+			params: PatchPropertyHostnameBucketRequest{
+				PropertyID: "prp_1",
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				Network:    papi.ActivationNetworkStaging,
+				Add: []BucketHostnameAdd{{
+					CnameFrom:            "www.example.com",
+					CnameType:            papi.HostnameCnameTypeEdgeHostname,
+					EdgeHostnameID:       "ehn_1",
+					CertProvisioningType: "CPS_MANAGED",
+				}},
+				NotifyEmails: []string{"user@example.com"},
+			},
+			responseStatus: http.StatusAccepted,
+			responseBody:   `{"activationLink": "/papi/v1/properties/prp_1/hostname-activations/atvhn_1?contractId=ctr_1&groupId=grp_2"}`,
+			expectedBody:   `{"network":"STAGING","add":[{"cnameFrom":"www.example.com","cnameType":"EDGE_HOSTNAME","edgeHostnameId":"ehn_1","certProvisioningType":"CPS_MANAGED"}],"remove":[],"notifyEmails":["user@example.com"]}`,
+			expectedResponse: &PatchPropertyHostnameBucketResponse{
+				HostnameActivationID:   "atvhn_1",
+				HostnameActivationLink: "/papi/v1/properties/prp_1/hostname-activations/atvhn_1?contractId=ctr_1&groupId=grp_2",
+			},
+		},
+		"400 Bad Request": {
+			params: PatchPropertyHostnameBucketRequest{
+				PropertyID: "prp_1",
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				Network:    papi.ActivationNetworkStaging,
+				Remove:     []string{"www.example.com"},
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "/papi/v1/errors/hostname-not-found", "title": "Hostname not found", "detail": "www.example.com is not in the bucket"}`,
+			expectedBody:   `{"network":"STAGING","add":[],"remove":["www.example.com"]}`,
+			withError: &papi.Error{
+				Type:       "/papi/v1/errors/hostname-not-found",
+				Title:      "Hostname not found",
+				Detail:     "www.example.com is not in the bucket",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		"validation error with no changes": {
+			params: PatchPropertyHostnameBucketRequest{
+				PropertyID: "prp_1",
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				Network:    papi.ActivationNetworkStaging,
+			},
+			withError: papi.ErrStructValidation,
+		},
+		"validation error with too many changes": {
+			params: PatchPropertyHostnameBucketRequest{
+				PropertyID: "prp_1",
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				Network:    papi.ActivationNetworkStaging,
+				Remove:     make([]string, maxHostnameBucketChanges+1),
+			},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/properties/prp_1/hostnames?contractId=ctr_1&groupId=grp_2", r.URL.String())
+				assert.Equal(t, http.MethodPatch, r.Method)
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, test.expectedBody, string(body))
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.PatchPropertyHostnameBucket(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestListPropertyHostnameBucket(t *testing.T) {
+	tests := map[string]struct {
+		params           ListPropertyHostnameBucketRequest
+		expectedURL      string
+		responseStatus   int
+		responseBody     string
+		expectedResponse *ListPropertyHostnameBucketResponse
+		withError        error
+	}{
+		"200 OK": {
+			params: ListPropertyHostnameBucketRequest{
+				PropertyID: "prp_1",
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				Network:    papi.ActivationNetworkProduction,
+				Offset:     1000,
+			},
+			expectedURL:    "/papi/v1/properties/prp_1/hostnames?contractId=ctr_1&groupId=grp_2&network=PRODUCTION&offset=1000",
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "propertyId": "prp_1",
+    "hostnames": {
+        "currentItemCount": 1,
+        "totalItems": 1001,
+        "items": [{
+            "cnameFrom": "www.example.com",
+            "cnameType": "EDGE_HOSTNAME",
+            "productionCertType": "CPS_MANAGED",
+            "productionCnameTo": "example.com.edgekey.net",
+            "productionEdgeHostnameId": "ehn_1"
+        }]
+    }
+}`,
+			expectedResponse: &ListPropertyHostnameBucketResponse{
+				PropertyID: "prp_1",
+				Hostnames: BucketHostnameItems{
+					CurrentItemCount: 1,
+					TotalItems:       1001,
+					Items: []BucketHostname{{
+						CnameFrom:                "www.example.com",
+						CnameType:                papi.HostnameCnameTypeEdgeHostname,
+						ProductionCertType:       "CPS_MANAGED",
+						ProductionCnameTo:        "example.com.edgekey.net",
+						ProductionEdgeHostnameID: "ehn_1",
+					}},
+				},
+			},
+		},
+		"validation error": {
+			params:    ListPropertyHostnameBucketRequest{PropertyID: "prp_1", ContractID: "ctr_1", GroupID: "grp_2", Network: "TEST"},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedURL, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.ListPropertyHostnameBucket(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetPropertyHostnameActivation(t *testing.T) {
+	tests := map[string]struct {
+		params             GetPropertyHostnameActivationRequest
+		responseStatus     int
+		responseBody       string
+		expectedActivation HostnameActivation
+		withError          error
+	}{
+		"200 OK": {
+			params:         GetPropertyHostnameActivationRequest{PropertyID: "prp_1", ContractID: "ctr_1", GroupID: "grp_2", HostnameActivationID: "atvhn_1"},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "hostnameActivations": {
+        "items": [{
+            "hostnameActivationId": "atvhn_1",
+            "propertyId": "prp_1",
+            "network": "STAGING",
+            "status": "PENDING",
+            "hostnames": [{"action": "ADD", "cnameFrom": "www.example.com", "edgeHostnameId": "ehn_1"}]
+        }]
+    }
+}`,
+			expectedActivation: HostnameActivation{
+				HostnameActivationID: "atvhn_1",
+				PropertyID:           "prp_1",
+				Network:              papi.ActivationNetworkStaging,
+				Status:               papi.ActivationStatusPending,
+				Hostnames: []HostnameActivationChange{{
+					Action:         HostnameActivationActionAdd,
+					CnameFrom:      "www.example.com",
+					EdgeHostnameID: "ehn_1",
+				}},
+			},
+		},
+		"activation not found": {
+			params:         GetPropertyHostnameActivationRequest{PropertyID: "prp_1", ContractID: "ctr_1", GroupID: "grp_2", HostnameActivationID: "atvhn_1"},
+			responseStatus: http.StatusOK,
+			responseBody:   `{"hostnameActivations": {"items": []}}`,
+			withError:      papi.ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/properties/prp_1/hostname-activations/atvhn_1?contractId=ctr_1&groupId=grp_2&includeHostnames=true", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.GetPropertyHostnameActivation(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedActivation, result.HostnameActivation)
+		})
+	}
+}
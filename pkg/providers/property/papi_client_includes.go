@@ -0,0 +1,853 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// Includes contains the operations on includes, rule trees shared by several properties
+	Includes interface {
+		// CreateInclude creates a new include with an editable first version
+		CreateInclude(context.Context, CreateIncludeRequest) (*CreateIncludeResponse, error)
+
+		// GetInclude gets the include with its latest, staging and production versions
+		GetInclude(context.Context, GetIncludeRequest) (*GetIncludeResponse, error)
+
+		// ListIncludes lists the includes of a contract and group
+		ListIncludes(context.Context, ListIncludesRequest) (*ListIncludesResponse, error)
+
+		// DeleteInclude removes an include which is not active on any network
+		DeleteInclude(context.Context, DeleteIncludeRequest) error
+
+		// ListIncludeParents lists the properties using the include
+		ListIncludeParents(context.Context, ListIncludeParentsRequest) (*ListIncludeParentsResponse, error)
+
+		// CreateIncludeVersion creates a new version of the include from an existing one
+		CreateIncludeVersion(context.Context, CreateIncludeVersionRequest) (*CreateIncludeVersionResponse, error)
+
+		// GetIncludeVersion gets a version of the include with its activation status
+		GetIncludeVersion(context.Context, GetIncludeVersionRequest) (*GetIncludeVersionResponse, error)
+
+		// GetIncludeRuleTree gets the rule tree of an include version
+		GetIncludeRuleTree(context.Context, GetIncludeRuleTreeRequest) (*GetIncludeRuleTreeResponse, error)
+
+		// UpdateIncludeRuleTree replaces the rule tree of an editable include version
+		UpdateIncludeRuleTree(context.Context, UpdateIncludeRuleTreeRequest) (*UpdateIncludeRuleTreeResponse, error)
+
+		// ActivateInclude creates a new activation or deactivation of an include version
+		ActivateInclude(context.Context, ActivateIncludeRequest) (*ActivateIncludeResponse, error)
+
+		// GetIncludeActivation gets an activation of the include
+		GetIncludeActivation(context.Context, GetIncludeActivationRequest) (*GetIncludeActivationResponse, error)
+
+		// ListIncludeActivations lists the activations of the include
+		ListIncludeActivations(context.Context, ListIncludeActivationsRequest) (*ListIncludeActivationsResponse, error)
+	}
+
+	// IncludeType is the type of an include
+	IncludeType string
+
+	// Include is an include in a contract and group
+	Include struct {
+		AccountID         string      `json:"accountId"`
+		AssetID           string      `json:"assetId"`
+		ContractID        string      `json:"contractId"`
+		GroupID           string      `json:"groupId"`
+		IncludeID         string      `json:"includeId"`
+		IncludeName       string      `json:"includeName"`
+		IncludeType       IncludeType `json:"includeType"`
+		LatestVersion     int         `json:"latestVersion"`
+		StagingVersion    *int        `json:"stagingVersion"`
+		ProductionVersion *int        `json:"productionVersion"`
+	}
+
+	// IncludeItems are the include items of a response
+	IncludeItems struct {
+		Items []Include `json:"items"`
+	}
+
+	// CreateIncludeRequest contains params required to create an include
+	CreateIncludeRequest struct {
+		ContractID  string
+		GroupID     string
+		IncludeName string
+		IncludeType IncludeType
+		ProductID   string
+		RuleFormat  string
+	}
+
+	// CreateIncludeResponse is the response of an include creation
+	CreateIncludeResponse struct {
+		IncludeID   string `json:"-"`
+		IncludeLink string `json:"includeLink"`
+	}
+
+	// GetIncludeRequest contains params required to get an include
+	GetIncludeRequest struct {
+		ContractID string
+		GroupID    string
+		IncludeID  string
+	}
+
+	// GetIncludeResponse is the response of GetInclude
+	GetIncludeResponse struct {
+		papi.Response
+		Includes IncludeItems `json:"includes"`
+		Include  Include      `json:"-"`
+	}
+
+	// ListIncludesRequest contains params required to list includes
+	ListIncludesRequest struct {
+		ContractID string
+		GroupID    string
+	}
+
+	// ListIncludesResponse is the response of ListIncludes
+	ListIncludesResponse struct {
+		papi.Response
+		Includes IncludeItems `json:"includes"`
+	}
+
+	// DeleteIncludeRequest contains params required to delete an include
+	DeleteIncludeRequest struct {
+		ContractID string
+		GroupID    string
+		IncludeID  string
+	}
+
+	// ListIncludeParentsRequest contains params required to list the properties using an include
+	ListIncludeParentsRequest struct {
+		ContractID string
+		GroupID    string
+		IncludeID  string
+	}
+
+	// IncludeParent is a property using an include
+	IncludeParent struct {
+		AccountID                        string `json:"accountId"`
+		ContractID                       string `json:"contractId"`
+		GroupID                          string `json:"groupId"`
+		PropertyID                       string `json:"propertyId"`
+		PropertyName                     string `json:"propertyName"`
+		StagingVersion                   *int   `json:"stagingVersion"`
+		ProductionVersion                *int   `json:"productionVersion"`
+		IsIncludeUsedInStagingVersion    bool   `json:"isIncludeUsedInStagingVersion"`
+		IsIncludeUsedInProductionVersion bool   `json:"isIncludeUsedInProductionVersion"`
+	}
+
+	// IncludeParentItems are the include parent items of a response
+	IncludeParentItems struct {
+		Items []IncludeParent `json:"items"`
+	}
+
+	// ListIncludeParentsResponse is the response of ListIncludeParents
+	ListIncludeParentsResponse struct {
+		papi.Response
+		Properties IncludeParentItems `json:"properties"`
+	}
+
+	// CreateIncludeVersionRequest contains params required to create an include version
+	CreateIncludeVersionRequest struct {
+		ContractID            string
+		GroupID               string
+		IncludeID             string
+		CreateFromVersion     int
+		CreateFromVersionEtag string
+	}
+
+	// CreateIncludeVersionResponse is the response of an include version creation
+	CreateIncludeVersionResponse struct {
+		Version     int    `json:"-"`
+		VersionLink string `json:"versionLink"`
+	}
+
+	// GetIncludeVersionRequest contains params required to get an include version
+	GetIncludeVersionRequest struct {
+		ContractID string
+		GroupID    string
+		IncludeID  string
+		Version    int
+	}
+
+	// IncludeVersion is a version of an include
+	IncludeVersion struct {
+		IncludeVersion   int                `json:"includeVersion"`
+		UpdatedByUser    string             `json:"updatedByUser"`
+		UpdatedDate      string             `json:"updatedDate"`
+		ProductionStatus papi.VersionStatus `json:"productionStatus"`
+		StagingStatus    papi.VersionStatus `json:"stagingStatus"`
+		Etag             string             `json:"etag"`
+		ProductID        string             `json:"productId"`
+		Note             string             `json:"note,omitempty"`
+		RuleFormat       string             `json:"ruleFormat"`
+	}
+
+	// IncludeVersionItems are the include version items of a response
+	IncludeVersionItems struct {
+		Items []IncludeVersion `json:"items"`
+	}
+
+	// GetIncludeVersionResponse is the response of GetIncludeVersion
+	GetIncludeVersionResponse struct {
+		papi.Response
+		IncludeID   string              `json:"includeId"`
+		IncludeName string              `json:"includeName"`
+		IncludeType IncludeType         `json:"includeType"`
+		Versions    IncludeVersionItems `json:"versions"`
+		Version     IncludeVersion      `json:"-"`
+	}
+
+	// GetIncludeRuleTreeRequest contains params required to get the rule tree of an include version
+	GetIncludeRuleTreeRequest struct {
+		ContractID    string
+		GroupID       string
+		IncludeID     string
+		Version       int
+		ValidateRules bool
+		ValidateMode  string
+	}
+
+	// GetIncludeRuleTreeResponse is the response of GetIncludeRuleTree
+	GetIncludeRuleTreeResponse struct {
+		papi.Response
+		IncludeID      string      `json:"includeId"`
+		IncludeName    string      `json:"includeName"`
+		IncludeType    IncludeType `json:"includeType"`
+		IncludeVersion int         `json:"includeVersion"`
+		RuleFormat     string      `json:"ruleFormat"`
+		Comments       string      `json:"comments,omitempty"`
+		Rules          papi.Rules  `json:"rules"`
+	}
+
+	// UpdateIncludeRuleTreeRequest contains params required to replace the rule tree of an include version.
+	// The rule format is sent in the Content-Type header of the context, as for property rules
+	UpdateIncludeRuleTreeRequest struct {
+		ContractID    string
+		GroupID       string
+		IncludeID     string
+		Version       int
+		Rules         papi.RulesUpdate
+		ValidateRules bool
+		ValidateMode  string
+		DryRun        bool
+	}
+
+	// UpdateIncludeRuleTreeResponse is the response of UpdateIncludeRuleTree
+	UpdateIncludeRuleTreeResponse GetIncludeRuleTreeResponse
+
+	// IncludeActivation is an activation or deactivation of an include version
+	IncludeActivation struct {
+		ActivationID           string                 `json:"activationId,omitempty"`
+		ActivationType         papi.ActivationType    `json:"activationType"`
+		IncludeID              string                 `json:"includeId,omitempty"`
+		IncludeVersion         int                    `json:"includeVersion"`
+		Network                papi.ActivationNetwork `json:"network"`
+		Note                   string                 `json:"note,omitempty"`
+		NotifyEmails           []string               `json:"notifyEmails"`
+		AcknowledgeWarnings    []string               `json:"acknowledgeWarnings,omitempty"`
+		AcknowledgeAllWarnings bool                   `json:"acknowledgeAllWarnings"`
+		Status                 papi.ActivationStatus  `json:"status,omitempty"`
+		SubmitDate             string                 `json:"submitDate,omitempty"`
+		UpdateDate             string                 `json:"updateDate,omitempty"`
+	}
+
+	// IncludeActivationItems are the include activation items of a response
+	IncludeActivationItems struct {
+		Items []IncludeActivation `json:"items"`
+	}
+
+	// ActivateIncludeRequest contains params required to activate or deactivate an include version
+	ActivateIncludeRequest struct {
+		ContractID string
+		GroupID    string
+		IncludeID  string
+		Activation IncludeActivation
+	}
+
+	// ActivateIncludeResponse is the response of ActivateInclude
+	ActivateIncludeResponse struct {
+		ActivationID   string `json:"-"`
+		ActivationLink string `json:"activationLink"`
+	}
+
+	// GetIncludeActivationRequest contains params required to get an include activation
+	GetIncludeActivationRequest struct {
+		ContractID   string
+		GroupID      string
+		IncludeID    string
+		ActivationID string
+	}
+
+	// GetIncludeActivationResponse is the response of GetIncludeActivation
+	GetIncludeActivationResponse struct {
+		papi.Response
+		Activations IncludeActivationItems `json:"activations"`
+		Activation  IncludeActivation      `json:"-"`
+	}
+
+	// ListIncludeActivationsRequest contains params required to list include activations
+	ListIncludeActivationsRequest struct {
+		ContractID string
+		GroupID    string
+		IncludeID  string
+	}
+
+	// ListIncludeActivationsResponse is the response of ListIncludeActivations
+	ListIncludeActivationsResponse struct {
+		papi.Response
+		Activations IncludeActivationItems `json:"activations"`
+	}
+
+	createIncludeBody struct {
+		IncludeName string      `json:"includeName"`
+		IncludeType IncludeType `json:"includeType"`
+		ProductID   string      `json:"productId"`
+		RuleFormat  string      `json:"ruleFormat,omitempty"`
+	}
+
+	createIncludeVersionBody struct {
+		CreateFromVersion     int    `json:"createFromVersion"`
+		CreateFromVersionEtag string `json:"createFromVersionEtag,omitempty"`
+	}
+)
+
+const (
+	// IncludeTypeMicroServices is an include a team manages independently of the properties using it
+	IncludeTypeMicroServices IncludeType = "MICROSERVICES"
+	// IncludeTypeCommonSettings is an include with settings shared by several properties
+	IncludeTypeCommonSettings IncludeType = "COMMON_SETTINGS"
+)
+
+var (
+	// ErrCreateInclude is returned when CreateInclude fails
+	ErrCreateInclude = errors.New("creating include")
+	// ErrGetInclude is returned when GetInclude fails
+	ErrGetInclude = errors.New("fetching include")
+	// ErrListIncludes is returned when ListIncludes fails
+	ErrListIncludes = errors.New("listing includes")
+	// ErrDeleteInclude is returned when DeleteInclude fails
+	ErrDeleteInclude = errors.New("deleting include")
+	// ErrListIncludeParents is returned when ListIncludeParents fails
+	ErrListIncludeParents = errors.New("listing include parents")
+	// ErrCreateIncludeVersion is returned when CreateIncludeVersion fails
+	ErrCreateIncludeVersion = errors.New("creating include version")
+	// ErrGetIncludeVersion is returned when GetIncludeVersion fails
+	ErrGetIncludeVersion = errors.New("fetching include version")
+	// ErrGetIncludeRuleTree is returned when GetIncludeRuleTree fails
+	ErrGetIncludeRuleTree = errors.New("fetching include rule tree")
+	// ErrUpdateIncludeRuleTree is returned when UpdateIncludeRuleTree fails
+	ErrUpdateIncludeRuleTree = errors.New("updating include rule tree")
+	// ErrActivateInclude is returned when ActivateInclude fails
+	ErrActivateInclude = errors.New("activating include")
+	// ErrGetIncludeActivation is returned when GetIncludeActivation fails
+	ErrGetIncludeActivation = errors.New("fetching include activation")
+	// ErrListIncludeActivations is returned when ListIncludeActivations fails
+	ErrListIncludeActivations = errors.New("listing include activations")
+)
+
+// Validate validates CreateIncludeRequest
+func (r CreateIncludeRequest) Validate() error {
+	return validation.Errors{
+		"ContractID":  validation.Validate(r.ContractID, validation.Required),
+		"GroupID":     validation.Validate(r.GroupID, validation.Required),
+		"IncludeName": validation.Validate(r.IncludeName, validation.Required),
+		"IncludeType": validation.Validate(r.IncludeType, validation.Required,
+			validation.In(IncludeTypeMicroServices, IncludeTypeCommonSettings)),
+		"ProductID": validation.Validate(r.ProductID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates GetIncludeRequest
+func (r GetIncludeRequest) Validate() error {
+	return validateIncludeParams(r.ContractID, r.GroupID, r.IncludeID)
+}
+
+// Validate validates ListIncludesRequest
+func (r ListIncludesRequest) Validate() error {
+	return validation.Errors{
+		"ContractID": validation.Validate(r.ContractID, validation.Required),
+		"GroupID":    validation.Validate(r.GroupID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates DeleteIncludeRequest
+func (r DeleteIncludeRequest) Validate() error {
+	return validateIncludeParams(r.ContractID, r.GroupID, r.IncludeID)
+}
+
+// Validate validates ListIncludeParentsRequest
+func (r ListIncludeParentsRequest) Validate() error {
+	return validateIncludeParams(r.ContractID, r.GroupID, r.IncludeID)
+}
+
+// Validate validates CreateIncludeVersionRequest
+func (r CreateIncludeVersionRequest) Validate() error {
+	return validation.Errors{
+		"ContractID":        validation.Validate(r.ContractID, validation.Required),
+		"GroupID":           validation.Validate(r.GroupID, validation.Required),
+		"IncludeID":         validation.Validate(r.IncludeID, validation.Required),
+		"CreateFromVersion": validation.Validate(r.CreateFromVersion, validation.Required),
+	}.Filter()
+}
+
+// Validate validates GetIncludeVersionRequest
+func (r GetIncludeVersionRequest) Validate() error {
+	return validateIncludeVersionParams(r.ContractID, r.GroupID, r.IncludeID, r.Version)
+}
+
+// Validate validates GetIncludeRuleTreeRequest
+func (r GetIncludeRuleTreeRequest) Validate() error {
+	return validateIncludeVersionParams(r.ContractID, r.GroupID, r.IncludeID, r.Version)
+}
+
+// Validate validates UpdateIncludeRuleTreeRequest
+func (r UpdateIncludeRuleTreeRequest) Validate() error {
+	return validateIncludeVersionParams(r.ContractID, r.GroupID, r.IncludeID, r.Version)
+}
+
+// Validate validates ActivateIncludeRequest
+func (r ActivateIncludeRequest) Validate() error {
+	return validation.Errors{
+		"ContractID": validation.Validate(r.ContractID, validation.Required),
+		"GroupID":    validation.Validate(r.GroupID, validation.Required),
+		"IncludeID":  validation.Validate(r.IncludeID, validation.Required),
+		"Activation.ActivationType": validation.Validate(r.Activation.ActivationType, validation.Required,
+			validation.In(papi.ActivationTypeActivate, papi.ActivationTypeDeactivate)),
+		"Activation.IncludeVersion": validation.Validate(r.Activation.IncludeVersion, validation.Required),
+		"Activation.Network": validation.Validate(r.Activation.Network, validation.Required,
+			validation.In(papi.ActivationNetworkStaging, papi.ActivationNetworkProduction)),
+		"Activation.NotifyEmails": validation.Validate(r.Activation.NotifyEmails, validation.Required),
+	}.Filter()
+}
+
+// Validate validates GetIncludeActivationRequest
+func (r GetIncludeActivationRequest) Validate() error {
+	return validation.Errors{
+		"ContractID":   validation.Validate(r.ContractID, validation.Required),
+		"GroupID":      validation.Validate(r.GroupID, validation.Required),
+		"IncludeID":    validation.Validate(r.IncludeID, validation.Required),
+		"ActivationID": validation.Validate(r.ActivationID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates ListIncludeActivationsRequest
+func (r ListIncludeActivationsRequest) Validate() error {
+	return validateIncludeParams(r.ContractID, r.GroupID, r.IncludeID)
+}
+
+func validateIncludeParams(ContractID, GroupID, IncludeID string) error {
+	return validation.Errors{
+		"ContractID": validation.Validate(ContractID, validation.Required),
+		"GroupID":    validation.Validate(GroupID, validation.Required),
+		"IncludeID":  validation.Validate(IncludeID, validation.Required),
+	}.Filter()
+}
+
+func validateIncludeVersionParams(ContractID, GroupID, IncludeID string, Version int) error {
+	return validation.Errors{
+		"ContractID": validation.Validate(ContractID, validation.Required),
+		"GroupID":    validation.Validate(GroupID, validation.Required),
+		"IncludeID":  validation.Validate(IncludeID, validation.Required),
+		"Version":    validation.Validate(Version, validation.Required),
+	}.Filter()
+}
+
+func (c *papiClient) CreateInclude(ctx context.Context, params CreateIncludeRequest) (*CreateIncludeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateInclude, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateInclude, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateInclude, err)
+	}
+
+	body := createIncludeBody{
+		IncludeName: params.IncludeName,
+		IncludeType: params.IncludeType,
+		ProductID:   params.ProductID,
+		RuleFormat:  params.RuleFormat,
+	}
+
+	var rval CreateIncludeResponse
+	resp, err := c.exec(req, &rval, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateInclude, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateInclude, papiResponseError(resp))
+	}
+
+	if rval.IncludeID, err = linkID(rval.IncludeLink); err != nil {
+		return nil, fmt.Errorf("%w: invalid include link: %s", ErrCreateInclude, err)
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) GetInclude(ctx context.Context, params GetIncludeRequest) (*GetIncludeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetInclude, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetInclude, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetInclude, err)
+	}
+
+	var rval GetIncludeResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetInclude, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetInclude, papiResponseError(resp))
+	}
+
+	if len(rval.Includes.Items) == 0 {
+		return nil, fmt.Errorf("%s: %w: IncludeID: %s", ErrGetInclude, papi.ErrNotFound, params.IncludeID)
+	}
+	rval.Include = rval.Includes.Items[0]
+
+	return &rval, nil
+}
+
+func (c *papiClient) ListIncludes(ctx context.Context, params ListIncludesRequest) (*ListIncludesResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrListIncludes, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListIncludes, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListIncludes, err)
+	}
+
+	var rval ListIncludesResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListIncludes, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListIncludes, papiResponseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) DeleteInclude(ctx context.Context, params DeleteIncludeRequest) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w: %s", ErrDeleteInclude, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID)
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse url: %s", ErrDeleteInclude, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteInclude, err)
+	}
+
+	resp, err := c.exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrDeleteInclude, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %w", ErrDeleteInclude, papiResponseError(resp))
+	}
+
+	return nil
+}
+
+func (c *papiClient) ListIncludeParents(ctx context.Context, params ListIncludeParentsRequest) (*ListIncludeParentsResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrListIncludeParents, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "parents")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListIncludeParents, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListIncludeParents, err)
+	}
+
+	var rval ListIncludeParentsResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListIncludeParents, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListIncludeParents, papiResponseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) CreateIncludeVersion(ctx context.Context, params CreateIncludeVersionRequest) (*CreateIncludeVersionResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrCreateIncludeVersion, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "versions")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateIncludeVersion, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateIncludeVersion, err)
+	}
+
+	body := createIncludeVersionBody{
+		CreateFromVersion:     params.CreateFromVersion,
+		CreateFromVersionEtag: params.CreateFromVersionEtag,
+	}
+
+	var rval CreateIncludeVersionResponse
+	resp, err := c.exec(req, &rval, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateIncludeVersion, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateIncludeVersion, papiResponseError(resp))
+	}
+
+	version, err := linkID(rval.VersionLink)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid version link: %s", ErrCreateIncludeVersion, err)
+	}
+	if rval.Version, err = strconv.Atoi(version); err != nil {
+		return nil, fmt.Errorf("%w: invalid version link: %s", ErrCreateIncludeVersion, err)
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) GetIncludeVersion(ctx context.Context, params GetIncludeVersionRequest) (*GetIncludeVersionResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetIncludeVersion, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "versions", strconv.Itoa(params.Version))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetIncludeVersion, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetIncludeVersion, err)
+	}
+
+	var rval GetIncludeVersionResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetIncludeVersion, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetIncludeVersion, papiResponseError(resp))
+	}
+
+	if len(rval.Versions.Items) == 0 {
+		return nil, fmt.Errorf("%s: %w: Version: %d", ErrGetIncludeVersion, papi.ErrNotFound, params.Version)
+	}
+	rval.Version = rval.Versions.Items[0]
+
+	return &rval, nil
+}
+
+func (c *papiClient) GetIncludeRuleTree(ctx context.Context, params GetIncludeRuleTreeRequest) (*GetIncludeRuleTreeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetIncludeRuleTree, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "versions", strconv.Itoa(params.Version), "rules")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetIncludeRuleTree, err)
+	}
+	q := uri.Query()
+	q.Add("validateRules", strconv.FormatBool(params.ValidateRules))
+	if params.ValidateMode != "" {
+		q.Add("validateMode", params.ValidateMode)
+	}
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetIncludeRuleTree, err)
+	}
+
+	var rval GetIncludeRuleTreeResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetIncludeRuleTree, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetIncludeRuleTree, papiResponseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) UpdateIncludeRuleTree(ctx context.Context, params UpdateIncludeRuleTreeRequest) (*UpdateIncludeRuleTreeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrUpdateIncludeRuleTree, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "versions", strconv.Itoa(params.Version), "rules")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUpdateIncludeRuleTree, err)
+	}
+	q := uri.Query()
+	q.Add("validateRules", strconv.FormatBool(params.ValidateRules))
+	if params.ValidateMode != "" {
+		q.Add("validateMode", params.ValidateMode)
+	}
+	if params.DryRun {
+		q.Add("dryRun", "true")
+	}
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateIncludeRuleTree, err)
+	}
+
+	var rval UpdateIncludeRuleTreeResponse
+	resp, err := c.exec(req, &rval, params.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateIncludeRuleTree, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateIncludeRuleTree, papiResponseError(resp))
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) ActivateInclude(ctx context.Context, params ActivateIncludeRequest) (*ActivateIncludeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrActivateInclude, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "activations")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrActivateInclude, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrActivateInclude, err)
+	}
+
+	var rval ActivateIncludeResponse
+	resp, err := c.exec(req, &rval, params.Activation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrActivateInclude, err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrActivateInclude, papiResponseError(resp))
+	}
+
+	if rval.ActivationID, err = linkID(rval.ActivationLink); err != nil {
+		return nil, fmt.Errorf("%w: invalid activation link: %s", ErrActivateInclude, err)
+	}
+
+	return &rval, nil
+}
+
+func (c *papiClient) GetIncludeActivation(ctx context.Context, params GetIncludeActivationRequest) (*GetIncludeActivationResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetIncludeActivation, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "activations", params.ActivationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetIncludeActivation, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetIncludeActivation, err)
+	}
+
+	var rval GetIncludeActivationResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetIncludeActivation, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetIncludeActivation, papiResponseError(resp))
+	}
+
+	if len(rval.Activations.Items) == 0 {
+		return nil, fmt.Errorf("%s: %w: ActivationID: %s", ErrGetIncludeActivation, papi.ErrNotFound, params.ActivationID)
+	}
+	rval.Activation = rval.Activations.Items[0]
+
+	return &rval, nil
+}
+
+func (c *papiClient) ListIncludeActivations(ctx context.Context, params ListIncludeActivationsRequest) (*ListIncludeActivationsResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrListIncludeActivations, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "includes", params.IncludeID, "activations")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrListIncludeActivations, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListIncludeActivations, err)
+	}
+
+	var rval ListIncludeActivationsResponse
+	resp, err := c.exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrListIncludeActivations, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrListIncludeActivations, papiResponseError(resp))
+	}
+
+	return &rval, nil
+}
@@ -0,0 +1,334 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateInclude(t *testing.T) {
+	tests := map[string]struct {
+		params           CreateIncludeRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *CreateIncludeResponse
+		withError        error
+	}{
+		"201 Created": {
+			params: CreateIncludeRequest{
+				ContractID:  "ctr_1",
+				GroupID:     "grp_2",
+				IncludeName: "test_include",
+				IncludeType: IncludeTypeMicroServices,
+				ProductID:   "prd_Web_App_Accel",
+				RuleFormat:  "v2020-11-02",
+			},
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"includeLink": "/papi/v1/includes/inc_123?contractId=ctr_1&groupId=grp_2"}`,
+			expectedResponse: &CreateIncludeResponse{
+				IncludeID:   "inc_123",
+				IncludeLink: "/papi/v1/includes/inc_123?contractId=ctr_1&groupId=grp_2",
+			},
+		},
+		"400 Bad Request": {
+			params: CreateIncludeRequest{
+				ContractID:  "ctr_1",
+				GroupID:     "grp_2",
+				IncludeName: "test_include",
+				IncludeType: IncludeTypeMicroServices,
+				ProductID:   "prd_Web_App_Accel",
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "/papi/v1/errors/invalid-product", "title": "Invalid product", "detail": "The product is not valid for the contract"}`,
+			withError: &papi.Error{
+				Type:       "/papi/v1/errors/invalid-product",
+				Title:      "Invalid product",
+				Detail:     "The product is not valid for the contract",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		"validation error": {
+			params: CreateIncludeRequest{
+				ContractID:  "ctr_1",
+				GroupID:     "grp_2",
+				IncludeName: "test_include",
+				IncludeType: "INVALID",
+				ProductID:   "prd_Web_App_Accel",
+			},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/includes?contractId=ctr_1&groupId=grp_2", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "true", r.Header.Get("PAPI-Use-Prefixes"))
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), `"includeName":"test_include"`)
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.CreateInclude(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGetInclude(t *testing.T) {
+	stagingVersion := 1
+	tests := map[string]struct {
+		params          GetIncludeRequest
+		responseStatus  int
+		responseBody    string
+		expectedInclude Include
+		withError       error
+	}{
+		"200 OK": {
+			params:         GetIncludeRequest{ContractID: "ctr_1", GroupID: "grp_2", IncludeID: "inc_123"},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "includes": {
+        "items": [{
+            "accountId": "act_1",
+            "contractId": "ctr_1",
+            "groupId": "grp_2",
+            "includeId": "inc_123",
+            "includeName": "test_include",
+            "includeType": "MICROSERVICES",
+            "latestVersion": 2,
+            "stagingVersion": 1,
+            "productionVersion": null
+        }]
+    }
+}`,
+			expectedInclude: Include{
+				AccountID:      "act_1",
+				ContractID:     "ctr_1",
+				GroupID:        "grp_2",
+				IncludeID:      "inc_123",
+				IncludeName:    "test_include",
+				IncludeType:    IncludeTypeMicroServices,
+				LatestVersion:  2,
+				StagingVersion: &stagingVersion,
+			},
+		},
+		"include not found": {
+			params:         GetIncludeRequest{ContractID: "ctr_1", GroupID: "grp_2", IncludeID: "inc_123"},
+			responseStatus: http.StatusOK,
+			responseBody:   `{"includes": {"items": []}}`,
+			withError:      papi.ErrNotFound,
+		},
+		"validation error": {
+			params:    GetIncludeRequest{ContractID: "ctr_1", GroupID: "grp_2"},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/includes/inc_123?contractId=ctr_1&groupId=grp_2", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.GetInclude(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedInclude, result.Include)
+		})
+	}
+}
+
+func TestUpdateIncludeRuleTree(t *testing.T) {
+	tests := map[string]struct {
+		params           UpdateIncludeRuleTreeRequest
+		expectedURL      string
+		responseStatus   int
+		responseBody     string
+		expectedResponse *UpdateIncludeRuleTreeResponse
+		withError        error
+	}{
+		"200 OK with rule errors": {
+			params: UpdateIncludeRuleTreeRequest{
+				ContractID:    "ctr_1",
+				GroupID:       "grp_2",
+				IncludeID:     "inc_123",
+				Version:       2,
+				Rules:         papi.RulesUpdate{Rules: papi.Rules{Name: "default"}},
+				ValidateRules: true,
+			},
+			expectedURL:    "/papi/v1/includes/inc_123/versions/2/rules?contractId=ctr_1&groupId=grp_2&validateRules=true",
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "includeId": "inc_123",
+    "includeVersion": 2,
+    "ruleFormat": "v2020-11-02",
+    "rules": {"name": "default"},
+    "errors": [{"type": "/papi/v1/errors/validation.required_behavior", "title": "Missing required behavior"}]
+}`,
+			expectedResponse: &UpdateIncludeRuleTreeResponse{
+				Response: papi.Response{
+					Errors: []*papi.Error{{Type: "/papi/v1/errors/validation.required_behavior", Title: "Missing required behavior"}},
+				},
+				IncludeID:      "inc_123",
+				IncludeVersion: 2,
+				RuleFormat:     "v2020-11-02",
+				Rules:          papi.Rules{Name: "default"},
+			},
+		},
+		"dry run": {
+			params: UpdateIncludeRuleTreeRequest{
+				ContractID:    "ctr_1",
+				GroupID:       "grp_2",
+				IncludeID:     "inc_123",
+				Version:       2,
+				Rules:         papi.RulesUpdate{Rules: papi.Rules{Name: "default"}},
+				ValidateRules: true,
+				ValidateMode:  papi.RuleValidateModeFull,
+				DryRun:        true,
+			},
+			expectedURL:    "/papi/v1/includes/inc_123/versions/2/rules?contractId=ctr_1&dryRun=true&groupId=grp_2&validateMode=full&validateRules=true",
+			responseStatus: http.StatusOK,
+			responseBody:   `{"includeId": "inc_123", "includeVersion": 2, "rules": {"name": "default"}}`,
+			expectedResponse: &UpdateIncludeRuleTreeResponse{
+				IncludeID:      "inc_123",
+				IncludeVersion: 2,
+				Rules:          papi.Rules{Name: "default"},
+			},
+		},
+		"409 Conflict": {
+			params: UpdateIncludeRuleTreeRequest{
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				IncludeID:  "inc_123",
+				Version:    1,
+				Rules:      papi.RulesUpdate{Rules: papi.Rules{Name: "default"}},
+			},
+			expectedURL:    "/papi/v1/includes/inc_123/versions/1/rules?contractId=ctr_1&groupId=grp_2&validateRules=false",
+			responseStatus: http.StatusConflict,
+			responseBody:   `{"type": "/papi/v1/errors/version-not-editable", "title": "Version not editable", "detail": "The version is active"}`,
+			withError: &papi.Error{
+				Type:       "/papi/v1/errors/version-not-editable",
+				Title:      "Version not editable",
+				Detail:     "The version is active",
+				StatusCode: http.StatusConflict,
+			},
+		},
+		"validation error": {
+			params:    UpdateIncludeRuleTreeRequest{ContractID: "ctr_1", GroupID: "grp_2", IncludeID: "inc_123"},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedURL, r.URL.String())
+				assert.Equal(t, http.MethodPut, r.Method)
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), `"name":"default"`)
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.UpdateIncludeRuleTree(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestActivateInclude(t *testing.T) {
+	tests := map[string]struct {
+		params           ActivateIncludeRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *ActivateIncludeResponse
+		withError        error
+	}{
+		"201 Created": {
+			params: ActivateIncludeRequest{
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				IncludeID:  "inc_123",
+				Activation: IncludeActivation{
+					ActivationType: papi.ActivationTypeActivate,
+					IncludeVersion: 2,
+					Network:        papi.ActivationNetworkStaging,
+					NotifyEmails:   []string{"user@example.com"},
+				},
+			},
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"activationLink": "/papi/v1/includes/inc_123/activations/atv_1?contractId=ctr_1&groupId=grp_2"}`,
+			expectedResponse: &ActivateIncludeResponse{
+				ActivationID:   "atv_1",
+				ActivationLink: "/papi/v1/includes/inc_123/activations/atv_1?contractId=ctr_1&groupId=grp_2",
+			},
+		},
+		"validation error": {
+			params: ActivateIncludeRequest{
+				ContractID: "ctr_1",
+				GroupID:    "grp_2",
+				IncludeID:  "inc_123",
+				Activation: IncludeActivation{
+					ActivationType: papi.ActivationTypeActivate,
+					IncludeVersion: 2,
+					Network:        papi.ActivationNetworkStaging,
+				},
+			},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/includes/inc_123/activations?contractId=ctr_1&groupId=grp_2", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), `"network":"STAGING"`)
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.ActivateInclude(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
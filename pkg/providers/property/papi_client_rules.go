@@ -0,0 +1,67 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+type (
+	// RuleTrees contains the rule tree operations the edgegrid client does not support
+	RuleTrees interface {
+		// DryRunRuleTree validates the rules against a property version without saving them. Unlike UpdateRuleTree,
+		// it returns the rule warnings along with the rule errors
+		DryRunRuleTree(context.Context, papi.UpdateRulesRequest) (*DryRunRuleTreeResponse, error)
+	}
+
+	// DryRunRuleTreeResponse is the response of DryRunRuleTree
+	DryRunRuleTreeResponse struct {
+		papi.Response
+		PropertyID      string `json:"propertyId"`
+		PropertyVersion int    `json:"propertyVersion"`
+		RuleFormat      string `json:"ruleFormat"`
+	}
+)
+
+var (
+	// ErrDryRunRuleTree is returned when DryRunRuleTree fails
+	ErrDryRunRuleTree = errors.New("validating rule tree")
+)
+
+func (c *papiClient) DryRunRuleTree(ctx context.Context, params papi.UpdateRulesRequest) (*DryRunRuleTreeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrDryRunRuleTree, papi.ErrStructValidation, err)
+	}
+
+	uri, err := papiURL(params.ContractID, params.GroupID, "properties", params.PropertyID, "versions",
+		strconv.Itoa(params.PropertyVersion), "rules")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrDryRunRuleTree, err)
+	}
+	q := uri.Query()
+	q.Add("validateRules", "true")
+	q.Add("validateMode", papi.RuleValidateModeFull)
+	q.Add("dryRun", "true")
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrDryRunRuleTree, err)
+	}
+
+	var rval DryRunRuleTreeResponse
+	resp, err := c.exec(req, &rval, params.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrDryRunRuleTree, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrDryRunRuleTree, papiResponseError(resp))
+	}
+
+	return &rval, nil
+}
@@ -0,0 +1,103 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunRuleTree(t *testing.T) {
+	tests := map[string]struct {
+		params           papi.UpdateRulesRequest
+		responseStatus   int
+		responseBody     string
+		expectedResponse *DryRunRuleTreeResponse
+		withError        error
+	}{
+		"200 OK with errors and warnings": {
+			params: papi.UpdateRulesRequest{
+				PropertyID:      "prp_1",
+				ContractID:      "ctr_1",
+				GroupID:         "grp_2",
+				PropertyVersion: 3,
+				Rules:           papi.RulesUpdate{Rules: papi.Rules{Name: "default"}},
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+    "propertyId": "prp_1",
+    "propertyVersion": 3,
+    "ruleFormat": "v2020-11-02",
+    "errors": [{"type": "/papi/v1/errors/validation.required_behavior", "title": "Missing required behavior", "behaviorName": "origin"}],
+    "warnings": [{"type": "/papi/v1/errors/validation.unstable_rule_format", "title": "Unstable rule format"}]
+}`,
+			expectedResponse: &DryRunRuleTreeResponse{
+				Response: papi.Response{
+					Errors: []*papi.Error{{
+						Type:         "/papi/v1/errors/validation.required_behavior",
+						Title:        "Missing required behavior",
+						BehaviorName: "origin",
+					}},
+					Warnings: []*papi.Error{{
+						Type:  "/papi/v1/errors/validation.unstable_rule_format",
+						Title: "Unstable rule format",
+					}},
+				},
+				PropertyID:      "prp_1",
+				PropertyVersion: 3,
+				RuleFormat:      "v2020-11-02",
+			},
+		},
+		"404 Not Found": {
+			params: papi.UpdateRulesRequest{
+				PropertyID:      "prp_1",
+				ContractID:      "ctr_1",
+				GroupID:         "grp_2",
+				PropertyVersion: 3,
+				Rules:           papi.RulesUpdate{Rules: papi.Rules{Name: "default"}},
+			},
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"type": "/papi/v1/errors/not-found", "title": "Not found", "detail": "The version does not exist"}`,
+			withError: &papi.Error{
+				Type:       "/papi/v1/errors/not-found",
+				Title:      "Not found",
+				Detail:     "The version does not exist",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+		"validation error": {
+			params: papi.UpdateRulesRequest{
+				PropertyID:      "prp_1",
+				ContractID:      "ctr_1",
+				GroupID:         "grp_2",
+				PropertyVersion: 3,
+			},
+			withError: papi.ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/properties/prp_1/versions/3/rules?contractId=ctr_1&dryRun=true&groupId=grp_2&validateMode=full&validateRules=true", r.URL.String())
+				assert.Equal(t, http.MethodPut, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockPapiClient(t, mockServer)
+			result, err := client.DryRunRuleTree(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
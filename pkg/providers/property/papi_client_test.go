@@ -0,0 +1,55 @@
+package property
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPapiClient(t *testing.T, mockServer *httptest.Server) PAPI {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+
+	s, err := session.New(
+		session.WithClient(httpClient),
+		session.WithSigner(&edgegrid.Config{Host: serverURL.Host}),
+	)
+	require.NoError(t, err)
+	return NewClient(s)
+}
+
+func TestLinkID(t *testing.T) {
+	tests := map[string]struct {
+		link     string
+		expected string
+	}{
+		"include link":    {link: "/papi/v1/includes/inc_123?contractId=ctr_1&groupId=grp_2", expected: "inc_123"},
+		"version link":    {link: "/papi/v1/includes/inc_123/versions/3?contractId=ctr_1&groupId=grp_2", expected: "3"},
+		"activation link": {link: "/papi/v1/includes/inc_123/activations/atv_1", expected: "atv_1"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			id, err := linkID(test.link)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
@@ -462,3 +462,169 @@ func (p *mockpapi) GetRuleFormats(ctx context.Context) (*papi.GetRuleFormatsResp
 
 	return args.Get(0).(*papi.GetRuleFormatsResponse), args.Error(1)
 }
+
+func (p *mockpapi) CreateInclude(ctx context.Context, r CreateIncludeRequest) (*CreateIncludeResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*CreateIncludeResponse), args.Error(1)
+}
+
+func (p *mockpapi) GetInclude(ctx context.Context, r GetIncludeRequest) (*GetIncludeResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetIncludeResponse), args.Error(1)
+}
+
+func (p *mockpapi) ListIncludes(ctx context.Context, r ListIncludesRequest) (*ListIncludesResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ListIncludesResponse), args.Error(1)
+}
+
+func (p *mockpapi) DeleteInclude(ctx context.Context, r DeleteIncludeRequest) error {
+	args := p.Called(ctx, r)
+
+	return args.Error(0)
+}
+
+func (p *mockpapi) ListIncludeParents(ctx context.Context, r ListIncludeParentsRequest) (*ListIncludeParentsResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ListIncludeParentsResponse), args.Error(1)
+}
+
+func (p *mockpapi) CreateIncludeVersion(ctx context.Context, r CreateIncludeVersionRequest) (*CreateIncludeVersionResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*CreateIncludeVersionResponse), args.Error(1)
+}
+
+func (p *mockpapi) GetIncludeVersion(ctx context.Context, r GetIncludeVersionRequest) (*GetIncludeVersionResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetIncludeVersionResponse), args.Error(1)
+}
+
+func (p *mockpapi) GetIncludeRuleTree(ctx context.Context, r GetIncludeRuleTreeRequest) (*GetIncludeRuleTreeResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetIncludeRuleTreeResponse), args.Error(1)
+}
+
+func (p *mockpapi) UpdateIncludeRuleTree(ctx context.Context, r UpdateIncludeRuleTreeRequest) (*UpdateIncludeRuleTreeResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*UpdateIncludeRuleTreeResponse), args.Error(1)
+}
+
+func (p *mockpapi) ActivateInclude(ctx context.Context, r ActivateIncludeRequest) (*ActivateIncludeResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ActivateIncludeResponse), args.Error(1)
+}
+
+func (p *mockpapi) GetIncludeActivation(ctx context.Context, r GetIncludeActivationRequest) (*GetIncludeActivationResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetIncludeActivationResponse), args.Error(1)
+}
+
+func (p *mockpapi) ListIncludeActivations(ctx context.Context, r ListIncludeActivationsRequest) (*ListIncludeActivationsResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ListIncludeActivationsResponse), args.Error(1)
+}
+
+func (p *mockpapi) PatchPropertyHostnameBucket(ctx context.Context, r PatchPropertyHostnameBucketRequest) (*PatchPropertyHostnameBucketResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*PatchPropertyHostnameBucketResponse), args.Error(1)
+}
+
+func (p *mockpapi) ListPropertyHostnameBucket(ctx context.Context, r ListPropertyHostnameBucketRequest) (*ListPropertyHostnameBucketResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ListPropertyHostnameBucketResponse), args.Error(1)
+}
+
+func (p *mockpapi) GetPropertyHostnameActivation(ctx context.Context, r GetPropertyHostnameActivationRequest) (*GetPropertyHostnameActivationResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetPropertyHostnameActivationResponse), args.Error(1)
+}
+
+func (p *mockpapi) DryRunRuleTree(ctx context.Context, r papi.UpdateRulesRequest) (*DryRunRuleTreeResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*DryRunRuleTreeResponse), args.Error(1)
+}
+
+func (p *mockpapi) CreateComplianceActivation(ctx context.Context, r CreateComplianceActivationRequest) (*papi.CreateActivationResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*papi.CreateActivationResponse), args.Error(1)
+}
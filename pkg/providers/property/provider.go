@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/config"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
@@ -21,7 +20,8 @@ type (
 	provider struct {
 		*schema.Provider
 
-		client papi.PAPI
+		client     PAPI
+		hapiClient HAPI
 	}
 
 	// Option is a papi provider option
@@ -72,43 +72,67 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"akamai_contract":                dataSourcePropertyContract(),
-			"akamai_contracts":               dataSourceAkamaiContracts(),
-			"akamai_cp_code":                 dataSourceCPCode(),
-			"akamai_group":                   dataSourcePropertyGroup(),
-			"akamai_groups":                  dataSourcePropertyMultipleGroups(),
-			"akamai_property_rules":          dataPropertyRules(),
-			"akamai_property_rule_formats":   dataPropertyRuleFormats(),
-			"akamai_property":                dataSourceAkamaiProperty(),
-			"akamai_property_rules_template": dataSourcePropertyRulesTemplate(),
-			"akamai_properties":              dataSourceAkamaiProperties(),
-			"akamai_property_products":       dataSourceAkamaiPropertyProducts(),
-			"akamai_property_hostnames":      dataSourceAkamaiPropertyHostnames(),
+			"akamai_contract":                     dataSourcePropertyContract(),
+			"akamai_contracts":                    dataSourceAkamaiContracts(),
+			"akamai_cp_code":                      dataSourceCPCode(),
+			"akamai_group":                        dataSourcePropertyGroup(),
+			"akamai_groups":                       dataSourcePropertyMultipleGroups(),
+			"akamai_property_rules":               dataPropertyRules(),
+			"akamai_property_rule_formats":        dataPropertyRuleFormats(),
+			"akamai_property_rule_format_upgrade": dataPropertyRuleFormatUpgrade(),
+			"akamai_property":                     dataSourceAkamaiProperty(),
+			"akamai_property_rules_template":      dataSourcePropertyRulesTemplate(),
+			"akamai_property_search":              dataSourcePropertySearch(),
+			"akamai_properties":                   dataSourceAkamaiProperties(),
+			"akamai_property_products":            dataSourceAkamaiPropertyProducts(),
+			"akamai_property_hostnames":           dataSourceAkamaiPropertyHostnames(),
+			"akamai_property_includes":            dataSourcePropertyIncludes(),
+			"akamai_property_include_parents":     dataSourcePropertyIncludeParents(),
+			"akamai_property_variables":           dataSourcePropertyVariables(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"akamai_cp_code":             resourceCPCode(),
-			"akamai_edge_hostname":       resourceSecureEdgeHostName(),
-			"akamai_property":            resourceProperty(),
-			"akamai_property_variables":  resourcePropertyVariables(),
-			"akamai_property_activation": resourcePropertyActivation(),
+			"akamai_cp_code":                     resourceCPCode(),
+			"akamai_edge_hostname":               resourceSecureEdgeHostName(),
+			"akamai_property":                    resourceProperty(),
+			"akamai_property_variables":          resourcePropertyVariables(),
+			"akamai_property_activation":         resourcePropertyActivation(),
+			"akamai_property_bulk_activation":    resourcePropertyBulkActivation(),
+			"akamai_property_include":            resourcePropertyInclude(),
+			"akamai_property_include_activation": resourcePropertyIncludeActivation(),
+			"akamai_property_hostname_bucket":    resourcePropertyHostnameBucket(),
 		},
 	}
 	return provider
 }
 
 // WithClient sets the client interface function, used for mocking and testing
-func WithClient(c papi.PAPI) Option {
+func WithClient(c PAPI) Option {
 	return func(p *provider) {
 		p.client = c
 	}
 }
 
 // Client returns the PAPI interface
-func (p *provider) Client(meta akamai.OperationMeta) papi.PAPI {
+func (p *provider) Client(meta akamai.OperationMeta) PAPI {
 	if p.client != nil {
 		return p.client
 	}
-	return papi.Client(meta.Session())
+	return NewClient(meta.Session())
+}
+
+// WithHapiClient sets the HAPI client interface function, used for mocking and testing
+func WithHapiClient(c HAPI) Option {
+	return func(p *provider) {
+		p.hapiClient = c
+	}
+}
+
+// HapiClient returns the HAPI interface used to manage edge hostnames
+func (p *provider) HapiClient(meta akamai.OperationMeta) HAPI {
+	if p.hapiClient != nil {
+		return p.hapiClient
+	}
+	return NewHapiClient(meta.Session())
 }
 
 func getPAPIV1Service(d *schema.ResourceData) error {
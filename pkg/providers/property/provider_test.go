@@ -11,7 +11,6 @@ import (
 
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,7 +44,7 @@ func TestProvider(t *testing.T) {
 var clientLock sync.Mutex
 
 // useClient swaps out the client on the global instance for the duration of the given func
-func useClient(client papi.PAPI, f func()) {
+func useClient(client PAPI, f func()) {
 	clientLock.Lock()
 	orig := inst.client
 	inst.client = client
@@ -58,6 +57,17 @@ func useClient(client papi.PAPI, f func()) {
 	f()
 }
 
+// useHapiClient swaps out the HAPI client on the global instance for the duration of the given func.
+// It has to be called within useClient, which holds the client lock.
+func useHapiClient(client HAPI, f func()) {
+	orig := inst.hapiClient
+	inst.hapiClient = client
+	defer func() {
+		inst.hapiClient = orig
+	}()
+	f()
+}
+
 // TODO marks a test as being in a "pending" state and logs a message telling the user why. Such tests are expected to
 // fail for the time being and may exist for the sake of unfinished/future features or to document known buggy cases
 // that won't be fixed right away. The failure of a pending test is not considered an error and the test will therefore
@@ -5,14 +5,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/hapi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
 )
@@ -21,10 +26,16 @@ func resourceSecureEdgeHostName() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSecureEdgeHostNameCreate,
 		ReadContext:   resourceSecureEdgeHostNameRead,
+		UpdateContext: resourceSecureEdgeHostNameUpdate,
 		DeleteContext: resourceSecureEdgeHostNameDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSecureEdgeHostNameImport,
 		},
+		CustomizeDiff: customdiff.ForceNewIfChange("ip_behavior", func(_ context.Context, old, new, _ interface{}) bool {
+			// only IPV4 and IPV6_COMPLIANCE edge hostnames can be switched in place
+			return !strings.EqualFold(old.(string), new.(string)) &&
+				(strings.EqualFold(old.(string), papi.EHIPVersionV6Performance) || strings.EqualFold(new.(string), papi.EHIPVersionV6Performance))
+		}),
 		Schema: akamaiSecureEdgeHostNameSchema,
 	}
 }
@@ -83,7 +94,9 @@ var akamaiSecureEdgeHostNameSchema = map[string]*schema.Schema{
 	"ip_behavior": {
 		Type:     schema.TypeString,
 		Required: true,
-		ForceNew: true,
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
 		ValidateDiagFunc: func(val interface{}, path cty.Path) diag.Diagnostics {
 			v := val.(string)
 			key := path[len(path)-1].(cty.GetAttrStep).Name
@@ -97,7 +110,12 @@ var akamaiSecureEdgeHostNameSchema = map[string]*schema.Schema{
 	"certificate": {
 		Type:     schema.TypeInt,
 		Optional: true,
-		ForceNew: true,
+	},
+	"ttl": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The time to live of the edge hostname DNS record, in seconds. The default TTL is kept when not set",
 	},
 	"use_cases": {
 		Type:             schema.TypeString,
@@ -106,6 +124,11 @@ var akamaiSecureEdgeHostNameSchema = map[string]*schema.Schema{
 		DiffSuppressFunc: suppressEdgeHostnameUseCases,
 		Description:      "A JSON encoded list of use cases",
 	},
+	"delete_on_destroy": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether to delete the edge hostname through HAPI on destroy, instead of only removing it from the state",
+	},
 }
 
 func resourceSecureEdgeHostNameCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -243,16 +266,147 @@ func resourceSecureEdgeHostNameCreate(ctx context.Context, d *schema.ResourceDat
 	return resourceSecureEdgeHostNameRead(ctx, d, meta)
 }
 
-func resourceSecureEdgeHostNameDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// resourceSecureEdgeHostNameUpdate changes the IP version behavior, TTL and certificate of the edge hostname in place through HAPI.
+// The change is applied asynchronously by HAPI. delete_on_destroy only changes the state
+func resourceSecureEdgeHostNameUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourceSecureEdgeHostNameUpdate")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	var operations []EdgeHostnamePatch
+	if d.HasChange("ip_behavior") {
+		operations = append(operations, EdgeHostnamePatch{
+			Op:    "replace",
+			Path:  EdgeHostnamePathIPVersionBehavior,
+			Value: strings.ToUpper(d.Get("ip_behavior").(string)),
+		})
+	}
+	if d.HasChange("ttl") {
+		if ttl := d.Get("ttl").(int); ttl > 0 {
+			operations = append(operations, EdgeHostnamePatch{
+				Op:    "replace",
+				Path:  EdgeHostnamePathTTL,
+				Value: strconv.Itoa(ttl),
+			})
+		}
+	}
+	if d.HasChange("certificate") {
+		certificate := d.Get("certificate").(int)
+		if certificate == 0 {
+			d.Partial(true)
+			return diag.Errorf("the certificate cannot be removed from an edge hostname, only replaced by another one")
+		}
+		operations = append(operations, EdgeHostnamePatch{
+			Op:    "replace",
+			Path:  EdgeHostnamePathSlotNumber,
+			Value: strconv.Itoa(certificate),
+		})
+	}
+
+	if len(operations) > 0 {
+		edgeHostname, err := tools.GetStringValue("edge_hostname", d)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		recordName, dnsZone, err := splitEdgeHostname(edgeHostname)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		logger.Debugf("Updating edge hostname %s: %#v", edgeHostname, operations)
+		res, err := inst.HapiClient(meta).PatchEdgeHostname(ctx, PatchEdgeHostnameRequest{
+			DNSZone:    dnsZone,
+			RecordName: recordName,
+			Operations: operations,
+		})
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		logger.Debugf("Edge hostname update submitted: change %d, status %s", res.ChangeID, res.Status)
+	}
+
+	d.Partial(false)
+	return resourceSecureEdgeHostNameRead(ctx, d, m)
+}
+
+func resourceSecureEdgeHostNameDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := akamai.Meta(m)
 	logger := meta.Log("PAPI", "resourceSecureEdgeHostNameDelete")
-	logger.Debug("DELETING")
-	logger.Info("PAPI does not support edge hostname deletion - resource will only be removed from state")
+
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	deleteOnDestroy, err := tools.GetBoolValue("delete_on_destroy", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if !deleteOnDestroy {
+		logger.Info("delete_on_destroy is not set - resource will only be removed from state")
+		d.SetId("")
+		return nil
+	}
+
+	client := inst.Client(meta)
+	hapiClient := inst.HapiClient(meta)
+
+	edgeHostname, err := tools.GetStringValue("edge_hostname", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	recordName, dnsZone, err := splitEdgeHostname(edgeHostname)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	logger.Debugf("Searching for properties using edge hostname %s", edgeHostname)
+	properties, err := client.SearchProperties(ctx, papi.SearchRequest{
+		Key:   papi.SearchKeyEdgeHostname,
+		Value: edgeHostname,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(properties.Versions.Items) > 0 {
+		var usages []string
+		for _, item := range properties.Versions.Items {
+			usages = append(usages, fmt.Sprintf("%s (%s) version %d", item.PropertyName, item.PropertyID, item.PropertyVersion))
+		}
+		return diag.Errorf("edge hostname %s cannot be deleted, it is still used by properties: %s", edgeHostname, strings.Join(usages, ", "))
+	}
+
+	logger.Debugf("Deleting edge hostname %s from zone %s", recordName, dnsZone)
+	res, err := hapiClient.DeleteEdgeHostname(ctx, hapi.DeleteEdgeHostnameRequest{
+		DNSZone:    dnsZone,
+		RecordName: recordName,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logger.Debugf("Edge hostname deletion submitted: change %d, status %s", res.ChangeID, res.Status)
+
 	d.SetId("")
-	logger.Debugf("DONE")
 	return nil
 }
 
+// splitEdgeHostname splits the edge hostname into the record name and the DNS zone it belongs to
+func splitEdgeHostname(edgeHostname string) (string, string, error) {
+	for _, zone := range []string{"edgesuite.net", "edgekey.net", "akamaized.net"} {
+		if strings.HasSuffix(edgeHostname, "."+zone) {
+			return strings.TrimSuffix(edgeHostname, "."+zone), zone, nil
+		}
+	}
+	return "", "", fmt.Errorf("edge hostname %s does not belong to any of the supported zones: edgesuite.net, edgekey.net, akamaized.net", edgeHostname)
+}
+
 func resourceSecureEdgeHostNameImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	meta := akamai.Meta(m)
 	client := inst.Client(meta)
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/hapi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 )
 
@@ -513,6 +514,7 @@ func TestResourceEdgeHostname(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			client := &mockpapi{}
+			hapiClient := &mockhapi{}
 			test.init(client)
 			var checkFuncs []resource.TestCheckFunc
 			for k, v := range test.expectedAttributes {
@@ -522,20 +524,259 @@ func TestResourceEdgeHostname(t *testing.T) {
 				checkFuncs = append(checkFuncs, resource.TestCheckOutput(k, v))
 			}
 			useClient(client, func() {
+				useHapiClient(hapiClient, func() {
+					resource.UnitTest(t, resource.TestCase{
+						Providers: testAccProviders,
+						Steps: []resource.TestStep{
+							{
+								Config:      loadFixtureString(fmt.Sprintf("%s/%s", testDir, test.givenTF)),
+								Check:       resource.ComposeAggregateTestCheckFunc(checkFuncs...),
+								ExpectError: test.withError,
+							},
+						},
+					})
+				})
+			})
+			client.AssertExpectations(t)
+			hapiClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResourceEdgeHostnameDelete(t *testing.T) {
+	expectGetEdgeHostnames := func(m *mockpapi) *mock.Call {
+		return m.On("GetEdgeHostnames", mock.Anything, papi.GetEdgeHostnamesRequest{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+		}).Return(&papi.GetEdgeHostnamesResponse{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+			EdgeHostnames: papi.EdgeHostnameItems{Items: []papi.EdgeHostnameGetItem{
+				{
+					ID:           "eh_123",
+					Domain:       "test.akamaized.net",
+					ProductID:    "prd_2",
+					DomainPrefix: "test",
+					DomainSuffix: "akamaized.net",
+				},
+			}},
+		}, nil)
+	}
+
+	t.Run("edge hostname deleted", func(t *testing.T) {
+		client := &mockpapi{}
+		hapiClient := &mockhapi{}
+		expectGetEdgeHostnames(client)
+		expectDeleteEdgeHostname(client, hapiClient, hapi.DeleteEdgeHostnameRequest{DNSZone: "akamaized.net", RecordName: "test"})
+
+		useClient(client, func() {
+			useHapiClient(hapiClient, func() {
 				resource.UnitTest(t, resource.TestCase{
 					Providers: testAccProviders,
 					Steps: []resource.TestStep{
 						{
-							Config:      loadFixtureString(fmt.Sprintf("%s/%s", testDir, test.givenTF)),
-							Check:       resource.ComposeAggregateTestCheckFunc(checkFuncs...),
-							ExpectError: test.withError,
+							Config: loadFixtureString("testdata/TestResourceEdgeHostname/delete_on_destroy.tf"),
+							Check:  resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "delete_on_destroy", "true"),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		hapiClient.AssertExpectations(t)
+	})
+
+	t.Run("edge hostname only removed from state by default", func(t *testing.T) {
+		client := &mockpapi{}
+		hapiClient := &mockhapi{}
+		expectGetEdgeHostnames(client)
+
+		useClient(client, func() {
+			useHapiClient(hapiClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString("testdata/TestResourceEdgeHostname/new_akamaized_net.tf"),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		hapiClient.AssertNotCalled(t, "DeleteEdgeHostname", mock.Anything, mock.Anything)
+	})
+
+	t.Run("edge hostname used by a property is not deleted", func(t *testing.T) {
+		client := &mockpapi{}
+		hapiClient := &mockhapi{}
+		expectGetEdgeHostnames(client)
+		client.On("SearchProperties", mock.Anything, papi.SearchRequest{
+			Key:   papi.SearchKeyEdgeHostname,
+			Value: "test.akamaized.net",
+		}).Return(&papi.SearchResponse{Versions: papi.SearchItems{Items: []papi.SearchItem{
+			{PropertyID: "prp_1", PropertyName: "property 1", PropertyVersion: 3},
+		}}}, nil).Once()
+		expectDeleteEdgeHostname(client, hapiClient, hapi.DeleteEdgeHostnameRequest{DNSZone: "akamaized.net", RecordName: "test"})
+
+		useClient(client, func() {
+			useHapiClient(hapiClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString("testdata/TestResourceEdgeHostname/delete_on_destroy.tf"),
+						},
+						{
+							Config:      loadFixtureString("testdata/TestResourceEdgeHostname/delete_on_destroy.tf"),
+							Destroy:     true,
+							ExpectError: regexp.MustCompile(`edge hostname test.akamaized.net cannot be deleted, it is still used by properties: property 1 \(prp_1\) version 3`),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		hapiClient.AssertExpectations(t)
+	})
+
+	t.Run("error deleting edge hostname", func(t *testing.T) {
+		client := &mockpapi{}
+		hapiClient := &mockhapi{}
+		expectGetEdgeHostnames(client)
+		client.On("SearchProperties", mock.Anything, papi.SearchRequest{
+			Key:   papi.SearchKeyEdgeHostname,
+			Value: "test.akamaized.net",
+		}).Return(&papi.SearchResponse{}, nil)
+		hapiClient.On("DeleteEdgeHostname", mock.Anything, hapi.DeleteEdgeHostnameRequest{
+			DNSZone:    "akamaized.net",
+			RecordName: "test",
+		}).Return(nil, fmt.Errorf("oops")).Once()
+		hapiClient.On("DeleteEdgeHostname", mock.Anything, hapi.DeleteEdgeHostnameRequest{
+			DNSZone:    "akamaized.net",
+			RecordName: "test",
+		}).Return(&hapi.DeleteEdgeHostnameResponse{ChangeID: 1, Status: "PENDING"}, nil).Once()
+
+		useClient(client, func() {
+			useHapiClient(hapiClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString("testdata/TestResourceEdgeHostname/delete_on_destroy.tf"),
+						},
+						{
+							Config:      loadFixtureString("testdata/TestResourceEdgeHostname/delete_on_destroy.tf"),
+							Destroy:     true,
+							ExpectError: regexp.MustCompile("oops"),
 						},
 					},
 				})
 			})
-			client.AssertExpectations(t)
 		})
+		client.AssertExpectations(t)
+		hapiClient.AssertExpectations(t)
+	})
+}
+
+func TestResourceEdgeHostnameUpdate(t *testing.T) {
+	expectGetEdgeHostnames := func(m *mockpapi) *mock.Call {
+		return m.On("GetEdgeHostnames", mock.Anything, papi.GetEdgeHostnamesRequest{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+		}).Return(&papi.GetEdgeHostnamesResponse{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+			EdgeHostnames: papi.EdgeHostnameItems{Items: []papi.EdgeHostnameGetItem{
+				{
+					ID:           "eh_123",
+					Domain:       "test.akamaized.net",
+					ProductID:    "prd_2",
+					DomainPrefix: "test",
+					DomainSuffix: "akamaized.net",
+				},
+			}},
+		}, nil)
 	}
+
+	t.Run("ip_behavior, ttl and certificate are updated in place", func(t *testing.T) {
+		client := &mockpapi{}
+		hapiClient := &mockhapi{}
+		expectGetEdgeHostnames(client)
+		hapiClient.On("PatchEdgeHostname", mock.Anything, PatchEdgeHostnameRequest{
+			DNSZone:    "akamaized.net",
+			RecordName: "test",
+			Operations: []EdgeHostnamePatch{
+				{Op: "replace", Path: "/ipVersionBehavior", Value: "IPV6_COMPLIANCE"},
+				{Op: "replace", Path: "/ttl", Value: "300"},
+				{Op: "replace", Path: "/slotNumber", Value: "456"},
+			},
+		}).Return(&EdgeHostnameChange{ChangeID: 1, Status: "PENDING"}, nil).Once()
+
+		useClient(client, func() {
+			useHapiClient(hapiClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString("testdata/TestResourceEdgeHostname/update_create.tf"),
+							Check:  resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "id", "eh_123"),
+						},
+						{
+							Config: loadFixtureString("testdata/TestResourceEdgeHostname/update.tf"),
+							Check: resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "id", "eh_123"),
+								resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "ip_behavior", "IPV6_COMPLIANCE"),
+								resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "ttl", "300"),
+								resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "certificate", "456"),
+							),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		hapiClient.AssertExpectations(t)
+	})
+
+	t.Run("error updating edge hostname", func(t *testing.T) {
+		client := &mockpapi{}
+		hapiClient := &mockhapi{}
+		expectGetEdgeHostnames(client)
+		hapiClient.On("PatchEdgeHostname", mock.Anything, mock.AnythingOfType("property.PatchEdgeHostnameRequest")).
+			Return(nil, fmt.Errorf("oops")).Once()
+
+		useClient(client, func() {
+			useHapiClient(hapiClient, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config: loadFixtureString("testdata/TestResourceEdgeHostname/update_create.tf"),
+						},
+						{
+							Config:      loadFixtureString("testdata/TestResourceEdgeHostname/update.tf"),
+							ExpectError: regexp.MustCompile("oops"),
+						},
+					},
+				})
+			})
+		})
+		client.AssertExpectations(t)
+		hapiClient.AssertExpectations(t)
+	})
+}
+
+// expectDeleteEdgeHostname sets up the search for properties using the edge hostname, which finds none, and its deletion
+func expectDeleteEdgeHostname(m *mockpapi, h *mockhapi, req hapi.DeleteEdgeHostnameRequest) {
+	m.On("SearchProperties", mock.Anything, papi.SearchRequest{
+		Key:   papi.SearchKeyEdgeHostname,
+		Value: fmt.Sprintf("%s.%s", req.RecordName, req.DNSZone),
+	}).Return(&papi.SearchResponse{}, nil)
+	h.On("DeleteEdgeHostname", mock.Anything, req).Return(&hapi.DeleteEdgeHostnameResponse{
+		ChangeID: 1,
+		Status:   "PENDING",
+	}, nil)
 }
 
 func TestResourceEdgeHostnames_WithImport(t *testing.T) {
@@ -891,3 +1132,37 @@ func TestConvertingUseCases2JSON(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitEdgeHostname(t *testing.T) {
+	tests := map[string]struct {
+		edgeHostname string
+		recordName   string
+		dnsZone      string
+		withError    string
+	}{
+		"edgesuite.net":         {edgeHostname: "test.edgesuite.net", recordName: "test", dnsZone: "edgesuite.net"},
+		"edgekey.net":           {edgeHostname: "test.edgekey.net", recordName: "test", dnsZone: "edgekey.net"},
+		"akamaized.net":         {edgeHostname: "test.akamaized.net", recordName: "test", dnsZone: "akamaized.net"},
+		"record name with dots": {edgeHostname: "test.aka.edgesuite.net", recordName: "test.aka", dnsZone: "edgesuite.net"},
+		"other zone": {
+			edgeHostname: "test.example.net",
+			withError:    "edge hostname test.example.net does not belong to any of the supported zones: edgesuite.net, edgekey.net, akamaized.net",
+		},
+		"no zone": {
+			edgeHostname: "test",
+			withError:    "edge hostname test does not belong to any of the supported zones: edgesuite.net, edgekey.net, akamaized.net",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recordName, dnsZone, err := splitEdgeHostname(test.edgeHostname)
+			if test.withError != "" {
+				assert.EqualError(t, err, test.withError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.recordName, recordName)
+			assert.Equal(t, test.dnsZone, dnsZone)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
@@ -95,8 +96,11 @@ func resourceProperty() *schema.Resource {
 		DeleteContext: resourcePropertyDelete,
 		CustomizeDiff: customdiff.All(
 			rulesCustomDiff,
+			rollbackCustomDiff,
+			rulesDiffCustomDiff,
 			hostNamesCustomDiff,
 			versionsComputedValuesCustomDiff,
+			rulesDryRunCustomDiff,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePropertyImport,
@@ -255,6 +259,24 @@ func resourceProperty() *schema.Resource {
 				},
 			},
 
+			"base_version": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Property's version new versions are created from when the latest version is active. Defaults to the latest version",
+			},
+			"rollback_to_version": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Property's version to roll back to. Setting or changing it creates a new latest version cloned from the given version",
+			},
+			"validate_rules_on_plan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "When enabled, rule changes are validated by a PAPI dry run during plan and rule errors fail the plan",
+			},
+
 			// Computed
 			"latest_version": {
 				Type:        schema.TypeInt,
@@ -276,11 +298,26 @@ func resourceProperty() *schema.Resource {
 				Computed:    true,
 				Description: "Required property's version to be read",
 			},
+			"rollback_version_etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Etag of the rollback_to_version version captured at plan time, the rollback fails if the version changed since",
+			},
+			"rollback_created_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Property's version created by the most recent rollback",
+			},
 			"rule_errors": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     papiError(),
 			},
+			"rules_diff": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Semantic diff of the rule tree changes from the most recent update, one changed path per line",
+			},
 			"rule_warnings": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -399,6 +436,29 @@ func rulesCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}
 	return nil
 }
 
+// rulesDiffCustomDiff presents the changes of the rule tree as a path-level semantic diff in rules_diff,
+// so that the plan can be reviewed without comparing the whole rules JSON
+func rulesDiffCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("rules") || !diff.NewValueKnown("rules") {
+		return nil
+	}
+
+	o, n := diff.GetChange("rules")
+	oldValue, newValue := o.(string), n.(string)
+	if oldValue == "" || newValue == "" || compareRulesJSON(oldValue, newValue) {
+		return nil
+	}
+
+	rulesDiff, err := diffRulesJSON(oldValue, newValue)
+	if err != nil {
+		return err
+	}
+	if err := diff.SetNew("rules_diff", strings.Join(rulesDiff, "\n")); err != nil {
+		return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	return nil
+}
+
 // unifyRulesDiff is invoked on first planning for property creation
 // Its main purpose is to unify the rules JSON with what we expect will be created by PAPI
 // It is used in order to prevent diffs on output on subsequent terraform applies
@@ -460,7 +520,8 @@ func versionsComputedValuesCustomDiff(_ context.Context, d *schema.ResourceDiff,
 	o, n := d.GetChange("hostnames")
 	oldSet := o.(*schema.Set)
 	equal := oldSet.HashEqual(n.(*schema.Set))
-	if !equal || !compareRulesJSON(oldRules.(string), newRules.(string)) {
+	rollback := d.HasChange("rollback_to_version") && d.Get("rollback_to_version").(int) != 0
+	if !equal || !compareRulesJSON(oldRules.(string), newRules.(string)) || rollback {
 		// These computed attributes can be changed on server through other clients and the state needs to be synced to local
 		for _, key := range []string{"latest_version", "staging_version", "production_version"} {
 			err := d.SetNewComputed(key)
@@ -474,6 +535,130 @@ func versionsComputedValuesCustomDiff(_ context.Context, d *schema.ResourceDiff,
 
 	return nil
 }
+
+// rollbackCustomDiff captures the etag of the rollback_to_version version when the rollback is planned, so the rollback
+// fails on apply if that version changed since the plan.
+//
+// Read stores the rules of the restored version, so while the version created by the rollback is the latest version,
+// the rules diff against the config is suppressed instead of undoing the rollback on the next apply. Removing
+// rollback_to_version makes the config rules apply again.
+func rollbackCustomDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "rollbackCustomDiff")
+	client := inst.Client(meta)
+	ctx = log.NewContext(ctx, logger)
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	RollbackVersion := d.Get("rollback_to_version").(int)
+	if RollbackVersion == 0 {
+		return nil
+	}
+
+	if d.HasChange("rollback_to_version") {
+		ContractID := tools.AddPrefix(d.Get("contract_id").(string), "ctr_")
+		GroupID := tools.AddPrefix(d.Get("group_id").(string), "grp_")
+
+		res, err := fetchPropertyVersion(ctx, client, d.Id(), GroupID, ContractID, RollbackVersion)
+		if err != nil {
+			return err
+		}
+
+		if err := d.SetNew("rollback_version_etag", res.Version.Etag); err != nil {
+			return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+		}
+		if err := d.SetNewComputed("rollback_created_version"); err != nil {
+			return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+		}
+		return nil
+	}
+
+	LatestVersion, _ := d.GetChange("latest_version")
+	CreatedVersion := d.Get("rollback_created_version").(int)
+	if CreatedVersion == 0 || CreatedVersion != LatestVersion.(int) || !d.HasChange("rules") {
+		return nil
+	}
+
+	logger.Warnf("rules differ from version %d restored by rolling back to version %d, the rules change is ignored until rollback_to_version is removed", CreatedVersion, RollbackVersion)
+	if err := d.Clear("rules"); err != nil {
+		return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+
+	return nil
+}
+
+// rulesDryRunCustomDiff validates changed rules of an existing property with a PAPI dry run when validate_rules_on_plan is enabled,
+// so rule errors fail the plan instead of leaving a new version with rule_errors behind on apply.
+//
+// The dry run runs against the version Update starts from: read_version if it is set, the latest version otherwise.
+// When that version is active, Update writes the rules to a new version created from it (or from base_version or
+// rollback_to_version), which does not exist yet at plan time, so the rules are validated against its source instead.
+func rulesDryRunCustomDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "rulesDryRunCustomDiff")
+	client := inst.Client(meta)
+	ctx = log.NewContext(ctx, logger)
+
+	if !d.Get("validate_rules_on_plan").(bool) || !(d.HasChange("rules") || d.HasChange("rule_format")) || !d.NewValueKnown("rules") {
+		return nil
+	}
+
+	// There is no property version to validate the rules against before the property is created
+	if d.Id() == "" {
+		logger.Debug("property not created yet, skipping rules dry run")
+		return nil
+	}
+
+	RulesJSON := d.Get("rules").(string)
+	if RulesJSON == "" {
+		return nil
+	}
+
+	var Rules papi.RulesUpdate
+	if err := json.Unmarshal([]byte(RulesJSON), &Rules); err != nil {
+		return fmt.Errorf("cannot parse rules JSON from config: %s", err)
+	}
+
+	PropertyVersion, _ := d.GetChange("latest_version")
+	if v, ok := d.GetOk("read_version"); ok && v.(int) != 0 {
+		PropertyVersion = v
+	}
+
+	Property := papi.Property{
+		PropertyID:    d.Id(),
+		ContractID:    tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		GroupID:       tools.AddPrefix(d.Get("group_id").(string), "grp_"),
+		LatestVersion: PropertyVersion.(int),
+	}
+
+	if RuleFormat := d.Get("rule_format").(string); RuleFormat != "" {
+		MIME := fmt.Sprintf("application/vnd.akamai.papirules.%s+json", RuleFormat)
+		h := http.Header{"Content-Type": []string{MIME}}
+		ctx = session.ContextWithOptions(ctx, session.WithContextHeaders(h))
+	}
+
+	diags, err := dryRunPropertyRules(ctx, client, Property, Rules)
+	if err != nil {
+		return err
+	}
+	if !diags.HasError() {
+		return nil
+	}
+
+	// CustomizeDiff can only fail with a single error, so the rule errors are listed in it
+	var RuleErrors []string
+	for _, d := range diags {
+		msg := d.Summary
+		if d.Detail != "" {
+			msg = fmt.Sprintf("%s [%s]", msg, d.Detail)
+		}
+		RuleErrors = append(RuleErrors, msg)
+	}
+	return fmt.Errorf("property rules are not valid:\n  - %s", strings.Join(RuleErrors, "\n  - "))
+}
+
 func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := akamai.Meta(m)
 	logger := meta.Log("PAPI", "resourcePropertyCreate")
@@ -734,9 +919,10 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diags
 	}
 
-	// We only update if these attributes change.
-	if !d.HasChanges("hostnames", "rules", "rule_format") {
-		logger.Debug("No changes to hostnames, rules, or rule_format (no update required)")
+	// We only update if these attributes change. Removing rollback_to_version doesn't change the property.
+	rollback := d.HasChange("rollback_to_version") && d.Get("rollback_to_version").(int) != 0
+	if !d.HasChanges("hostnames", "rules", "rule_format") && !rollback {
+		logger.Debug("No changes to hostnames, rules, rule_format or rollback_to_version (no update required)")
 		return nil
 	}
 
@@ -768,31 +954,89 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	ContractID := d.Get("contract_id").(string)
 	GroupID := d.Get("group_id").(string)
 
-	var PropertyVersion int
-	if v, ok := d.GetOk("read_version"); ok && v.(int) != 0 {
-		PropertyVersion = v.(int)
-	} else {
-		PropertyVersion = Property.LatestVersion
-	}
+	// Validate the rules again before any version is created, so each rule error is reported as its own diagnostic.
+	// The plan can only report them together and skips the validation when the rules are not known yet
+	if d.Get("validate_rules_on_plan").(bool) && d.HasChanges("rules", "rule_format") && d.Get("rules").(string) != "" {
+		var Rules papi.RulesUpdate
+		if err := json.Unmarshal([]byte(d.Get("rules").(string)), &Rules); err != nil {
+			d.Partial(true)
+			return diag.Errorf("rules are not valid JSON: %s", err)
+		}
 
-	resp, err := fetchPropertyVersion(ctx, client, PropertyID, GroupID, ContractID, PropertyVersion)
-	if err != nil {
-		d.Partial(true)
-		return diag.FromErr(err)
+		DryRunProperty := Property
+		if v, ok := d.GetOk("read_version"); ok && v.(int) != 0 {
+			DryRunProperty.LatestVersion = v.(int)
+		} else {
+			LatestVersion, _ := d.GetChange("latest_version")
+			DryRunProperty.LatestVersion = LatestVersion.(int)
+		}
+
+		DryRunCtx := ctx
+		if RuleFormat := d.Get("rule_format").(string); RuleFormat != "" {
+			MIME := fmt.Sprintf("application/vnd.akamai.papirules.%s+json", RuleFormat)
+			h := http.Header{"Content-Type": []string{MIME}}
+			DryRunCtx = session.ContextWithOptions(ctx, session.WithContextHeaders(h))
+		}
+		diags, err := dryRunPropertyRules(DryRunCtx, client, DryRunProperty, Rules)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		if diags.HasError() {
+			d.Partial(true)
+			return diags
+		}
 	}
 
-	// check latest version is editable
-	if resp.Version.ProductionStatus != papi.VersionStatusInactive || resp.Version.StagingStatus != papi.VersionStatusInactive {
-		// The latest version has been activated on either production or staging, so we need to create a new version to apply changes on
-		VersionID, err := createPropertyVersion(ctx, client, Property)
+	if rollback {
+		RollbackVersion := d.Get("rollback_to_version").(int)
+
+		// Roll back by creating a new latest version cloned from the requested one, changes are applied on top of it.
+		// Read stores the rules of the restored version, so unless the config is updated to match them, the next
+		// apply writes the config rules again and undoes the rollback
+		logger.Debugf("rolling back to version %d", RollbackVersion)
+		VersionID, err := createPropertyVersionFrom(ctx, client, Property, RollbackVersion, d.Get("rollback_version_etag").(string))
 		if err != nil {
 			d.Partial(true)
 			return diag.FromErr(err)
 		}
 		Property.LatestVersion = VersionID
-		if err = d.Set("read_version", 0); err != nil {
+		if err := tools.SetAttrs(d, map[string]interface{}{"read_version": 0, "rollback_created_version": VersionID}); err != nil {
 			return diag.FromErr(err)
 		}
+	} else {
+		var PropertyVersion int
+		if v, ok := d.GetOk("read_version"); ok && v.(int) != 0 {
+			PropertyVersion = v.(int)
+		} else {
+			PropertyVersion = Property.LatestVersion
+		}
+
+		resp, err := fetchPropertyVersion(ctx, client, PropertyID, GroupID, ContractID, PropertyVersion)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		// check latest version is editable
+		if resp.Version.ProductionStatus != papi.VersionStatusInactive || resp.Version.StagingStatus != papi.VersionStatusInactive {
+			// The latest version has been activated on either production or staging, so we need to create a new version to apply changes on.
+			// base_version only matters here, an editable latest version is always changed in place
+			var VersionID int
+			if BaseVersion, ok := d.GetOk("base_version"); ok {
+				VersionID, err = createPropertyVersionFrom(ctx, client, Property, BaseVersion.(int), "")
+			} else {
+				VersionID, err = createPropertyVersion(ctx, client, Property)
+			}
+			if err != nil {
+				d.Partial(true)
+				return diag.FromErr(err)
+			}
+			Property.LatestVersion = VersionID
+			if err = d.Set("read_version", 0); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	// Hostnames
@@ -1270,17 +1514,98 @@ func updatePropertyRules(ctx context.Context, client papi.PAPI, Property papi.Pr
 	return nil
 }
 
+// Validate rules against the latest version of the given property without saving them. Rule warnings are logged and
+// each rule error is returned as its own diagnostic. The error is only returned when the rules could not be validated
+func dryRunPropertyRules(ctx context.Context, client PAPI, Property papi.Property, Rules papi.RulesUpdate) (diag.Diagnostics, error) {
+	req := papi.UpdateRulesRequest{
+		PropertyID:      Property.PropertyID,
+		GroupID:         Property.GroupID,
+		ContractID:      Property.ContractID,
+		PropertyVersion: Property.LatestVersion,
+		Rules:           Rules,
+		ValidateRules:   true,
+		ValidateMode:    papi.RuleValidateModeFull,
+		DryRun:          true,
+	}
+
+	logger := log.FromContext(ctx).WithFields(logFields(req))
+
+	logger.Debug("validating property rules")
+	res, err := client.DryRunRuleTree(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not validate property rules")
+		return nil, err
+	}
+
+	if len(res.Warnings) > 0 {
+		msg, err := json.MarshalIndent(papiErrorsToList(res.Warnings), "", "\t")
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling API warnings: %s", err)
+		}
+		logger.Warnf("property rules have warnings %s", msg)
+	}
+
+	if len(res.Errors) == 0 {
+		logger.Debug("property rules are valid")
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	for _, ruleErr := range res.Errors {
+		msg := ruleErr.Title
+		if ruleErr.Detail != "" {
+			msg = fmt.Sprintf("%s: %s", msg, ruleErr.Detail)
+		}
+		if ruleErr.BehaviorName != "" {
+			msg = fmt.Sprintf("%s (behavior %q)", msg, ruleErr.BehaviorName)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  msg,
+			Detail:   ruleErr.Instance,
+		})
+	}
+
+	logger.Errorf("property rules are not valid: %d error(s)", len(diags))
+	return diags, nil
+}
+
 // Create a new property version based on the latest version of the given property
 func createPropertyVersion(ctx context.Context, client papi.PAPI, Property papi.Property) (NewVersion int, err error) {
-	req := papi.CreatePropertyVersionRequest{
+	return createPropertyVersionRequest(ctx, client, papi.CreatePropertyVersionRequest{
 		PropertyID: Property.PropertyID,
 		ContractID: Property.ContractID,
 		GroupID:    Property.GroupID,
 		Version: papi.PropertyVersionCreate{
 			CreateFromVersion: Property.LatestVersion,
 		},
+	})
+}
+
+// Create a new property version based on the given version of the property. The etag of the version is passed along,
+// so PAPI refuses to create the new version if the given version has been changed since the etag was read. When no
+// etag is given, it is fetched right before the version is created
+func createPropertyVersionFrom(ctx context.Context, client papi.PAPI, Property papi.Property, FromVersion int, Etag string) (NewVersion int, err error) {
+	if Etag == "" {
+		res, err := fetchPropertyVersion(ctx, client, Property.PropertyID, Property.GroupID, Property.ContractID, FromVersion)
+		if err != nil {
+			return 0, err
+		}
+		Etag = res.Version.Etag
 	}
 
+	return createPropertyVersionRequest(ctx, client, papi.CreatePropertyVersionRequest{
+		PropertyID: Property.PropertyID,
+		ContractID: Property.ContractID,
+		GroupID:    Property.GroupID,
+		Version: papi.PropertyVersionCreate{
+			CreateFromVersion:     FromVersion,
+			CreateFromVersionEtag: Etag,
+		},
+	})
+}
+
+func createPropertyVersionRequest(ctx context.Context, client papi.PAPI, req papi.CreatePropertyVersionRequest) (NewVersion int, err error) {
 	logger := log.FromContext(ctx).WithFields(logFields(req))
 
 	logger.Debug("creating new property version")
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
@@ -115,6 +116,71 @@ var akamaiPropertyActivationSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "assigns a log message to the activation request",
 	},
+	"acknowledge_warnings": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "message IDs of the activation warnings to acknowledge. When set, only these warnings are acknowledged instead of all of them",
+	},
+	"use_fast_fallback": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "activates the given version with fast fallback. Use it within the fallback window to quickly roll back to the previously active version",
+	},
+	"can_fast_fallback": {
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "whether it is possible to fast fallback from the activation",
+	},
+	"fallback_version": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "the version a fast fallback would activate",
+	},
+	"fast_fallback_expiration_time": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "the time (in seconds since the Unix epoch) until which a fast fallback is possible",
+	},
+	"compliance_record": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "records the change management compliance of the activation",
+		Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"noncompliance_reason": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(NoncomplianceReasons, false)),
+				Description:      "the reason the activation does not comply with change management, or NONE",
+			},
+			"other_noncompliance_reason": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "describes the reason when noncompliance_reason is OTHER",
+			},
+			"ticket_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "the ticket tracking the change",
+			},
+			"peer_reviewed_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "the email of the person who reviewed the change",
+			},
+			"customer_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "the email of the customer who requested the change",
+			},
+			"unit_tested": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "whether the change was tested",
+			},
+		}},
+	},
 }
 
 func papiError() *schema.Resource {
@@ -163,8 +229,7 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	// Schema guarantees these types
-	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
+	acknowledgeRuleWarnings, acknowledgeWarnings := activationWarningsAcknowledgement(d)
 
 	// check to see if this tree has any issues
 	rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
@@ -231,7 +296,7 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 			return diag.FromErr(err)
 		}
 
-		create, err := client.CreateActivation(ctx, papi.CreateActivationRequest{
+		create, err := createPropertyActivation(ctx, client, d, papi.CreateActivationRequest{
 			PropertyID: propertyID,
 			Activation: papi.Activation{
 				ActivationType:         papi.ActivationTypeActivate,
@@ -239,7 +304,9 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 				PropertyVersion:        version,
 				NotifyEmails:           notify,
 				AcknowledgeAllWarnings: acknowledgeRuleWarnings,
+				AcknowledgeWarnings:    acknowledgeWarnings,
 				Note:                   note,
+				UseFastFallback:        d.Get("use_fast_fallback").(bool),
 			},
 		})
 		if err != nil {
@@ -305,6 +372,10 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
+	if err := setActivationFallbackInfo(d, activation.FallbackInfo); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(propertyID + ":" + string(network))
 
 	if err := d.Set("version", version); err != nil {
@@ -451,6 +522,67 @@ func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// activationWarningsAcknowledgement returns whether all activation warnings are acknowledged and which warnings are acknowledged
+// explicitly. Warnings listed in acknowledge_warnings take precedence over auto_acknowledge_rule_warnings
+func activationWarningsAcknowledgement(d *schema.ResourceData) (bool, []string) {
+	// Schema guarantees these types
+	var acknowledgeWarnings []string
+	for _, id := range d.Get("acknowledge_warnings").(*schema.Set).List() {
+		acknowledgeWarnings = append(acknowledgeWarnings, id.(string))
+	}
+	if len(acknowledgeWarnings) > 0 {
+		return false, acknowledgeWarnings
+	}
+	return d.Get("auto_acknowledge_rule_warnings").(bool), nil
+}
+
+// complianceRecord returns the compliance record of the activation, or nil when compliance_record is not set
+func complianceRecord(d *schema.ResourceData) *ComplianceRecord {
+	// Schema guarantees these types
+	records := d.Get("compliance_record").([]interface{})
+	if len(records) == 0 || records[0] == nil {
+		return nil
+	}
+	record := records[0].(map[string]interface{})
+	return &ComplianceRecord{
+		NoncomplianceReason:      record["noncompliance_reason"].(string),
+		OtherNoncomplianceReason: record["other_noncompliance_reason"].(string),
+		TicketID:                 record["ticket_id"].(string),
+		PeerReviewedBy:           record["peer_reviewed_by"].(string),
+		CustomerEmail:            record["customer_email"].(string),
+		UnitTested:               record["unit_tested"].(bool),
+	}
+}
+
+// createPropertyActivation creates the activation, with the compliance record when compliance_record is set
+func createPropertyActivation(ctx context.Context, client PAPI, d *schema.ResourceData, req papi.CreateActivationRequest) (*papi.CreateActivationResponse, error) {
+	if record := complianceRecord(d); record != nil {
+		return client.CreateComplianceActivation(ctx, CreateComplianceActivationRequest{
+			CreateActivationRequest: req,
+			ComplianceRecord:        record,
+		})
+	}
+	return client.CreateActivation(ctx, req)
+}
+
+// setActivationFallbackInfo stores the fast fallback details of an activation, clearing them when PAPI does not return any
+func setActivationFallbackInfo(d *schema.ResourceData, info *papi.ActivationFallbackInfo) error {
+	attrs := map[string]interface{}{
+		"can_fast_fallback":             false,
+		"fallback_version":              0,
+		"fast_fallback_expiration_time": 0,
+	}
+	if info != nil {
+		attrs["can_fast_fallback"] = info.CanFastFallback
+		attrs["fallback_version"] = info.FallbackVersion
+		attrs["fast_fallback_expiration_time"] = info.FastFallbackExpirationTime
+	}
+	if err := tools.SetAttrs(d, attrs); err != nil {
+		return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	return nil
+}
+
 func flattenErrorArray(errors []*papi.Error) string {
 	var errorStrArr = make([]string, len(errors))
 	for i, err := range errors {
@@ -524,6 +656,9 @@ func resourcePropertyActivationRead(ctx context.Context, d *schema.ResourceData,
 			if err := d.Set("activation_id", act.ActivationID); err != nil {
 				return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 			}
+			if err := setActivationFallbackInfo(d, act.FallbackInfo); err != nil {
+				return diag.FromErr(err)
+			}
 
 			break
 		}
@@ -581,8 +716,7 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	// Schema guarantees these types
-	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
+	acknowledgeRuleWarnings, acknowledgeWarnings := activationWarningsAcknowledgement(d)
 
 	// Assigns a log message to the activation request
 	note, err := tools.GetStringValue("note", d)
@@ -662,7 +796,7 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 			notify = append(notify, cast.ToString(contact))
 		}
 
-		create, err := client.CreateActivation(ctx, papi.CreateActivationRequest{
+		create, err := createPropertyActivation(ctx, client, d, papi.CreateActivationRequest{
 			PropertyID: propertyID,
 			Activation: papi.Activation{
 				ActivationType:         papi.ActivationTypeActivate,
@@ -670,7 +804,9 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 				PropertyVersion:        version,
 				NotifyEmails:           notify,
 				AcknowledgeAllWarnings: acknowledgeRuleWarnings,
+				AcknowledgeWarnings:    acknowledgeWarnings,
 				Note:                   note,
+				UseFastFallback:        d.Get("use_fast_fallback").(bool),
 			},
 		})
 		if err != nil {
@@ -744,6 +880,10 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
+	if err := setActivationFallbackInfo(d, propertyActivation.FallbackInfo); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(propertyID + ":" + string(network))
 
 	if err := d.Set("version", version); err != nil {
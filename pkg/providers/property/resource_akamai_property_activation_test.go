@@ -95,6 +95,80 @@ func TestResourcePAPIPropertyActivation(t *testing.T) {
 				},
 			},
 		},
+		"property activation with fast fallback - OK": {
+			init: func(m *mockpapi) {
+				// create
+				expectGetRuleTree(m, "prp_test", 1, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", papi.GetActivationsResponse{}, nil).Once()
+				m.On("CreateActivation", mock.Anything, papi.CreateActivationRequest{
+					PropertyID: "prp_test",
+					Activation: papi.Activation{
+						ActivationType:         papi.ActivationTypeActivate,
+						AcknowledgeAllWarnings: true,
+						PropertyVersion:        1,
+						Network:                "STAGING",
+						NotifyEmails:           []string{"user@example.com"},
+						Note:                   "property activation note for creating",
+						UseFastFallback:        true,
+					},
+				}).Return(&papi.CreateActivationResponse{ActivationID: "atv_activation1"}, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_activation1", 1, "STAGING", papi.ActivationStatusActive, nil).Once()
+				// read and delete
+				expectGetActivations(m, "prp_test", activationsResponseDeactivated, nil).Twice()
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("testdata/TestPropertyActivation/ok/resource_property_activation_fast_fallback.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "id", "prp_test:STAGING"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "use_fast_fallback", "true"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "activation_id", "atv_activation1"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "status", "ACTIVE"),
+					),
+				},
+			},
+		},
+		"property activation with compliance record - OK": {
+			init: func(m *mockpapi) {
+				// create
+				expectGetRuleTree(m, "prp_test", 1, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", papi.GetActivationsResponse{}, nil).Once()
+				m.On("CreateComplianceActivation", mock.Anything, CreateComplianceActivationRequest{
+					CreateActivationRequest: papi.CreateActivationRequest{
+						PropertyID: "prp_test",
+						Activation: papi.Activation{
+							ActivationType:         papi.ActivationTypeActivate,
+							AcknowledgeAllWarnings: true,
+							PropertyVersion:        1,
+							Network:                "STAGING",
+							NotifyEmails:           []string{"user@example.com"},
+							Note:                   "property activation note for creating",
+						},
+					},
+					ComplianceRecord: &ComplianceRecord{
+						NoncomplianceReason: NoncomplianceReasonNone,
+						TicketID:            "JIRA-1234",
+						PeerReviewedBy:      "reviewer@example.com",
+						UnitTested:          true,
+					},
+				}).Return(&papi.CreateActivationResponse{ActivationID: "atv_activation1"}, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_activation1", 1, "STAGING", papi.ActivationStatusActive, nil).Once()
+				// read and delete
+				expectGetActivations(m, "prp_test", activationsResponseDeactivated, nil).Twice()
+			},
+			steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("testdata/TestPropertyActivation/ok/resource_property_activation_compliance_record.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "id", "prp_test:STAGING"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "compliance_record.0.noncompliance_reason", "NONE"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "compliance_record.0.ticket_id", "JIRA-1234"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "activation_id", "atv_activation1"),
+						resource.TestCheckResourceAttr("akamai_property_activation.test", "status", "ACTIVE"),
+					),
+				},
+			},
+		},
 		"schema with `property` instead of `property_id` - OK": {
 			init: func(m *mockpapi) {
 				// create
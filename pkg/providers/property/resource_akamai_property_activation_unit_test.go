@@ -291,3 +291,74 @@ func TestLookupActivation(t *testing.T) {
 		})
 	}
 }
+
+func TestSetActivationFallbackInfo(t *testing.T) {
+	tests := map[string]struct {
+		info     *papi.ActivationFallbackInfo
+		expected map[string]interface{}
+	}{
+		"fallback info returned": {
+			info: &papi.ActivationFallbackInfo{
+				CanFastFallback:            true,
+				FallbackVersion:            2,
+				FastFallbackExpirationTime: 1645000000,
+			},
+			expected: map[string]interface{}{
+				"can_fast_fallback":             true,
+				"fallback_version":              2,
+				"fast_fallback_expiration_time": 1645000000,
+			},
+		},
+		"no fallback info": {
+			expected: map[string]interface{}{
+				"can_fast_fallback":             false,
+				"fallback_version":              0,
+				"fast_fallback_expiration_time": 0,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, map[string]interface{}{})
+			require.NoError(t, setActivationFallbackInfo(d, test.info))
+			for attr, value := range test.expected {
+				assert.Equal(t, value, d.Get(attr), attr)
+			}
+		})
+	}
+}
+
+func TestActivationWarningsAcknowledgement(t *testing.T) {
+	tests := map[string]struct {
+		raw          map[string]interface{}
+		expectAll    bool
+		expectListed []string
+	}{
+		"all warnings acknowledged by default": {
+			raw:       map[string]interface{}{},
+			expectAll: true,
+		},
+		"auto acknowledgement disabled": {
+			raw:       map[string]interface{}{"auto_acknowledge_rule_warnings": false},
+			expectAll: false,
+		},
+		"listed warnings take precedence": {
+			raw: map[string]interface{}{
+				"auto_acknowledge_rule_warnings": true,
+				"acknowledge_warnings":           []interface{}{"msg_1"},
+			},
+			expectAll:    false,
+			expectListed: []string{"msg_1"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, test.raw)
+			all, listed := activationWarningsAcknowledgement(d)
+			assert.Equal(t, test.expectAll, all)
+			assert.Equal(t, test.expectListed, listed)
+		})
+	}
+}
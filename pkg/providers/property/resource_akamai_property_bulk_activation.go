@@ -0,0 +1,523 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spf13/cast"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func resourcePropertyBulkActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyBulkActivationCreate,
+		ReadContext:   resourcePropertyBulkActivationRead,
+		UpdateContext: resourcePropertyBulkActivationUpdate,
+		DeleteContext: resourcePropertyBulkActivationDelete,
+		Schema:        akamaiPropertyBulkActivationSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &PropertyResourceTimeout,
+		},
+	}
+}
+
+var akamaiPropertyBulkActivationSchema = map[string]*schema.Schema{
+	"property": {
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: "property versions activated together",
+		Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"property_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"version": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"activation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"errors": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}},
+	},
+	"network": {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  papi.ActivationNetworkStaging,
+	},
+	"contact": {
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"note": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "assigns a log message to the activation requests",
+	},
+	"auto_acknowledge_rule_warnings": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "automatically acknowledge all rule warnings for activations to continue. default is true",
+	},
+}
+
+// bulkActivationEntry tracks the activation of a single property version of the bulk activation
+type bulkActivationEntry struct {
+	propertyID string
+	version    int
+	activation *papi.Activation
+	err        error
+}
+
+func resourcePropertyBulkActivationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyBulkActivationCreate")
+
+	logger.Debug("resourcePropertyBulkActivationCreate call")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	return bulkActivate(ctx, d, meta, logger)
+}
+
+func resourcePropertyBulkActivationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyBulkActivationUpdate")
+
+	logger.Debug("resourcePropertyBulkActivationUpdate call")
+	if !d.HasChange("property") {
+		if d.HasChange("note") {
+			oldValue, _ := d.GetChange("note")
+			if err := d.Set("note", oldValue); err != nil {
+				return diag.FromErr(err)
+			}
+			return diag.Errorf("cannot update activation attribute note after creation")
+		}
+		return nil
+	}
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	diags := bulkActivate(ctx, d, meta, logger)
+	if diags.HasError() {
+		return diags
+	}
+
+	// properties removed from the list are deactivated once the remaining ones are active
+	dropped := droppedBulkActivationEntries(d)
+	if len(dropped) == 0 {
+		return diags
+	}
+	network, err := networkAlias(d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	settings, err := bulkActivationSettings(d, network)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return append(diags, bulkDeactivate(ctx, inst.Client(meta), dropped, settings)...)
+}
+
+// bulkActivate validates the rules of all property versions, submits their activations at once and waits until all of them finish.
+// Property versions which are already active or being activated on the network are not activated again
+func bulkActivate(ctx context.Context, d *schema.ResourceData, meta akamai.OperationMeta, logger log.Interface) diag.Diagnostics {
+	client := inst.Client(meta)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entries := bulkActivationEntries(d)
+
+	// check to see if any of the rule trees has issues, nothing is activated if it does
+	var diags diag.Diagnostics
+	for _, entry := range entries {
+		rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+			PropertyID:      entry.propertyID,
+			PropertyVersion: entry.version,
+			ValidateRules:   true,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(rules.Errors) > 0 {
+			msg, err := json.MarshalIndent(papiErrorsToList(rules.Errors), "", "\t")
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error marshaling API error: %s", err))
+			}
+			logger.Errorf("Property %s has rule errors %s", entry.propertyID, msg)
+			diags = append(diags, diag.Errorf("activation cannot continue due to rule errors in %s version %d: %s", entry.propertyID, entry.version, msg)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	settings, err := bulkActivationSettings(d, network)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, entry := range entries {
+		entry.activation, entry.err = lookupActivation(ctx, client, lookupActivationRequest{
+			propertyID: entry.propertyID,
+			version:    entry.version,
+			network:    network,
+			activationType: map[papi.ActivationType]struct{}{
+				papi.ActivationTypeActivate: {},
+			},
+		})
+		if entry.err != nil || entry.activation != nil {
+			continue
+		}
+
+		activation := settings
+		activation.ActivationType = papi.ActivationTypeActivate
+		activation.PropertyVersion = entry.version
+		entry.activation, entry.err = createActivation(ctx, client, entry.propertyID, activation)
+	}
+
+	d.SetId(bulkActivationID(entries, network))
+	diags = waitForBulkActivation(ctx, client, entries, "activation")
+	if err := setBulkActivationEntries(d, entries); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func resourcePropertyBulkActivationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyBulkActivationRead")
+	client := inst.Client(meta)
+
+	logger.Debug("resourcePropertyBulkActivationRead call")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries := bulkActivationEntries(d)
+	for _, entry := range entries {
+		activation, err := lookupNetworkActivation(ctx, client, entry.propertyID, network)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get activations for property %s: %w", entry.propertyID, err))
+		}
+		// when another version was activated or the property was deactivated outside of terraform, the version
+		// in the state is updated so that the plan shows the drift and applying activates the configured version again
+		entry.activation = nil
+		switch {
+		case activation == nil || activation.ActivationType == papi.ActivationTypeDeactivate:
+			entry.version = 0
+		default:
+			entry.version = activation.PropertyVersion
+			entry.activation = activation
+		}
+	}
+
+	if err := setBulkActivationEntries(d, entries); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePropertyBulkActivationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyBulkActivationDelete")
+	client := inst.Client(meta)
+
+	logger.Debug("resourcePropertyBulkActivationDelete call")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings, err := bulkActivationSettings(d, network)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries := bulkActivationEntries(d)
+	diags := bulkDeactivate(ctx, client, entries, settings)
+	if diags.HasError() {
+		if err := setBulkActivationEntries(d, entries); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// bulkDeactivate submits the deactivation of all property versions at once and waits until all of them finish.
+// Property versions which are already deactivated or not active at all are skipped
+func bulkDeactivate(ctx context.Context, client papi.PAPI, entries []*bulkActivationEntry, settings papi.Activation) diag.Diagnostics {
+	for _, entry := range entries {
+		if entry.version == 0 {
+			continue
+		}
+		entry.activation, entry.err = lookupActivation(ctx, client, lookupActivationRequest{
+			propertyID: entry.propertyID,
+			version:    entry.version,
+			network:    settings.Network,
+			activationType: map[papi.ActivationType]struct{}{
+				papi.ActivationTypeDeactivate: {},
+				papi.ActivationTypeActivate:   {},
+			},
+		})
+		if entry.err != nil || (entry.activation != nil && entry.activation.ActivationType == papi.ActivationTypeDeactivate) {
+			continue
+		}
+
+		deactivation := settings
+		deactivation.ActivationType = papi.ActivationTypeDeactivate
+		deactivation.PropertyVersion = entry.version
+		entry.activation, entry.err = createActivation(ctx, client, entry.propertyID, deactivation)
+	}
+
+	// deactivations also use status Active for when they are fully processed
+	return waitForBulkActivation(ctx, client, entries, "deactivation")
+}
+
+// bulkActivationSettings returns the activation attributes shared by all property versions of the bulk activation
+func bulkActivationSettings(d *schema.ResourceData, network papi.ActivationNetwork) (papi.Activation, error) {
+	notifySet, err := tools.GetSetValue("contact", d)
+	if err != nil {
+		return papi.Activation{}, err
+	}
+	var notify []string
+	for _, contact := range notifySet.List() {
+		notify = append(notify, cast.ToString(contact))
+	}
+	note, err := tools.GetStringValue("note", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return papi.Activation{}, err
+	}
+
+	return papi.Activation{
+		Network:      network,
+		NotifyEmails: notify,
+		// Schema guarantees these types
+		AcknowledgeAllWarnings: d.Get("auto_acknowledge_rule_warnings").(bool),
+		Note:                   note,
+	}, nil
+}
+
+// lookupNetworkActivation returns the most recent activation or deactivation of any version of the property on the network
+// which is either in progress or completed, or nil if there is none
+func lookupNetworkActivation(ctx context.Context, client papi.PAPI, propertyID string, network papi.ActivationNetwork) (*papi.Activation, error) {
+	activations, err := client.GetActivations(ctx, papi.GetActivationsRequest{
+		PropertyID: propertyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *papi.Activation
+	var latestSubmitDate time.Time
+	for _, a := range activations.Activations.Items {
+		if a.Network != network || a.Status == papi.ActivationStatusAborted || a.Status == papi.ActivationStatusFailed {
+			continue
+		}
+		submitDate, err := tools.ParseDate(tools.DateTimeFormat, a.SubmitDate)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil || latestSubmitDate.Before(submitDate) {
+			latest, latestSubmitDate = a, submitDate
+		}
+	}
+	return latest, nil
+}
+
+// createActivation submits the activation (or deactivation) of the property and returns it with its initial status
+func createActivation(ctx context.Context, client papi.PAPI, propertyID string, activation papi.Activation) (*papi.Activation, error) {
+	create, err := client.CreateActivation(ctx, papi.CreateActivationRequest{
+		PropertyID: propertyID,
+		Activation: activation,
+	})
+	if err != nil {
+		if activation.ActivationType == papi.ActivationTypeDeactivate {
+			return nil, fmt.Errorf("create deactivation failed: %w", err)
+		}
+		return nil, fmt.Errorf("create activation failed: %w", err)
+	}
+
+	act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+		ActivationID: create.ActivationID,
+		PropertyID:   propertyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return act.Activation, nil
+}
+
+// waitForBulkActivation polls the submitted activations together until none of them is in progress.
+// Failed activations do not stop waiting for the others and each of them is reported in the returned diagnostics
+func waitForBulkActivation(ctx context.Context, client papi.PAPI, entries []*bulkActivationEntry, operation string) diag.Diagnostics {
+	for {
+		var pending []*bulkActivationEntry
+		for _, entry := range entries {
+			if entry.err != nil || entry.activation == nil {
+				continue
+			}
+			switch entry.activation.Status {
+			case papi.ActivationStatusActive:
+			case papi.ActivationStatusAborted:
+				entry.err = fmt.Errorf("%s request aborted", operation)
+			case papi.ActivationStatusFailed:
+				entry.err = fmt.Errorf("%s request failed in downstream system", operation)
+			default:
+				pending = append(pending, entry)
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+
+		select {
+		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+			for _, entry := range pending {
+				act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+					ActivationID: entry.activation.ActivationID,
+					PropertyID:   entry.propertyID,
+				})
+				if err != nil {
+					entry.err = err
+					continue
+				}
+				entry.activation = act.Activation
+			}
+
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return diag.Diagnostics{DiagWarnActivationTimeout}
+			} else if errors.Is(ctx.Err(), context.Canceled) {
+				return diag.Diagnostics{DiagWarnActivationCanceled}
+			}
+			return diag.FromErr(fmt.Errorf("activation context terminated: %w", ctx.Err()))
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, entry := range entries {
+		if entry.err != nil {
+			diags = append(diags, diag.Errorf("%s version %d: %s", entry.propertyID, entry.version, entry.err)...)
+		}
+	}
+	return diags
+}
+
+func bulkActivationEntries(d *schema.ResourceData) []*bulkActivationEntry {
+	// Schema guarantees these types
+	properties := d.Get("property").([]interface{})
+	entries := make([]*bulkActivationEntry, 0, len(properties))
+	for _, p := range properties {
+		property := p.(map[string]interface{})
+		entries = append(entries, &bulkActivationEntry{
+			propertyID: tools.AddPrefix(property["property_id"].(string), "prp_"),
+			version:    property["version"].(int),
+		})
+	}
+	return entries
+}
+
+// droppedBulkActivationEntries returns the properties which were removed from the bulk activation, with their previously activated versions
+func droppedBulkActivationEntries(d *schema.ResourceData) []*bulkActivationEntry {
+	current := make(map[string]bool)
+	for _, entry := range bulkActivationEntries(d) {
+		current[entry.propertyID] = true
+	}
+
+	// Schema guarantees these types
+	oldProperties, _ := d.GetChange("property")
+	var dropped []*bulkActivationEntry
+	for _, p := range oldProperties.([]interface{}) {
+		property := p.(map[string]interface{})
+		propertyID := tools.AddPrefix(property["property_id"].(string), "prp_")
+		if current[propertyID] {
+			continue
+		}
+		dropped = append(dropped, &bulkActivationEntry{
+			propertyID: propertyID,
+			version:    property["version"].(int),
+		})
+	}
+	return dropped
+}
+
+func setBulkActivationEntries(d *schema.ResourceData, entries []*bulkActivationEntry) error {
+	// Schema guarantees these types
+	properties := d.Get("property").([]interface{})
+	for i, entry := range entries {
+		property := properties[i].(map[string]interface{})
+		property["version"] = entry.version
+		property["activation_id"], property["status"], property["errors"] = "", "", ""
+		if entry.activation != nil {
+			property["activation_id"] = entry.activation.ActivationID
+			property["status"] = string(entry.activation.Status)
+		}
+		if entry.err != nil {
+			property["errors"] = entry.err.Error()
+		}
+	}
+	if err := d.Set("property", properties); err != nil {
+		return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	return nil
+}
+
+func bulkActivationID(entries []*bulkActivationEntry, network papi.ActivationNetwork) string {
+	propertyIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		propertyIDs = append(propertyIDs, entry.propertyID)
+	}
+	return strings.Join(propertyIDs, ",") + ":" + string(network)
+}
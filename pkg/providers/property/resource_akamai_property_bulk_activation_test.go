@@ -0,0 +1,271 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestResourcePropertyBulkActivation(t *testing.T) {
+	bulkActivations := func(items ...*papi.Activation) papi.GetActivationsResponse {
+		return papi.GetActivationsResponse{Activations: papi.ActivationsItems{Items: items}}
+	}
+	bulkActivationItem := func(propertyID, activationID string, activationType papi.ActivationType, submitDate string) *papi.Activation {
+		return &papi.Activation{
+			ActivationID:    activationID,
+			ActivationType:  activationType,
+			PropertyID:      propertyID,
+			PropertyVersion: 1,
+			Network:         papi.ActivationNetworkStaging,
+			Status:          papi.ActivationStatusActive,
+			SubmitDate:      submitDate,
+		}
+	}
+	contact := []string{"user@example.com"}
+
+	client := &mockpapi{}
+	// create
+	expectGetRuleTree(client, "prp_1", 1, ruleTreeResponseValid, nil).Twice()
+	expectGetRuleTree(client, "prp_2", 1, ruleTreeResponseValid, nil).Once()
+	expectGetActivations(client, "prp_1", papi.GetActivationsResponse{}, nil).Once()
+	expectGetActivations(client, "prp_2", papi.GetActivationsResponse{}, nil).Once()
+	expectCreateActivation(client, "prp_1", papi.ActivationTypeActivate, 1, "STAGING", contact, "release", "atv_1", nil).Once()
+	expectCreateActivation(client, "prp_2", papi.ActivationTypeActivate, 1, "STAGING", contact, "release", "atv_2", nil).Once()
+	expectGetActivation(client, "prp_1", "atv_1", 1, "STAGING", papi.ActivationStatusActive, nil).Once()
+	expectGetActivation(client, "prp_2", "atv_2", 1, "STAGING", papi.ActivationStatusActive, nil).Once()
+	// read
+	activatedPrp1 := expectGetActivations(client, "prp_1", bulkActivations(
+		bulkActivationItem("prp_1", "atv_1", papi.ActivationTypeActivate, "2021-10-28T15:04:05Z"),
+	), nil)
+	expectGetActivations(client, "prp_2", bulkActivations(
+		bulkActivationItem("prp_2", "atv_2", papi.ActivationTypeActivate, "2021-10-28T15:04:05Z"),
+	), nil)
+	// update activates prp_3 and deactivates prp_2, which was removed from the list
+	expectGetRuleTree(client, "prp_3", 1, ruleTreeResponseValid, nil).Once()
+	expectGetActivations(client, "prp_3", papi.GetActivationsResponse{}, nil).Once()
+	expectCreateActivation(client, "prp_3", papi.ActivationTypeActivate, 1, "STAGING", contact, "release", "atv_3", nil).Once()
+	expectGetActivation(client, "prp_3", "atv_3", 1, "STAGING", papi.ActivationStatusActive, nil).Once()
+	expectCreateActivation(client, "prp_2", papi.ActivationTypeDeactivate, 1, "STAGING", contact, "release", "atv_d2", nil).Once()
+	expectGetActivation(client, "prp_2", "atv_d2", 1, "STAGING", papi.ActivationStatusActive, nil).Once()
+	expectGetActivations(client, "prp_3", bulkActivations(
+		bulkActivationItem("prp_3", "atv_3", papi.ActivationTypeActivate, "2021-10-28T15:04:05Z"),
+	), nil)
+	// destroy deactivates prp_3 only, as prp_1 was deactivated outside of terraform
+	expectCreateActivation(client, "prp_3", papi.ActivationTypeDeactivate, 1, "STAGING", contact, "release", "atv_d3", nil).Once()
+	expectGetActivation(client, "prp_3", "atv_d3", 1, "STAGING", papi.ActivationStatusActive, nil).Once()
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			Providers:  testAccProviders,
+			IsUnitTest: true,
+			Steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("testdata/TestResourcePropertyBulkActivation/create.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "id", "prp_1,prp_2:STAGING"),
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "property.0.activation_id", "atv_1"),
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "property.0.status", "ACTIVE"),
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "property.1.activation_id", "atv_2"),
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "property.1.status", "ACTIVE"),
+					),
+				},
+				{
+					Config: loadFixtureString("testdata/TestResourcePropertyBulkActivation/update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "id", "prp_1,prp_3:STAGING"),
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "property.#", "2"),
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "property.1.property_id", "prp_3"),
+						resource.TestCheckResourceAttr("akamai_property_bulk_activation.test", "property.1.activation_id", "atv_3"),
+					),
+				},
+				{
+					PreConfig: func() {
+						activatedPrp1.ReturnArguments = mock.Arguments{&papi.GetActivationsResponse{Activations: papi.ActivationsItems{Items: []*papi.Activation{
+							bulkActivationItem("prp_1", "atv_1", papi.ActivationTypeActivate, "2021-10-28T15:04:05Z"),
+							bulkActivationItem("prp_1", "atv_d1", papi.ActivationTypeDeactivate, "2021-10-29T15:04:05Z"),
+						}}}, nil}
+					},
+					Config:             loadFixtureString("testdata/TestResourcePropertyBulkActivation/update.tf"),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		})
+	})
+	client.AssertExpectations(t)
+}
+
+func TestResourcePropertyBulkActivationRuleErrors(t *testing.T) {
+	client := &mockpapi{}
+	expectGetRuleTree(client, "prp_1", 1, ruleTreeResponseValid, nil).Once()
+	expectGetRuleTree(client, "prp_2", 1, ruleTreeResponseInvalid, nil).Once()
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			Providers:  testAccProviders,
+			IsUnitTest: true,
+			Steps: []resource.TestStep{
+				{
+					Config:      loadFixtureString("testdata/TestResourcePropertyBulkActivation/create.tf"),
+					ExpectError: regexp.MustCompile("activation cannot continue due to rule errors in prp_2 version 1"),
+				},
+			},
+		})
+	})
+	client.AssertExpectations(t)
+}
+
+func TestLookupNetworkActivation(t *testing.T) {
+	activations := []*papi.Activation{
+		{ActivationID: "atv_1", ActivationType: papi.ActivationTypeActivate, PropertyVersion: 1, Network: papi.ActivationNetworkStaging,
+			Status: papi.ActivationStatusActive, SubmitDate: "2021-10-27T15:04:05Z"},
+		{ActivationID: "atv_2", ActivationType: papi.ActivationTypeActivate, PropertyVersion: 2, Network: papi.ActivationNetworkStaging,
+			Status: papi.ActivationStatusActive, SubmitDate: "2021-10-28T15:04:05Z"},
+		{ActivationID: "atv_3", ActivationType: papi.ActivationTypeActivate, PropertyVersion: 3, Network: papi.ActivationNetworkStaging,
+			Status: papi.ActivationStatusFailed, SubmitDate: "2021-10-29T15:04:05Z"},
+		{ActivationID: "atv_4", ActivationType: papi.ActivationTypeDeactivate, PropertyVersion: 1, Network: papi.ActivationNetworkProduction,
+			Status: papi.ActivationStatusActive, SubmitDate: "2021-10-30T15:04:05Z"},
+	}
+
+	tests := map[string]struct {
+		network    papi.ActivationNetwork
+		activation *papi.Activation
+	}{
+		"latest staging activation": {
+			network:    papi.ActivationNetworkStaging,
+			activation: activations[1],
+		},
+		"latest production deactivation": {
+			network:    papi.ActivationNetworkProduction,
+			activation: activations[3],
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockpapi{}
+			client.On("GetActivations", AnyCTX, papi.GetActivationsRequest{PropertyID: "prp_1"}).Return(&papi.GetActivationsResponse{
+				Activations: papi.ActivationsItems{Items: activations},
+			}, nil).Once()
+
+			activation, err := lookupNetworkActivation(context.Background(), client, "prp_1", test.network)
+			require.NoError(t, err)
+			assert.Equal(t, test.activation, activation)
+			client.AssertExpectations(t)
+		})
+	}
+
+	t.Run("no activations", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetActivations", AnyCTX, papi.GetActivationsRequest{PropertyID: "prp_1"}).Return(&papi.GetActivationsResponse{}, nil).Once()
+
+		activation, err := lookupNetworkActivation(context.Background(), client, "prp_1", papi.ActivationNetworkStaging)
+		require.NoError(t, err)
+		assert.Nil(t, activation)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestCreateActivation(t *testing.T) {
+	activation := papi.Activation{
+		ActivationType:  papi.ActivationTypeActivate,
+		Network:         papi.ActivationNetworkStaging,
+		PropertyVersion: 2,
+		NotifyEmails:    []string{"user@example.com"},
+	}
+
+	t.Run("activation created", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("CreateActivation", AnyCTX, papi.CreateActivationRequest{
+			PropertyID: "prp_1",
+			Activation: activation,
+		}).Return(&papi.CreateActivationResponse{ActivationID: "atv_1"}, nil).Once()
+		client.On("GetActivation", AnyCTX, papi.GetActivationRequest{
+			PropertyID:   "prp_1",
+			ActivationID: "atv_1",
+		}).Return(&papi.GetActivationResponse{
+			Activation: &papi.Activation{ActivationID: "atv_1", Status: papi.ActivationStatusPending},
+		}, nil).Once()
+
+		act, err := createActivation(context.Background(), client, "prp_1", activation)
+		require.NoError(t, err)
+		assert.Equal(t, "atv_1", act.ActivationID)
+		assert.Equal(t, papi.ActivationStatusPending, act.Status)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("create activation failed", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("CreateActivation", AnyCTX, papi.CreateActivationRequest{
+			PropertyID: "prp_1",
+			Activation: activation,
+		}).Return(nil, fmt.Errorf("oops")).Once()
+
+		_, err := createActivation(context.Background(), client, "prp_1", activation)
+		assert.EqualError(t, err, "create activation failed: oops")
+		client.AssertExpectations(t)
+	})
+}
+
+func TestWaitForBulkActivation(t *testing.T) {
+	t.Run("all activations finished", func(t *testing.T) {
+		client := &mockpapi{}
+		entries := []*bulkActivationEntry{
+			{propertyID: "prp_1", version: 1, activation: &papi.Activation{ActivationID: "atv_1", Status: papi.ActivationStatusActive}},
+			{propertyID: "prp_2", version: 3, activation: &papi.Activation{ActivationID: "atv_2", Status: papi.ActivationStatusActive}},
+		}
+
+		diags := waitForBulkActivation(context.Background(), client, entries, "activation")
+		assert.Empty(t, diags)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("errors reported per property", func(t *testing.T) {
+		client := &mockpapi{}
+		entries := []*bulkActivationEntry{
+			{propertyID: "prp_1", version: 1, activation: &papi.Activation{ActivationID: "atv_1", Status: papi.ActivationStatusActive}},
+			{propertyID: "prp_2", version: 3, activation: &papi.Activation{ActivationID: "atv_2", Status: papi.ActivationStatusFailed}},
+			{propertyID: "prp_3", version: 2, activation: &papi.Activation{ActivationID: "atv_3", Status: papi.ActivationStatusAborted}},
+			{propertyID: "prp_4", version: 5, err: fmt.Errorf("create activation failed: oops")},
+		}
+
+		diags := waitForBulkActivation(context.Background(), client, entries, "activation")
+		assert.Equal(t, diag.Diagnostics{
+			{Severity: diag.Error, Summary: "prp_2 version 3: activation request failed in downstream system"},
+			{Severity: diag.Error, Summary: "prp_3 version 2: activation request aborted"},
+			{Severity: diag.Error, Summary: "prp_4 version 5: create activation failed: oops"},
+		}, diags)
+		assert.NoError(t, entries[0].err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("context canceled while waiting", func(t *testing.T) {
+		client := &mockpapi{}
+		entries := []*bulkActivationEntry{
+			{propertyID: "prp_1", version: 1, activation: &papi.Activation{ActivationID: "atv_1", Status: papi.ActivationStatusPending}},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		diags := waitForBulkActivation(ctx, client, entries, "activation")
+		assert.Equal(t, diag.Diagnostics{DiagWarnActivationCanceled}, diags)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestBulkActivationID(t *testing.T) {
+	entries := []*bulkActivationEntry{
+		{propertyID: "prp_1", version: 1},
+		{propertyID: "prp_2", version: 3},
+	}
+	assert.Equal(t, "prp_1,prp_2:STAGING", bulkActivationID(entries, papi.ActivationNetworkStaging))
+}
@@ -0,0 +1,425 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+var (
+	errHostnameActivationAborted = errors.New("request aborted")
+	errHostnameActivationFailed  = errors.New("request failed in downstream system")
+)
+
+func resourcePropertyHostnameBucket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyHostnameBucketCreate,
+		ReadContext:   resourcePropertyHostnameBucketRead,
+		UpdateContext: resourcePropertyHostnameBucketUpdate,
+		DeleteContext: resourcePropertyHostnameBucketDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &PropertyResourceTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"property_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("prp_"),
+				Description: "The property the hostnames belong to",
+			},
+			"contract_id": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: addPrefixToState("ctr_"),
+			},
+			"group_id": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: addPrefixToState("grp_"),
+			},
+			"network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  papi.ActivationNetworkStaging,
+			},
+			"hostnames": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateEdgeHostnameIDs,
+				Description:      "hostnames of the property on the network, mapped to the ID of their edge hostname",
+			},
+			"cert_provisioning_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "CPS_MANAGED",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					"CPS_MANAGED", "DEFAULT",
+				}, false)),
+				Description: "certificate provisioning type of the hostnames added to the bucket",
+			},
+			"notify_emails": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "email addresses notified about the hostname activations",
+			},
+			"note": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "assigns a log message to the hostname activations",
+			},
+			"activation_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the latest hostname activation",
+			},
+			"hostname_statuses": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "activation status of each hostname on the network",
+			},
+		},
+	}
+}
+
+// validateEdgeHostnameIDs checks the hostnames are mapped to edge hostname IDs with the ehn_ prefix, as returned by PAPI
+func validateEdgeHostnameIDs(v interface{}, _ cty.Path) diag.Diagnostics {
+	for hostname, id := range v.(map[string]interface{}) {
+		if !strings.HasPrefix(cast.ToString(id), "ehn_") {
+			return diag.Errorf("edge hostname ID %q of hostname %q must have the 'ehn_' prefix", id, hostname)
+		}
+	}
+	return nil
+}
+
+func resourcePropertyHostnameBucketCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketCreate")
+	client := inst.Client(meta)
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	PropertyID, ContractID, GroupID := hostnameBucketIDs(d)
+
+	// The bucket may already have hostnames, only the differences with the configuration are applied
+	current, err := fetchHostnameBucket(ctx, client, PropertyID, ContractID, GroupID, network)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", PropertyID, network))
+	if diags := patchHostnameBucket(ctx, d, client, network, current, hostnamesMap(d.Get("hostnames"))); diags != nil {
+		return diags
+	}
+
+	return resourcePropertyHostnameBucketRead(ctx, d, m)
+}
+
+func resourcePropertyHostnameBucketRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketRead")
+	client := inst.Client(meta)
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	PropertyID, ContractID, GroupID := hostnameBucketIDs(d)
+
+	hostnames, err := fetchHostnameBucket(ctx, client, PropertyID, ContractID, GroupID, network)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statuses := make(map[string]interface{}, len(hostnames))
+	for hostname := range hostnames {
+		statuses[hostname] = string(papi.ActivationStatusActive)
+	}
+
+	// The hostnames of an activation in progress are not listed in the bucket yet, they are reported with the
+	// status of the activation so they are not planned again
+	if ActivationID := d.Get("activation_id").(string); ActivationID != "" {
+		res, err := client.GetPropertyHostnameActivation(ctx, GetPropertyHostnameActivationRequest{
+			PropertyID:           PropertyID,
+			ContractID:           ContractID,
+			GroupID:              GroupID,
+			HostnameActivationID: ActivationID,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		activation := res.HostnameActivation
+		if activation.Status != papi.ActivationStatusActive {
+			inProgress := activation.Status != papi.ActivationStatusAborted && activation.Status != papi.ActivationStatusFailed
+			for _, change := range activation.Hostnames {
+				statuses[change.CnameFrom] = string(activation.Status)
+				if !inProgress {
+					continue
+				}
+				if change.Action == HostnameActivationActionAdd {
+					hostnames[change.CnameFrom] = change.EdgeHostnameID
+				} else if change.Action == HostnameActivationActionRemove {
+					delete(hostnames, change.CnameFrom)
+				}
+			}
+		}
+	}
+
+	attrs := map[string]interface{}{
+		"hostnames":         hostnames,
+		"hostname_statuses": statuses,
+	}
+	if err := tools.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	return nil
+}
+
+func resourcePropertyHostnameBucketUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketUpdate")
+	client := inst.Client(meta)
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	// The other arguments only apply to the next hostname activation
+	if !d.HasChange("hostnames") {
+		return nil
+	}
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldHostnames, newHostnames := d.GetChange("hostnames")
+	if diags := patchHostnameBucket(ctx, d, client, network, hostnamesMap(oldHostnames), hostnamesMap(newHostnames)); diags != nil {
+		return diags
+	}
+
+	return resourcePropertyHostnameBucketRead(ctx, d, m)
+}
+
+func resourcePropertyHostnameBucketDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyHostnameBucketDelete")
+	client := inst.Client(meta)
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := patchHostnameBucket(ctx, d, client, network, hostnamesMap(d.Get("hostnames")), map[string]string{}); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// hostnameBucketIDs returns the property, contract and group IDs of the hostname bucket with their prefixes
+func hostnameBucketIDs(d *schema.ResourceData) (PropertyID, ContractID, GroupID string) {
+	return tools.AddPrefix(d.Get("property_id").(string), "prp_"),
+		tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		tools.AddPrefix(d.Get("group_id").(string), "grp_")
+}
+
+// fetchHostnameBucket returns the hostnames of the property on the network mapped to the IDs of their edge hostnames
+func fetchHostnameBucket(ctx context.Context, client PAPI, PropertyID, ContractID, GroupID string, network papi.ActivationNetwork) (map[string]string, error) {
+	hostnames := make(map[string]string)
+
+	for offset := 0; ; {
+		res, err := client.ListPropertyHostnameBucket(ctx, ListPropertyHostnameBucketRequest{
+			PropertyID: PropertyID,
+			ContractID: ContractID,
+			GroupID:    GroupID,
+			Network:    network,
+			Offset:     offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range res.Hostnames.Items {
+			hostnames[h.CnameFrom] = h.StagingEdgeHostnameID
+			if network == papi.ActivationNetworkProduction {
+				hostnames[h.CnameFrom] = h.ProductionEdgeHostnameID
+			}
+		}
+
+		offset += len(res.Hostnames.Items)
+		if len(res.Hostnames.Items) == 0 || offset >= res.Hostnames.TotalItems {
+			return hostnames, nil
+		}
+	}
+}
+
+// patchHostnameBucket adds and removes the hostnames which differ between the old and new hostnames. The changes are
+// applied in chunks of at most maxHostnameBucketChanges hostnames, waiting for the activation of each chunk
+func patchHostnameBucket(ctx context.Context, d *schema.ResourceData, client PAPI, network papi.ActivationNetwork,
+	oldHostnames, newHostnames map[string]string) diag.Diagnostics {
+	PropertyID, ContractID, GroupID := hostnameBucketIDs(d)
+
+	var add []BucketHostnameAdd
+	var remove []string
+	for _, hostname := range sortedKeys(newHostnames) {
+		if oldHostnames[hostname] == newHostnames[hostname] {
+			continue
+		}
+		add = append(add, BucketHostnameAdd{
+			CnameFrom:            hostname,
+			CnameType:            papi.HostnameCnameTypeEdgeHostname,
+			EdgeHostnameID:       newHostnames[hostname],
+			CertProvisioningType: d.Get("cert_provisioning_type").(string),
+		})
+	}
+	for _, hostname := range sortedKeys(oldHostnames) {
+		if _, ok := newHostnames[hostname]; !ok {
+			remove = append(remove, hostname)
+		}
+	}
+
+	var notify []string
+	for _, email := range d.Get("notify_emails").(*schema.Set).List() {
+		notify = append(notify, cast.ToString(email))
+	}
+
+	for len(add) > 0 || len(remove) > 0 {
+		req := PatchPropertyHostnameBucketRequest{
+			PropertyID:   PropertyID,
+			ContractID:   ContractID,
+			GroupID:      GroupID,
+			Network:      network,
+			NotifyEmails: notify,
+			Note:         d.Get("note").(string),
+		}
+		n := len(remove)
+		if n > maxHostnameBucketChanges {
+			n = maxHostnameBucketChanges
+		}
+		req.Remove, remove = remove[:n], remove[n:]
+		n = len(add)
+		if n > maxHostnameBucketChanges-len(req.Remove) {
+			n = maxHostnameBucketChanges - len(req.Remove)
+		}
+		req.Add, add = add[:n], add[n:]
+
+		res, err := client.PatchPropertyHostnameBucket(ctx, req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("activation_id", res.HostnameActivationID); err != nil {
+			return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+		}
+
+		if err := pollHostnameActivation(ctx, client, PropertyID, ContractID, GroupID, res.HostnameActivationID); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return diag.Diagnostics{DiagWarnActivationTimeout}
+			} else if errors.Is(err, context.Canceled) {
+				return diag.Diagnostics{DiagWarnActivationCanceled}
+			}
+			if errors.Is(err, errHostnameActivationAborted) || errors.Is(err, errHostnameActivationFailed) {
+				return diag.FromErr(fmt.Errorf("hostname activation %w", err))
+			}
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// pollHostnameActivation waits for the hostname activation to complete. The context error is returned when the context is done first
+func pollHostnameActivation(ctx context.Context, client PAPI, PropertyID, ContractID, GroupID, ActivationID string) error {
+	for {
+		res, err := client.GetPropertyHostnameActivation(ctx, GetPropertyHostnameActivationRequest{
+			PropertyID:           PropertyID,
+			ContractID:           ContractID,
+			GroupID:              GroupID,
+			HostnameActivationID: ActivationID,
+		})
+		if err != nil {
+			return err
+		}
+
+		switch res.HostnameActivation.Status {
+		case papi.ActivationStatusActive:
+			return nil
+		case papi.ActivationStatusAborted:
+			return errHostnameActivationAborted
+		case papi.ActivationStatusFailed:
+			return errHostnameActivationFailed
+		}
+
+		select {
+		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// hostnamesMap converts the hostnames map of the schema to edge hostname IDs by hostname
+func hostnamesMap(v interface{}) map[string]string {
+	hostnames := make(map[string]string)
+	for hostname, id := range v.(map[string]interface{}) {
+		hostnames[hostname] = cast.ToString(id)
+	}
+	return hostnames
+}
+
+// sortedKeys returns the keys of the map in order, so hostnames are always sent in the same chunks
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
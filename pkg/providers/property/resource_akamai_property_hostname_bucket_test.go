@@ -0,0 +1,176 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResPropertyHostnameBucket(t *testing.T) {
+	bucketRequest := PatchPropertyHostnameBucketRequest{
+		PropertyID:   "prp_1",
+		ContractID:   "ctr_1",
+		GroupID:      "grp_2",
+		Network:      papi.ActivationNetworkStaging,
+		NotifyEmails: []string{"user@example.com"},
+	}
+	hostnameAdd := func(hostname, edgeHostnameID string) BucketHostnameAdd {
+		return BucketHostnameAdd{
+			CnameFrom:            hostname,
+			CnameType:            papi.HostnameCnameTypeEdgeHostname,
+			EdgeHostnameID:       edgeHostnameID,
+			CertProvisioningType: "CPS_MANAGED",
+		}
+	}
+
+	t.Run("add and remove hostnames incrementally", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		// Hostnames of the mock bucket, changed by the patches
+		bucket := ListPropertyHostnameBucketResponse{PropertyID: "prp_1"}
+		client.On("ListPropertyHostnameBucket", AnyCTX, ListPropertyHostnameBucketRequest{
+			PropertyID: "prp_1",
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			Network:    papi.ActivationNetworkStaging,
+		}).Return(&bucket, nil)
+
+		expectPatch := func(add []BucketHostnameAdd, remove []string, ActivationID string) {
+			req := bucketRequest
+			req.Add, req.Remove = add, remove
+			client.On("PatchPropertyHostnameBucket", AnyCTX, req).Return(&PatchPropertyHostnameBucketResponse{
+				HostnameActivationID: ActivationID,
+			}, nil).Run(func(mock.Arguments) {
+				var items []BucketHostname
+				for _, h := range bucket.Hostnames.Items {
+					if !tools.ContainsString(remove, h.CnameFrom) {
+						items = append(items, h)
+					}
+				}
+				for _, h := range add {
+					items = append(items, BucketHostname{CnameFrom: h.CnameFrom, StagingEdgeHostnameID: h.EdgeHostnameID})
+				}
+				bucket.Hostnames = BucketHostnameItems{Items: items, TotalItems: len(items)}
+			}).Once()
+		}
+		client.On("GetPropertyHostnameActivation", AnyCTX, mock.MatchedBy(func(req GetPropertyHostnameActivationRequest) bool {
+			return req.PropertyID == "prp_1"
+		})).Return(&GetPropertyHostnameActivationResponse{HostnameActivation: HostnameActivation{
+			Status: papi.ActivationStatusActive,
+		}}, nil)
+
+		expectPatch([]BucketHostnameAdd{
+			hostnameAdd("shop.example.com", "ehn_1"),
+			hostnameAdd("www.example.com", "ehn_1"),
+		}, nil, "atvhn_1")
+		expectPatch([]BucketHostnameAdd{hostnameAdd("blog.example.com", "ehn_2")}, []string{"shop.example.com"}, "atvhn_2")
+		expectPatch(nil, []string{"blog.example.com", "www.example.com"}, "atvhn_3")
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResPropertyHostnameBucket/step0.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_hostname_bucket.test", "id", "prp_1:STAGING"),
+							resource.TestCheckResourceAttr("akamai_property_hostname_bucket.test", "activation_id", "atvhn_1"),
+							resource.TestCheckResourceAttr("akamai_property_hostname_bucket.test", "hostnames.%", "2"),
+							resource.TestCheckResourceAttr("akamai_property_hostname_bucket.test", "hostname_statuses.www.example.com", "ACTIVE"),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResPropertyHostnameBucket/step1.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_hostname_bucket.test", "activation_id", "atvhn_2"),
+							resource.TestCheckResourceAttr("akamai_property_hostname_bucket.test", "hostnames.%", "2"),
+							resource.TestCheckResourceAttr("akamai_property_hostname_bucket.test", "hostnames.blog.example.com", "ehn_2"),
+							resource.TestCheckNoResourceAttr("akamai_property_hostname_bucket.test", "hostnames.shop.example.com"),
+						),
+					},
+				},
+			})
+		})
+	})
+
+	t.Run("edge hostname without ID", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:      loadFixtureString("testdata/TestResPropertyHostnameBucket/invalid_edge_hostname.tf"),
+					ExpectError: regexp.MustCompile("must have the 'ehn_' prefix"),
+				}},
+			})
+		})
+	})
+}
+
+func TestPatchHostnameBucket(t *testing.T) {
+	t.Run("changes are sent in chunks", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		oldHostnames, newHostnames := map[string]string{}, map[string]string{}
+		for i := 0; i < 600; i++ {
+			oldHostnames[fmt.Sprintf("old%04d.example.com", i)] = "ehn_1"
+		}
+		for i := 0; i < 1500; i++ {
+			newHostnames[fmt.Sprintf("new%04d.example.com", i)] = "ehn_1"
+		}
+
+		var chunks [][2]int
+		client.On("PatchPropertyHostnameBucket", AnyCTX, mock.Anything).Return(&PatchPropertyHostnameBucketResponse{
+			HostnameActivationID: "atvhn_1",
+		}, nil).Run(func(args mock.Arguments) {
+			req := args.Get(1).(PatchPropertyHostnameBucketRequest)
+			chunks = append(chunks, [2]int{len(req.Remove), len(req.Add)})
+		}).Times(3)
+		client.On("GetPropertyHostnameActivation", AnyCTX, mock.Anything).Return(&GetPropertyHostnameActivationResponse{
+			HostnameActivation: HostnameActivation{Status: papi.ActivationStatusActive},
+		}, nil).Times(3)
+
+		d := schema.TestResourceDataRaw(t, resourcePropertyHostnameBucket().Schema, map[string]interface{}{
+			"property_id": "prp_1",
+			"contract_id": "ctr_1",
+			"group_id":    "grp_2",
+		})
+		diags := patchHostnameBucket(context.Background(), d, client, papi.ActivationNetworkStaging, oldHostnames, newHostnames)
+		assert.Nil(t, diags)
+		assert.Equal(t, [][2]int{{600, 400}, {0, 1000}, {0, 100}}, chunks)
+	})
+
+	t.Run("failed activation stops the changes", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		client.On("PatchPropertyHostnameBucket", AnyCTX, mock.Anything).Return(&PatchPropertyHostnameBucketResponse{
+			HostnameActivationID: "atvhn_1",
+		}, nil).Once()
+		client.On("GetPropertyHostnameActivation", AnyCTX, mock.Anything).Return(&GetPropertyHostnameActivationResponse{
+			HostnameActivation: HostnameActivation{Status: papi.ActivationStatusFailed},
+		}, nil).Once()
+
+		d := schema.TestResourceDataRaw(t, resourcePropertyHostnameBucket().Schema, map[string]interface{}{
+			"property_id": "prp_1",
+			"contract_id": "ctr_1",
+			"group_id":    "grp_2",
+		})
+		diags := patchHostnameBucket(context.Background(), d, client, papi.ActivationNetworkStaging, map[string]string{},
+			map[string]string{"www.example.com": "ehn_1"})
+		assert.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "hostname activation request failed in downstream system")
+	})
+}
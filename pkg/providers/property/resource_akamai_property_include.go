@@ -0,0 +1,480 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func resourcePropertyInclude() *schema.Resource {
+	// Rules and rule format are handled the same way as the ones of akamai_property
+	propertySchema := resourceProperty().Schema
+	ruleFormat, rules := *propertySchema["rule_format"], *propertySchema["rules"]
+	ruleFormat.Description = "Specify the rule format version (defaults to latest version available when created)"
+	rules.Description = "Include rules as JSON"
+
+	return &schema.Resource{
+		CreateContext: resourcePropertyIncludeCreate,
+		ReadContext:   resourcePropertyIncludeRead,
+		UpdateContext: resourcePropertyIncludeUpdate,
+		DeleteContext: resourcePropertyIncludeDelete,
+		CustomizeDiff: customdiff.All(
+			rulesCustomDiff,
+			includeVersionsCustomDiff,
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePropertyIncludeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePropertyName,
+				Description:      "Name to give to the include (must be unique)",
+			},
+			"contract_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("ctr_"),
+				Description: "Contract ID to be assigned to the include",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("grp_"),
+				Description: "Group ID to be assigned to the include",
+			},
+			"product_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("prd_"),
+				Description: "Product ID to be assigned to the include",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					string(IncludeTypeMicroServices), string(IncludeTypeCommonSettings),
+				}, false)),
+				Description: "Type of the include, either MICROSERVICES or COMMON_SETTINGS",
+			},
+			"rule_format": &ruleFormat,
+			"rules":       &rules,
+
+			// Computed
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Include's current latest version number",
+			},
+			"staging_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Include's version currently activated in staging (zero when not active in staging)",
+			},
+			"production_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Include's version currently activated in production (zero when not active in production)",
+			},
+			"rule_errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     papiError(),
+			},
+			"rule_warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     papiError(),
+			},
+		},
+	}
+}
+
+// includeVersionsCustomDiff sets `latest_version`, `staging_version` and `production_version` fields as computed
+// if a new version of the include is expected to be created
+func includeVersionsCustomDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "includeVersionsCustomDiff")
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldRules, newRules := d.GetChange("rules")
+	if compareRulesJSON(oldRules.(string), newRules.(string)) && !d.HasChange("rule_format") {
+		return nil
+	}
+
+	for _, key := range []string{"latest_version", "staging_version", "production_version"} {
+		if err := d.SetNewComputed(key); err != nil {
+			logger.Errorf("%s state failed to update with new value from server", key)
+			return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func resourcePropertyIncludeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeCreate")
+	client := inst.Client(meta)
+	ctx = log.NewContext(ctx, logger)
+
+	// Schema guarantees these types
+	ContractID := tools.AddPrefix(d.Get("contract_id").(string), "ctr_")
+	GroupID := tools.AddPrefix(d.Get("group_id").(string), "grp_")
+	RuleFormat := d.Get("rule_format").(string)
+	RulesJSON := []byte(d.Get("rules").(string))
+
+	req := CreateIncludeRequest{
+		ContractID:  ContractID,
+		GroupID:     GroupID,
+		IncludeName: d.Get("name").(string),
+		IncludeType: IncludeType(d.Get("type").(string)),
+		ProductID:   tools.AddPrefix(d.Get("product_id").(string), "prd_"),
+		RuleFormat:  RuleFormat,
+	}
+
+	logger.WithFields(logFields(req)).Debug("creating include")
+	res, err := client.CreateInclude(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not create include")
+		return diag.FromErr(err)
+	}
+	logger.WithFields(logFields(*res)).Info("include created")
+
+	// Save minimum state BEFORE moving on
+	d.SetId(res.IncludeID)
+	if err := rdSetAttrs(ctx, d, map[string]interface{}{"contract_id": ContractID, "group_id": GroupID}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(RulesJSON) > 0 {
+		var Rules papi.RulesUpdate
+		if err := json.Unmarshal(RulesJSON, &Rules); err != nil {
+			logger.WithError(err).Error("failed to unmarshal include rules")
+			return diag.Errorf("rules are not valid JSON: %s", err)
+		}
+
+		Include := Include{IncludeID: res.IncludeID, ContractID: ContractID, GroupID: GroupID}
+		if err := updateIncludeRules(includeRulesContext(ctx, RuleFormat), client, Include, 1, Rules); err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePropertyIncludeRead(ctx, d, m)
+}
+
+func resourcePropertyIncludeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeRead")
+	client := inst.Client(meta)
+	ctx = log.NewContext(ctx, logger)
+
+	Include, err := fetchInclude(ctx, client, d.Id(), tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		tools.AddPrefix(d.Get("group_id").(string), "grp_"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	Rules, RuleFormat, RuleErrors, RuleWarnings, err := fetchIncludeVersionRules(ctx, client, *Include, Include.LatestVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(RuleErrors) > 0 {
+		msg, err := json.MarshalIndent(papiErrorsToList(RuleErrors), "", "\t")
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error marshaling API error: %s", err))
+		}
+		logger.Errorf("Include has rule errors %s", msg)
+	}
+	if len(RuleWarnings) > 0 {
+		msg, err := json.MarshalIndent(papiErrorsToList(RuleWarnings), "", "\t")
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error marshaling API warnings: %s", err))
+		}
+		logger.Warnf("Include has rule warnings %s", msg)
+	}
+
+	RulesJSON, err := json.Marshal(Rules)
+	if err != nil {
+		logger.WithError(err).Error("could not render rules as JSON")
+		return diag.Errorf("received rules that could not be rendered to JSON: %s", err)
+	}
+
+	res, err := fetchIncludeVersion(ctx, client, *Include, Include.LatestVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attrs := map[string]interface{}{
+		"name":               Include.IncludeName,
+		"contract_id":        Include.ContractID,
+		"group_id":           Include.GroupID,
+		"product_id":         res.ProductID,
+		"type":               string(Include.IncludeType),
+		"latest_version":     Include.LatestVersion,
+		"staging_version":    decodeVersion(Include.StagingVersion),
+		"production_version": decodeVersion(Include.ProductionVersion),
+		"rules":              string(RulesJSON),
+		"rule_format":        RuleFormat,
+		"rule_errors":        papiErrorsToList(RuleErrors),
+		"rule_warnings":      papiErrorsToList(RuleWarnings),
+	}
+	if err := rdSetAttrs(ctx, d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePropertyIncludeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeUpdate")
+	client := inst.Client(meta)
+	ctx = log.NewContext(ctx, logger)
+
+	RuleFormat := d.Get("rule_format").(string)
+	RulesJSON := []byte(d.Get("rules").(string))
+	RulesNeedUpdate := len(RulesJSON) > 0 && d.HasChange("rules")
+	FormatNeedsUpdate := len(RuleFormat) > 0 && d.HasChange("rule_format")
+
+	if !RulesNeedUpdate && !FormatNeedsUpdate {
+		logger.Debug("No changes to rules or rule_format (no update required)")
+		return nil
+	}
+
+	var Rules papi.RulesUpdate
+	if err := json.Unmarshal(RulesJSON, &Rules); err != nil {
+		d.Partial(true)
+		return diag.Errorf("rules are not valid JSON: %s", err)
+	}
+
+	Include := Include{
+		IncludeID:     d.Id(),
+		ContractID:    tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		GroupID:       tools.AddPrefix(d.Get("group_id").(string), "grp_"),
+		LatestVersion: d.Get("latest_version").(int),
+	}
+
+	Version, err := fetchIncludeVersion(ctx, client, Include, Include.LatestVersion)
+	if err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	// An include version which has been activated on either network can't be changed, the rules go to a new version
+	if Version.StagingStatus != papi.VersionStatusInactive || Version.ProductionStatus != papi.VersionStatusInactive {
+		Include.LatestVersion, err = createIncludeVersion(ctx, client, Include, *Version)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateIncludeRules(includeRulesContext(ctx, RuleFormat), client, Include, Include.LatestVersion, Rules); err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	return resourcePropertyIncludeRead(ctx, d, m)
+}
+
+func resourcePropertyIncludeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeDelete")
+	client := inst.Client(meta)
+	ctx = log.NewContext(ctx, logger)
+
+	req := DeleteIncludeRequest{
+		IncludeID:  d.Id(),
+		ContractID: tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		GroupID:    tools.AddPrefix(d.Get("group_id").(string), "grp_"),
+	}
+
+	logger.WithFields(logFields(req)).Debug("removing include")
+	if err := client.DeleteInclude(ctx, req); err != nil {
+		logger.WithError(err).Error("could not remove include")
+		return diag.FromErr(err)
+	}
+
+	logger.Info("include removed")
+	return nil
+}
+
+func resourcePropertyIncludeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ctx = log.NewContext(ctx, akamai.Meta(m).Log("PAPI", "resourcePropertyIncludeImport"))
+
+	// User-supplied import ID is a comma-separated list of IncludeID,ContractID,GroupID, includes can't be fetched
+	// without the contract and group
+	parts := strings.Split(d.Id(), ",")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid include identifier: %q, expected include_id,contract_id,group_id", d.Id())
+	}
+
+	d.SetId(tools.AddPrefix(parts[0], "inc_"))
+	attrs := map[string]interface{}{
+		"contract_id": tools.AddPrefix(parts[1], "ctr_"),
+		"group_id":    tools.AddPrefix(parts[2], "grp_"),
+	}
+	if err := rdSetAttrs(ctx, d, attrs); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// includeRulesContext returns a context sending the rules in the given rule format
+func includeRulesContext(ctx context.Context, RuleFormat string) context.Context {
+	if RuleFormat == "" {
+		return ctx
+	}
+
+	h := http.Header{
+		"Content-Type": []string{fmt.Sprintf("application/vnd.akamai.papirules.%s+json", RuleFormat)},
+	}
+	return session.ContextWithOptions(ctx, session.WithContextHeaders(h))
+}
+
+// Fetch the include with its latest, staging and production versions
+func fetchInclude(ctx context.Context, client PAPI, IncludeID, ContractID, GroupID string) (*Include, error) {
+	req := GetIncludeRequest{
+		IncludeID:  IncludeID,
+		ContractID: ContractID,
+		GroupID:    GroupID,
+	}
+
+	logger := log.FromContext(ctx).WithFields(logFields(req))
+
+	logger.Debug("fetching include")
+	res, err := client.GetInclude(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not fetch include")
+		return nil, err
+	}
+
+	logger.WithFields(logFields(res.Include)).Debug("fetched include")
+	return &res.Include, nil
+}
+
+// Fetch the given version of the include
+func fetchIncludeVersion(ctx context.Context, client PAPI, Include Include, Version int) (*IncludeVersion, error) {
+	req := GetIncludeVersionRequest{
+		IncludeID:  Include.IncludeID,
+		ContractID: Include.ContractID,
+		GroupID:    Include.GroupID,
+		Version:    Version,
+	}
+
+	logger := log.FromContext(ctx).WithFields(logFields(req))
+
+	logger.Debug("fetching include version")
+	res, err := client.GetIncludeVersion(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not fetch include version")
+		return nil, err
+	}
+
+	logger.WithFields(logFields(res.Version)).Debug("fetched include version")
+	return &res.Version, nil
+}
+
+// Create a new include version based on the given version. The etag of the version is passed along, so PAPI refuses
+// to create the new version if the given version has been changed in the meantime
+func createIncludeVersion(ctx context.Context, client PAPI, Include Include, From IncludeVersion) (int, error) {
+	req := CreateIncludeVersionRequest{
+		IncludeID:             Include.IncludeID,
+		ContractID:            Include.ContractID,
+		GroupID:               Include.GroupID,
+		CreateFromVersion:     From.IncludeVersion,
+		CreateFromVersionEtag: From.Etag,
+	}
+
+	logger := log.FromContext(ctx).WithFields(logFields(req))
+
+	logger.Debug("creating new include version")
+	res, err := client.CreateIncludeVersion(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not create new include version")
+		return 0, err
+	}
+
+	logger.WithFields(logFields(*res)).Info("include version created")
+	return res.Version, nil
+}
+
+// Fetch the rules of the given include version with their rule errors and warnings
+func fetchIncludeVersionRules(ctx context.Context, client PAPI, Include Include, Version int) (Rules papi.RulesUpdate, Format string, Errors, Warnings []*papi.Error, err error) {
+	req := GetIncludeRuleTreeRequest{
+		IncludeID:     Include.IncludeID,
+		ContractID:    Include.ContractID,
+		GroupID:       Include.GroupID,
+		Version:       Version,
+		ValidateRules: true,
+		ValidateMode:  papi.RuleValidateModeFull,
+	}
+
+	logger := log.FromContext(ctx).WithFields(logFields(req))
+
+	logger.Debug("fetching include rules")
+	res, err := client.GetIncludeRuleTree(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not fetch include rules")
+		return
+	}
+
+	logger.WithFields(logFields(*res)).Debug("fetched include rules")
+	Rules = papi.RulesUpdate{
+		Rules:    res.Rules,
+		Comments: res.Comments,
+	}
+	Format = res.RuleFormat
+	Errors = res.Errors
+	Warnings = res.Warnings
+	return
+}
+
+// Set rules for the given include version
+func updateIncludeRules(ctx context.Context, client PAPI, Include Include, Version int, Rules papi.RulesUpdate) error {
+	req := UpdateIncludeRuleTreeRequest{
+		IncludeID:     Include.IncludeID,
+		ContractID:    Include.ContractID,
+		GroupID:       Include.GroupID,
+		Version:       Version,
+		Rules:         Rules,
+		ValidateRules: true,
+	}
+
+	logger := log.FromContext(ctx).WithFields(logFields(req))
+
+	logger.Debug("updating include rules")
+	res, err := client.UpdateIncludeRuleTree(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not update include rules")
+		return err
+	}
+
+	logger.WithFields(logFields(*res)).Info("updated include rules")
+	return nil
+}
@@ -0,0 +1,438 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+var (
+	errIncludeActivationAborted = errors.New("request aborted")
+	errIncludeActivationFailed  = errors.New("request failed in downstream system")
+)
+
+func resourcePropertyIncludeActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyIncludeActivationCreate,
+		ReadContext:   resourcePropertyIncludeActivationRead,
+		UpdateContext: resourcePropertyIncludeActivationUpdate,
+		DeleteContext: resourcePropertyIncludeActivationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &PropertyResourceTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			"include_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc:   addPrefixToState("inc_"),
+				Description: "The include to activate",
+			},
+			"contract_id": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: addPrefixToState("ctr_"),
+			},
+			"group_id": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: addPrefixToState("grp_"),
+			},
+			"version": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "The include version to activate",
+			},
+			"network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  papi.ActivationNetworkStaging,
+			},
+			"notify_emails": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "email addresses notified about the activation",
+			},
+			"note": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "assigns a log message to the activation request",
+			},
+			"auto_acknowledge_rule_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "automatically acknowledge all rule warnings for activation to continue. default is true",
+			},
+			"acknowledge_warnings": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "message IDs of the activation warnings to acknowledge. When set, only these warnings are acknowledged instead of all of them",
+			},
+			"activation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     papiError(),
+			},
+		},
+	}
+}
+
+func resourcePropertyIncludeActivationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeActivationCreate")
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	return activateInclude(ctx, d, meta)
+}
+
+func resourcePropertyIncludeActivationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeActivationUpdate")
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	// The other arguments only apply to the next activation
+	if !d.HasChange("version") {
+		return nil
+	}
+
+	return activateInclude(ctx, d, meta)
+}
+
+func resourcePropertyIncludeActivationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeActivationRead")
+	client := inst.Client(meta)
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	Include, err := fetchInclude(ctx, client, tools.AddPrefix(d.Get("include_id").(string), "inc_"),
+		tools.AddPrefix(d.Get("contract_id").(string), "ctr_"), tools.AddPrefix(d.Get("group_id").(string), "grp_"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ActiveVersion := Include.StagingVersion
+	if network == papi.ActivationNetworkProduction {
+		ActiveVersion = Include.ProductionVersion
+	}
+	if ActiveVersion == nil {
+		logger.Warnf("include %s is not active on %s anymore", Include.IncludeID, network)
+		d.SetId("")
+		return nil
+	}
+
+	activation, err := lookupIncludeActivation(ctx, client, *Include, *ActiveVersion, network, papi.ActivationTypeActivate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attrs := map[string]interface{}{
+		"version": *ActiveVersion,
+		"status":  string(papi.ActivationStatusActive),
+	}
+	if activation != nil {
+		attrs["activation_id"] = activation.ActivationID
+		attrs["status"] = string(activation.Status)
+	}
+	if err := tools.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	return nil
+}
+
+func resourcePropertyIncludeActivationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyIncludeActivationDelete")
+	client := inst.Client(meta)
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	Include := Include{
+		IncludeID:  tools.AddPrefix(d.Get("include_id").(string), "inc_"),
+		ContractID: tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		GroupID:    tools.AddPrefix(d.Get("group_id").(string), "grp_"),
+	}
+	version := d.Get("version").(int)
+
+	activation, err := lookupIncludeActivation(ctx, client, Include, version, network, papi.ActivationTypeDeactivate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if activation == nil {
+		activation, err = createIncludeActivation(ctx, d, client, Include, version, network, papi.ActivationTypeDeactivate)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("create deactivation failed: %w", err))
+		}
+	}
+
+	// deactivations also use status Active for when they are fully processed
+	if _, err := pollIncludeActivation(ctx, client, Include, *activation); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return diag.FromErr(fmt.Errorf("deactivation context terminated: %w", err))
+		}
+		if errors.Is(err, errIncludeActivationAborted) || errors.Is(err, errIncludeActivationFailed) {
+			return diag.FromErr(fmt.Errorf("deactivation %w", err))
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// activateInclude activates the configured include version, waiting for the activation to complete
+func activateInclude(ctx context.Context, d *schema.ResourceData, meta akamai.OperationMeta) diag.Diagnostics {
+	logger := meta.Log("PAPI", "activateInclude")
+	client := inst.Client(meta)
+
+	network, err := networkAlias(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	Include := Include{
+		IncludeID:  tools.AddPrefix(d.Get("include_id").(string), "inc_"),
+		ContractID: tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		GroupID:    tools.AddPrefix(d.Get("group_id").(string), "grp_"),
+	}
+	version := d.Get("version").(int)
+
+	// check to see if this tree has any issues
+	_, _, RuleErrors, RuleWarnings, err := fetchIncludeVersionRules(ctx, client, Include, version)
+	if err != nil {
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule_errors", papiErrorsToList(RuleErrors)); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+	if len(RuleWarnings) > 0 {
+		msg, err := json.MarshalIndent(papiErrorsToList(RuleWarnings), "", "\t")
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error marshaling API warnings: %s", err))
+		}
+		logger.Warnf("Include has rule warnings %s", msg)
+	}
+	if len(RuleErrors) > 0 {
+		msg, err := json.MarshalIndent(papiErrorsToList(RuleErrors), "", "\t")
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error marshaling API error: %s", err))
+		}
+		logger.Errorf("Include has rule errors %s", msg)
+		d.Partial(true)
+		return diag.Errorf("activation cannot continue due to rule errors: %s", msg)
+	}
+
+	activation, err := lookupIncludeActivation(ctx, client, Include, version, network, papi.ActivationTypeActivate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if activation == nil {
+		activation, err = createIncludeActivation(ctx, d, client, Include, version, network, papi.ActivationTypeActivate)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("create activation failed: %w", err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", Include.IncludeID, network))
+	if err := d.Set("activation_id", activation.ActivationID); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	activation, err = pollIncludeActivation(ctx, client, Include, *activation)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return diag.Diagnostics{DiagWarnActivationTimeout}
+		} else if errors.Is(err, context.Canceled) {
+			return diag.Diagnostics{DiagWarnActivationCanceled}
+		}
+		if errors.Is(err, errIncludeActivationAborted) || errors.Is(err, errIncludeActivationFailed) {
+			return diag.FromErr(fmt.Errorf("activation %w", err))
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := tools.SetAttrs(d, map[string]interface{}{"status": string(activation.Status), "version": version}); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	return nil
+}
+
+// createIncludeActivation submits an activation or deactivation of the include version and returns its initial state
+func createIncludeActivation(ctx context.Context, d *schema.ResourceData, client PAPI, Include Include, version int,
+	network papi.ActivationNetwork, activationType papi.ActivationType) (*IncludeActivation, error) {
+	var notify []string
+	for _, email := range d.Get("notify_emails").(*schema.Set).List() {
+		notify = append(notify, cast.ToString(email))
+	}
+	acknowledgeRuleWarnings, acknowledgeWarnings := activationWarningsAcknowledgement(d)
+
+	create, err := client.ActivateInclude(ctx, ActivateIncludeRequest{
+		IncludeID:  Include.IncludeID,
+		ContractID: Include.ContractID,
+		GroupID:    Include.GroupID,
+		Activation: IncludeActivation{
+			ActivationType:         activationType,
+			IncludeVersion:         version,
+			Network:                network,
+			Note:                   d.Get("note").(string),
+			NotifyEmails:           notify,
+			AcknowledgeAllWarnings: acknowledgeRuleWarnings,
+			AcknowledgeWarnings:    acknowledgeWarnings,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// query the activation to retrieve the initial status
+	act, err := client.GetIncludeActivation(ctx, GetIncludeActivationRequest{
+		IncludeID:    Include.IncludeID,
+		ContractID:   Include.ContractID,
+		GroupID:      Include.GroupID,
+		ActivationID: create.ActivationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &act.Activation, nil
+}
+
+// pollIncludeActivation waits for the activation to complete. The context error is returned when the context is done first
+func pollIncludeActivation(ctx context.Context, client PAPI, Include Include, activation IncludeActivation) (*IncludeActivation, error) {
+	for activation.Status != papi.ActivationStatusActive {
+		if activation.Status == papi.ActivationStatusAborted {
+			return nil, errIncludeActivationAborted
+		}
+		if activation.Status == papi.ActivationStatusFailed {
+			return nil, errIncludeActivationFailed
+		}
+		select {
+		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+			act, err := client.GetIncludeActivation(ctx, GetIncludeActivationRequest{
+				IncludeID:    Include.IncludeID,
+				ContractID:   Include.ContractID,
+				GroupID:      Include.GroupID,
+				ActivationID: activation.ActivationID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			activation = act.Activation
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return &activation, nil
+}
+
+// lookupIncludeActivation returns the most recent activation (by SubmitDate) of the include version on the network
+// which is in progress or active, when it is of the given type
+func lookupIncludeActivation(ctx context.Context, client PAPI, Include Include, version int, network papi.ActivationNetwork,
+	activationType papi.ActivationType) (*IncludeActivation, error) {
+	activations, err := client.ListIncludeActivations(ctx, ListIncludeActivationsRequest{
+		IncludeID:  Include.IncludeID,
+		ContractID: Include.ContractID,
+		GroupID:    Include.GroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inProgressStates := map[papi.ActivationStatus]struct{}{
+		papi.ActivationStatusActive:       {},
+		papi.ActivationStatusNew:          {},
+		papi.ActivationStatusPending:      {},
+		papi.ActivationStatusDeactivating: {},
+		papi.ActivationStatusZone1:        {},
+		papi.ActivationStatusZone2:        {},
+		papi.ActivationStatusZone3:        {},
+	}
+
+	var bestMatch *IncludeActivation
+	var bestMatchSubmitDate time.Time
+
+	for i, a := range activations.Activations.Items {
+		if _, ok := inProgressStates[a.Status]; !ok || a.IncludeVersion != version || a.Network != network {
+			continue
+		}
+
+		aSubmitDate, err := tools.ParseDate(tools.DateTimeFormat, a.SubmitDate)
+		if err != nil {
+			return nil, err
+		}
+
+		if bestMatchSubmitDate.IsZero() || bestMatchSubmitDate.Before(aSubmitDate) {
+			bestMatch = &activations.Activations.Items[i]
+			bestMatchSubmitDate = aSubmitDate
+		}
+	}
+
+	if bestMatch != nil && bestMatch.ActivationType == activationType {
+		return bestMatch, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResPropertyIncludeActivation(t *testing.T) {
+	ruleTreeReq := GetIncludeRuleTreeRequest{
+		ContractID:    "ctr_1",
+		GroupID:       "grp_2",
+		IncludeID:     "inc_123",
+		Version:       1,
+		ValidateRules: true,
+		ValidateMode:  papi.RuleValidateModeFull,
+	}
+
+	expectActivateInclude := func(client *mockpapi, activationType papi.ActivationType, ActivationID string) *mock.Call {
+		return client.On("ActivateInclude", AnyCTX, mock.MatchedBy(func(req ActivateIncludeRequest) bool {
+			return req.IncludeID == "inc_123" && req.Activation.ActivationType == activationType &&
+				req.Activation.IncludeVersion == 1 && req.Activation.Network == papi.ActivationNetworkStaging &&
+				req.Activation.AcknowledgeAllWarnings
+		})).Return(&ActivateIncludeResponse{ActivationID: ActivationID}, nil).Once()
+	}
+
+	t.Run("activate and deactivate include", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		activation := IncludeActivation{
+			ActivationID:   "atv_1",
+			ActivationType: papi.ActivationTypeActivate,
+			IncludeID:      "inc_123",
+			IncludeVersion: 1,
+			Network:        papi.ActivationNetworkStaging,
+			Status:         papi.ActivationStatusActive,
+			SubmitDate:     "2022-03-01T00:00:00Z",
+		}
+		activations := ListIncludeActivationsResponse{}
+
+		client.On("GetIncludeRuleTree", AnyCTX, ruleTreeReq).Return(&GetIncludeRuleTreeResponse{
+			IncludeID:      "inc_123",
+			IncludeVersion: 1,
+			Rules:          papi.Rules{Name: "default"},
+		}, nil).Once()
+		client.On("ListIncludeActivations", AnyCTX, ListIncludeActivationsRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			IncludeID:  "inc_123",
+		}).Return(&activations, nil)
+		expectActivateInclude(client, papi.ActivationTypeActivate, "atv_1").Run(func(mock.Arguments) {
+			activations.Activations.Items = []IncludeActivation{activation}
+		})
+		client.On("GetIncludeActivation", AnyCTX, GetIncludeActivationRequest{
+			ContractID:   "ctr_1",
+			GroupID:      "grp_2",
+			IncludeID:    "inc_123",
+			ActivationID: "atv_1",
+		}).Return(&GetIncludeActivationResponse{Activation: activation}, nil)
+
+		stagingVersion := 1
+		client.On("GetInclude", AnyCTX, GetIncludeRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			IncludeID:  "inc_123",
+		}).Return(&GetIncludeResponse{Include: Include{
+			ContractID:     "ctr_1",
+			GroupID:        "grp_2",
+			IncludeID:      "inc_123",
+			LatestVersion:  1,
+			StagingVersion: &stagingVersion,
+		}}, nil)
+
+		deactivation := activation
+		deactivation.ActivationID = "atv_2"
+		deactivation.ActivationType = papi.ActivationTypeDeactivate
+		expectActivateInclude(client, papi.ActivationTypeDeactivate, "atv_2")
+		client.On("GetIncludeActivation", AnyCTX, GetIncludeActivationRequest{
+			ContractID:   "ctr_1",
+			GroupID:      "grp_2",
+			IncludeID:    "inc_123",
+			ActivationID: "atv_2",
+		}).Return(&GetIncludeActivationResponse{Activation: deactivation}, nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestResPropertyIncludeActivation/activation.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_property_include_activation.test", "id", "inc_123:STAGING"),
+						resource.TestCheckResourceAttr("akamai_property_include_activation.test", "activation_id", "atv_1"),
+						resource.TestCheckResourceAttr("akamai_property_include_activation.test", "status", "ACTIVE"),
+						resource.TestCheckResourceAttr("akamai_property_include_activation.test", "version", "1"),
+						resource.TestCheckResourceAttr("akamai_property_include_activation.test", "rule_errors.#", "0"),
+					),
+				}},
+			})
+		})
+	})
+
+	t.Run("rule errors prevent the activation", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		client.On("GetIncludeRuleTree", AnyCTX, ruleTreeReq).Return(&GetIncludeRuleTreeResponse{
+			Response: papi.Response{Errors: []*papi.Error{{
+				Type:  "/papi/v1/errors/validation.required_behavior",
+				Title: "Missing required behavior in default rule",
+			}}},
+			IncludeID:      "inc_123",
+			IncludeVersion: 1,
+			Rules:          papi.Rules{Name: "default"},
+		}, nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:      loadFixtureString("testdata/TestResPropertyIncludeActivation/activation.tf"),
+					ExpectError: regexp.MustCompile("activation cannot continue due to rule errors"),
+				}},
+			})
+		})
+	})
+}
@@ -0,0 +1,155 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResPropertyInclude(t *testing.T) {
+	rulesWith := func(behavior string) papi.Rules {
+		return papi.Rules{
+			Name: "default",
+			Behaviors: []papi.RuleBehavior{{
+				Name:    "caching",
+				Options: papi.RuleOptionsMap{"behavior": behavior},
+			}},
+		}
+	}
+
+	// Mock include backing the responses, the rule tree and version status change with the calls
+	include := GetIncludeResponse{Include: Include{
+		ContractID:    "ctr_1",
+		GroupID:       "grp_2",
+		IncludeID:     "inc_123",
+		IncludeName:   "test_include",
+		IncludeType:   IncludeTypeMicroServices,
+		LatestVersion: 1,
+	}}
+	ruleTree := GetIncludeRuleTreeResponse{IncludeID: "inc_123", RuleFormat: "v2020-11-02"}
+	version := GetIncludeVersionResponse{Version: IncludeVersion{
+		ProductID:        "prd_Web_App_Accel",
+		StagingStatus:    papi.VersionStatusInactive,
+		ProductionStatus: papi.VersionStatusInactive,
+	}}
+
+	expectRead := func(client *mockpapi) {
+		client.On("GetInclude", AnyCTX, GetIncludeRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			IncludeID:  "inc_123",
+		}).Return(&include, nil)
+		client.On("GetIncludeRuleTree", AnyCTX, mock.MatchedBy(func(req GetIncludeRuleTreeRequest) bool {
+			return req.IncludeID == "inc_123" && req.Version == include.Include.LatestVersion && req.ValidateRules
+		})).Return(&ruleTree, nil)
+		client.On("GetIncludeVersion", AnyCTX, mock.MatchedBy(func(req GetIncludeVersionRequest) bool {
+			return req.IncludeID == "inc_123" && req.Version == include.Include.LatestVersion
+		})).Return(&version, nil)
+	}
+
+	expectUpdateRules := func(client *mockpapi, Version int, Behavior string) {
+		client.On("UpdateIncludeRuleTree", AnyCTX, mock.MatchedBy(func(req UpdateIncludeRuleTreeRequest) bool {
+			return req.IncludeID == "inc_123" && req.Version == Version &&
+				req.Rules.Rules.Behaviors[0].Options["behavior"] == Behavior
+		})).Return(&UpdateIncludeRuleTreeResponse{IncludeID: "inc_123", IncludeVersion: Version}, nil).Run(func(mock.Arguments) {
+			ruleTree.IncludeVersion = Version
+			ruleTree.Rules = rulesWith(Behavior)
+		}).Once()
+	}
+
+	t.Run("lifecycle with new version for activated include", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		client.On("CreateInclude", AnyCTX, CreateIncludeRequest{
+			ContractID:  "ctr_1",
+			GroupID:     "grp_2",
+			IncludeName: "test_include",
+			IncludeType: IncludeTypeMicroServices,
+			ProductID:   "prd_Web_App_Accel",
+			RuleFormat:  "v2020-11-02",
+		}).Return(&CreateIncludeResponse{IncludeID: "inc_123"}, nil).Once()
+		expectUpdateRules(client, 1, "NO_STORE")
+		expectRead(client)
+
+		// Version 1 is activated on staging before the rules change
+		client.On("CreateIncludeVersion", AnyCTX, CreateIncludeVersionRequest{
+			ContractID:            "ctr_1",
+			GroupID:               "grp_2",
+			IncludeID:             "inc_123",
+			CreateFromVersion:     1,
+			CreateFromVersionEtag: "etag1",
+		}).Return(&CreateIncludeVersionResponse{Version: 2}, nil).Run(func(mock.Arguments) {
+			include.Include.LatestVersion = 2
+			version.Version = IncludeVersion{
+				IncludeVersion:   2,
+				ProductID:        "prd_Web_App_Accel",
+				StagingStatus:    papi.VersionStatusInactive,
+				ProductionStatus: papi.VersionStatusInactive,
+			}
+		}).Once()
+		expectUpdateRules(client, 2, "BYPASS_CACHE")
+
+		client.On("DeleteInclude", AnyCTX, DeleteIncludeRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			IncludeID:  "inc_123",
+		}).Return(nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResPropertyInclude/step0.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_include.test", "id", "inc_123"),
+							resource.TestCheckResourceAttr("akamai_property_include.test", "latest_version", "1"),
+							resource.TestCheckResourceAttr("akamai_property_include.test", "staging_version", "0"),
+							resource.TestCheckResourceAttr("akamai_property_include.test", "rule_format", "v2020-11-02"),
+							resource.TestCheckResourceAttr("akamai_property_include.test", "rule_errors.#", "0"),
+							func(*terraform.State) error {
+								// Activate version 1 on staging outside of the resource
+								stagingVersion := 1
+								include.Include.StagingVersion = &stagingVersion
+								version.Version.IncludeVersion = 1
+								version.Version.Etag = "etag1"
+								version.Version.StagingStatus = papi.VersionStatusActive
+								return nil
+							},
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResPropertyInclude/step1.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_include.test", "latest_version", "2"),
+							resource.TestCheckResourceAttr("akamai_property_include.test", "staging_version", "1"),
+						),
+					},
+				},
+			})
+		})
+	})
+
+	t.Run("import with invalid ID", func(t *testing.T) {
+		client := &mockpapi{}
+		defer client.AssertExpectations(t)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:        loadFixtureString("testdata/TestResPropertyInclude/step0.tf"),
+					ImportState:   true,
+					ImportStateId: "inc_123",
+					ResourceName:  "akamai_property_include.test",
+					ExpectError:   regexp.MustCompile("expected include_id,contract_id,group_id"),
+				}},
+			})
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package property
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResProperty(t *testing.T) {
@@ -531,6 +533,178 @@ func TestResProperty(t *testing.T) {
 		},
 	}
 
+	// ValidateRulesOnPlan verifies that rule errors returned by the dry run fail the plan and no rules are written
+	ValidateRulesOnPlan := LifecycleTestCase{
+		Name: "Rule errors fail the plan",
+		ClientSetup: ComposeBehaviors(
+			PropertyLifecycle("test_property", "prp_0", "grp_0",
+				papi.RulesUpdate{Rules: papi.Rules{Behaviors: []papi.RuleBehavior{{Name: "caching",
+					Options: papi.RuleOptionsMap{"behavior": "MAX_AGE", "mustRevalidate": false, "ttl": "12d"}}},
+					Name: "default"}}),
+			GetPropertyVersions("prp_0", "test_property", "ctr_0", "grp_0"),
+			GetPropertyVersionResources("prp_0", "grp_0", "ctr_0", 1, papi.VersionStatusInactive, papi.VersionStatusInactive),
+			SetHostnames("prp_0", 1, "to.test.domain"),
+			UpdateRuleTree("prp_0", "ctr_0", "grp_0", 1,
+				&papi.RulesUpdate{Rules: papi.Rules{Behaviors: []papi.RuleBehavior{{Name: "caching",
+					Options: papi.RuleOptionsMap{"behavior": "MAX_AGE", "mustRevalidate": false, "ttl": "12d"}}},
+					Name: "default"}}),
+			func(State *TestState) {
+				State.Client.On("DryRunRuleTree", AnyCTX, papi.UpdateRulesRequest{
+					PropertyID:      "prp_0",
+					ContractID:      "ctr_0",
+					GroupID:         "grp_0",
+					PropertyVersion: 1,
+					Rules: papi.RulesUpdate{Rules: papi.Rules{Behaviors: []papi.RuleBehavior{{Name: "caching",
+						Options: papi.RuleOptionsMap{"behavior": "MAX_AGE", "mustRevalidate": false, "ttl": "13d"}}},
+						Name: "default"}},
+					ValidateRules: true,
+					ValidateMode:  papi.RuleValidateModeFull,
+					DryRun:        true,
+				}).Return(&DryRunRuleTreeResponse{Response: papi.Response{
+					Errors: []*papi.Error{{Title: "Invalid TTL", BehaviorName: "caching"}},
+				}}, nil)
+			},
+		),
+		Steps: func(State *TestState, FixturePath string) []resource.TestStep {
+			return []resource.TestStep{
+				{
+					PreConfig: func() {
+						State.VersionItems = papi.PropertyVersionItems{Items: []papi.PropertyVersionGetItem{{PropertyVersion: 1, ProductionStatus: papi.VersionStatusInactive}}}
+					},
+					Config: loadFixtureString("%s/step0.tf", FixturePath),
+					Check: CheckAttrs("prp_0", "to.test.domain", "1", "0", "0", "ehn_123",
+						`{"rules":{"behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","mustRevalidate":false,"ttl":"12d"}}],"name":"default","options":{}}}`),
+				},
+				{
+					Config:      loadFixtureString("%s/step1.tf", FixturePath),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(`property rules are not valid:\s+- Invalid TTL \(behavior "caching"\)`),
+				},
+			}
+		},
+	}
+
+	// BaseVersion verifies that a new version is created from base_version instead of the active latest version
+	BaseVersion := LifecycleTestCase{
+		Name: "New version is created from base_version",
+		ClientSetup: ComposeBehaviors(
+			PropertyLifecycle("test_property", "prp_0", "grp_0",
+				papi.RulesUpdate{Rules: papi.Rules{Name: "default"}}),
+			GetPropertyVersions("prp_0", "test_property", "ctr_0", "grp_0"),
+			GetPropertyVersionResources("prp_0", "grp_0", "ctr_0", 1, papi.VersionStatusActive, papi.VersionStatusInactive),
+			SetHostnames("prp_0", 1, "to.test.domain"),
+			AdvanceVersion("prp_0", 1, 2),
+			GetPropertyVersionResources("prp_0", "grp_0", "ctr_0", 2, papi.VersionStatusActive, papi.VersionStatusInactive),
+			SetHostnames("prp_0", 2, "to2.test.domain"),
+			AdvanceVersion("prp_0", 1, 3),
+			GetPropertyVersionResources("prp_0", "grp_0", "ctr_0", 3, papi.VersionStatusInactive, papi.VersionStatusInactive),
+			SetHostnames("prp_0", 3, "to.test.domain"),
+		),
+		Steps: func(State *TestState, FixturePath string) []resource.TestStep {
+			return []resource.TestStep{
+				{
+					PreConfig: func() {
+						State.VersionItems = papi.PropertyVersionItems{
+							Items: []papi.PropertyVersionGetItem{{
+								ProductionStatus: papi.VersionStatusInactive,
+								PropertyVersion:  1,
+								StagingStatus:    papi.VersionStatusActive,
+							}},
+						}
+					},
+					Config: loadFixtureString("%s/step0.tf", FixturePath),
+					Check: CheckAttrs("prp_0", "to.test.domain", "1", "0", "0", "ehn_123",
+						"{\"rules\":{\"name\":\"default\",\"options\":{}}}"),
+				},
+				{
+					PreConfig: func() {
+						StagingVersion := 1
+						State.Property.StagingVersion = &StagingVersion
+					},
+					Config: loadFixtureString("%s/step1.tf", FixturePath),
+					Check: CheckAttrs("prp_0", "to2.test.domain", "2", "1", "0", "ehn_123",
+						"{\"rules\":{\"name\":\"default\",\"options\":{}}}"),
+				},
+				{
+					PreConfig: func() {
+						StagingVersion := 2
+						State.Property.StagingVersion = &StagingVersion
+					},
+					Config: loadFixtureString("%s/step2.tf", FixturePath),
+					Check: CheckAttrs("prp_0", "to.test.domain", "3", "2", "0", "ehn_123",
+						"{\"rules\":{\"name\":\"default\",\"options\":{}}}"),
+				},
+			}
+		},
+	}
+
+	// Rollback verifies that rollback_to_version creates a new version from the given one, even if the latest version
+	// is editable
+	Rollback := LifecycleTestCase{
+		Name: "Roll back to an earlier version",
+		ClientSetup: ComposeBehaviors(
+			PropertyLifecycle("test_property", "prp_0", "grp_0",
+				papi.RulesUpdate{Rules: papi.Rules{Name: "default"}}),
+			GetPropertyVersions("prp_0", "test_property", "ctr_0", "grp_0"),
+			GetPropertyVersionResources("prp_0", "grp_0", "ctr_0", 1, papi.VersionStatusActive, papi.VersionStatusInactive),
+			SetHostnames("prp_0", 1, "to.test.domain"),
+			AdvanceVersion("prp_0", 1, 2),
+			GetPropertyVersionResources("prp_0", "grp_0", "ctr_0", 2, papi.VersionStatusInactive, papi.VersionStatusInactive),
+			SetHostnames("prp_0", 2, "to2.test.domain"),
+			AdvanceVersion("prp_0", 1, 3),
+			GetPropertyVersionResources("prp_0", "grp_0", "ctr_0", 3, papi.VersionStatusInactive, papi.VersionStatusInactive),
+			SetHostnames("prp_0", 3, "to.test.domain"),
+		),
+		Steps: func(State *TestState, FixturePath string) []resource.TestStep {
+			return []resource.TestStep{
+				{
+					PreConfig: func() {
+						State.VersionItems = papi.PropertyVersionItems{
+							Items: []papi.PropertyVersionGetItem{{
+								ProductionStatus: papi.VersionStatusInactive,
+								PropertyVersion:  1,
+								StagingStatus:    papi.VersionStatusActive,
+							}},
+						}
+					},
+					Config: loadFixtureString("%s/step0.tf", FixturePath),
+					Check: CheckAttrs("prp_0", "to.test.domain", "1", "0", "0", "ehn_123",
+						"{\"rules\":{\"name\":\"default\",\"options\":{}}}"),
+				},
+				{
+					PreConfig: func() {
+						StagingVersion := 1
+						State.Property.StagingVersion = &StagingVersion
+					},
+					Config: loadFixtureString("%s/step1.tf", FixturePath),
+					Check: CheckAttrs("prp_0", "to2.test.domain", "2", "1", "0", "ehn_123",
+						"{\"rules\":{\"name\":\"default\",\"options\":{}}}"),
+				},
+				{
+					// Version 2 is editable, without the rollback its hostnames would be updated in place
+					Config: loadFixtureString("%s/step2.tf", FixturePath),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						CheckAttrs("prp_0", "to.test.domain", "3", "1", "0", "ehn_123",
+							"{\"rules\":{\"name\":\"default\",\"options\":{}}}"),
+						resource.TestCheckResourceAttr("akamai_property.test", "rollback_to_version", "1"),
+						resource.TestCheckResourceAttr("akamai_property.test", "rollback_created_version", "3"),
+					),
+				},
+				{
+					// The restored version is still the latest one, so the rules change in the config is not planned
+					Config:   loadFixtureString("%s/step3.tf", FixturePath),
+					PlanOnly: true,
+				},
+				{
+					// Removing rollback_to_version alone doesn't update the property
+					Config: loadFixtureString("%s/step4.tf", FixturePath),
+					Check: CheckAttrs("prp_0", "to.test.domain", "3", "1", "0", "ehn_123",
+						"{\"rules\":{\"name\":\"default\",\"options\":{}}}"),
+				},
+			}
+		},
+	}
+
 	NoDiffForHostnames := LifecycleTestCase{
 		Name: "No diff found in update",
 		ClientSetup: ComposeBehaviors(
@@ -800,6 +974,9 @@ func TestResProperty(t *testing.T) {
 		t.Run("Lifecycle: no diff (product to product_id)", AssertLifecycle(t, t.Name(), "product to product_id", NoDiff))
 		t.Run("Lifecycle: no diff (product_id to product)", AssertLifecycle(t, t.Name(), "product_id to product", NoDiff))
 		t.Run("Lifecycle: rules custom diff", AssertLifecycle(t, t.Name(), "rules custom diff", RulesCustomDiff))
+		t.Run("Lifecycle: validate rules on plan", AssertLifecycle(t, t.Name(), "validate rules on plan", ValidateRulesOnPlan))
+		t.Run("Lifecycle: base version", AssertLifecycle(t, t.Name(), "base version", BaseVersion))
+		t.Run("Lifecycle: rollback", AssertLifecycle(t, t.Name(), "rollback", Rollback))
 		t.Run("Lifecycle: no diff for hostnames (hostnames)", AssertLifecycle(t, t.Name(), "hostnames", NoDiffForHostnames))
 
 		// Test Import
@@ -1200,3 +1377,139 @@ func TestValidatePropertyName(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatePropertyVersionFrom(t *testing.T) {
+	Property := papi.Property{
+		PropertyID:    "prp_0",
+		ContractID:    "ctr_0",
+		GroupID:       "grp_0",
+		LatestVersion: 5,
+	}
+
+	t.Run("version created from the given version and its etag", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetPropertyVersion", AnyCTX, papi.GetPropertyVersionRequest{
+			PropertyID:      "prp_0",
+			ContractID:      "ctr_0",
+			GroupID:         "grp_0",
+			PropertyVersion: 2,
+		}).Return(&papi.GetPropertyVersionsResponse{
+			PropertyID: "prp_0",
+			Version:    papi.PropertyVersionGetItem{PropertyVersion: 2, Etag: "etag_2"},
+		}, nil).Once()
+		client.On("CreatePropertyVersion", AnyCTX, papi.CreatePropertyVersionRequest{
+			PropertyID: "prp_0",
+			ContractID: "ctr_0",
+			GroupID:    "grp_0",
+			Version: papi.PropertyVersionCreate{
+				CreateFromVersion:     2,
+				CreateFromVersionEtag: "etag_2",
+			},
+		}).Return(&papi.CreatePropertyVersionResponse{PropertyVersion: 6}, nil).Once()
+
+		version, err := createPropertyVersionFrom(context.Background(), client, Property, 2, "")
+		require.NoError(t, err)
+		assert.Equal(t, 6, version)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("version created with the etag captured at plan time", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("CreatePropertyVersion", AnyCTX, papi.CreatePropertyVersionRequest{
+			PropertyID: "prp_0",
+			ContractID: "ctr_0",
+			GroupID:    "grp_0",
+			Version: papi.PropertyVersionCreate{
+				CreateFromVersion:     2,
+				CreateFromVersionEtag: "planned_etag",
+			},
+		}).Return(&papi.CreatePropertyVersionResponse{PropertyVersion: 6}, nil).Once()
+
+		version, err := createPropertyVersionFrom(context.Background(), client, Property, 2, "planned_etag")
+		require.NoError(t, err)
+		assert.Equal(t, 6, version)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error fetching the given version", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetPropertyVersion", AnyCTX, mock.Anything).Return(nil, fmt.Errorf("oops")).Once()
+
+		_, err := createPropertyVersionFrom(context.Background(), client, Property, 2, "")
+		assert.EqualError(t, err, "oops")
+		client.AssertExpectations(t)
+	})
+}
+
+func TestDryRunPropertyRules(t *testing.T) {
+	Property := papi.Property{
+		PropertyID:    "prp_0",
+		ContractID:    "ctr_0",
+		GroupID:       "grp_0",
+		LatestVersion: 3,
+	}
+	Rules := papi.RulesUpdate{Rules: papi.Rules{Name: "default"}}
+	req := papi.UpdateRulesRequest{
+		PropertyID:      "prp_0",
+		ContractID:      "ctr_0",
+		GroupID:         "grp_0",
+		PropertyVersion: 3,
+		Rules:           Rules,
+		ValidateRules:   true,
+		ValidateMode:    papi.RuleValidateModeFull,
+		DryRun:          true,
+	}
+
+	tests := map[string]struct {
+		response      *DryRunRuleTreeResponse
+		err           error
+		expectedDiags diag.Diagnostics
+		withError     string
+	}{
+		"valid rules": {
+			response: &DryRunRuleTreeResponse{PropertyID: "prp_0"},
+		},
+		"rule warnings only": {
+			response: &DryRunRuleTreeResponse{
+				Response: papi.Response{
+					Warnings: []*papi.Error{{Title: "Unstable rule format", Detail: "the rule format is frozen"}},
+				},
+			},
+		},
+		"rule errors": {
+			response: &DryRunRuleTreeResponse{
+				Response: papi.Response{
+					Errors: []*papi.Error{
+						{Title: "Missing origin", Detail: "origin hostname is required", BehaviorName: "origin", Instance: "/rules/behaviors/0"},
+						{Title: "Invalid CP code"},
+					},
+					Warnings: []*papi.Error{{Title: "Unstable rule format"}},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "Missing origin: origin hostname is required (behavior \"origin\")", Detail: "/rules/behaviors/0"},
+				{Severity: diag.Error, Summary: "Invalid CP code"},
+			},
+		},
+		"request error": {
+			err:       fmt.Errorf("oops"),
+			withError: "oops",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockpapi{}
+			client.On("DryRunRuleTree", AnyCTX, req).Return(test.response, test.err).Once()
+
+			diags, err := dryRunPropertyRules(context.Background(), client, Property, Rules)
+			if test.withError != "" {
+				assert.EqualError(t, err, test.withError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedDiags, diags)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+// namedRuleLists are the rule tree collections whose entries are matched by name rather than by position
+var namedRuleLists = map[string]bool{
+	"children":  true,
+	"behaviors": true,
+	"criteria":  true,
+	"variables": true,
+}
+
+// diffRulesJSON returns a path-level semantic diff between two papi.RulesUpdate JSON representations,
+// e.g. "/rules/children[Origin]/behaviors[origin].options.hostname: a → b".
+// Children, behaviors, criteria and variables are matched by their names, so reordering behaviors
+// does not produce a diff, while reordering child rules (which is significant in PAPI) is reported
+func diffRulesJSON(old, new string) ([]string, error) {
+	var oldRules, newRules papi.RulesUpdate
+	if err := json.Unmarshal([]byte(old), &oldRules); err != nil {
+		return nil, fmt.Errorf("cannot parse rules JSON from state: %s", err)
+	}
+	if err := json.Unmarshal([]byte(new), &newRules); err != nil {
+		return nil, fmt.Errorf("cannot parse rules JSON from config: %s", err)
+	}
+
+	oldValue, err := rulesToValue(&oldRules.Rules)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := rulesToValue(&newRules.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var diff []string
+	if oldRules.Comments != newRules.Comments {
+		diff = append(diff, fmt.Sprintf("/comments: %s → %s", formatRuleValue(oldRules.Comments), formatRuleValue(newRules.Comments)))
+	}
+	diffRuleValues("/rules", oldValue, newValue, &diff)
+	return diff, nil
+}
+
+// rulesToValue converts the rule tree to generic JSON values, dropping the default criteriaMustSatisfy
+func rulesToValue(rules *papi.Rules) (interface{}, error) {
+	var normalize func(*papi.Rules)
+	normalize = func(r *papi.Rules) {
+		if r.CriteriaMustSatisfy == papi.RuleCriteriaMustSatisfyAll {
+			r.CriteriaMustSatisfy = ""
+		}
+		for i := range r.Children {
+			normalize(&r.Children[i])
+		}
+	}
+	normalize(rules)
+
+	rulesJSON, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(rulesJSON, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// diffRuleValues appends the differences between the old and new values found at the given path to diff
+func diffRuleValues(path string, old, new interface{}, diff *[]string) {
+	oldMap, oldIsMap := old.(map[string]interface{})
+	newMap, newIsMap := new.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		for _, key := range unionKeys(oldMap, newMap) {
+			oldEntry, inOld := oldMap[key]
+			newEntry, inNew := newMap[key]
+			if namedRuleLists[key] {
+				diffNamedRuleLists(fmt.Sprintf("%s/%s", path, key), oldEntry, newEntry, diff)
+				continue
+			}
+			entryPath := fmt.Sprintf("%s.%s", path, key)
+			switch {
+			case !inOld:
+				*diff = append(*diff, addedRuleValue(entryPath, newEntry))
+			case !inNew:
+				*diff = append(*diff, removedRuleValue(entryPath, oldEntry))
+			default:
+				diffRuleValues(entryPath, oldEntry, newEntry, diff)
+			}
+		}
+		return
+	}
+
+	oldList, oldIsList := old.([]interface{})
+	newList, newIsList := new.([]interface{})
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			entryPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(oldList):
+				*diff = append(*diff, addedRuleValue(entryPath, newList[i]))
+			case i >= len(newList):
+				*diff = append(*diff, removedRuleValue(entryPath, oldList[i]))
+			default:
+				diffRuleValues(entryPath, oldList[i], newList[i], diff)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		*diff = append(*diff, fmt.Sprintf("%s: %s → %s", path, formatRuleValue(old), formatRuleValue(new)))
+	}
+}
+
+// diffNamedRuleLists matches the entries of two rule tree collections by name and appends their differences to diff
+func diffNamedRuleLists(path string, old, new interface{}, diff *[]string) {
+	oldList, _ := old.([]interface{})
+	newList, _ := new.([]interface{})
+	oldKeys, oldEntries := nameRuleEntries(oldList)
+	newKeys, newEntries := nameRuleEntries(newList)
+
+	var oldCommon, newCommon []string
+	for _, key := range oldKeys {
+		entryPath := fmt.Sprintf("%s[%s]", path, key)
+		if _, ok := newEntries[key]; !ok {
+			*diff = append(*diff, removedRuleValue(entryPath, oldEntries[key]))
+			continue
+		}
+		oldCommon = append(oldCommon, key)
+		diffRuleValues(entryPath, oldEntries[key], newEntries[key], diff)
+	}
+	for _, key := range newKeys {
+		if _, ok := oldEntries[key]; !ok {
+			*diff = append(*diff, addedRuleValue(fmt.Sprintf("%s[%s]", path, key), newEntries[key]))
+			continue
+		}
+		newCommon = append(newCommon, key)
+	}
+
+	// the order of child rules is significant, while behaviors, criteria and variables are compared regardless of their order
+	if strings.HasSuffix(path, "/children") && !reflect.DeepEqual(oldCommon, newCommon) {
+		*diff = append(*diff, fmt.Sprintf("%s: order %s → %s", path, strings.Join(oldCommon, ", "), strings.Join(newCommon, ", ")))
+	}
+}
+
+// nameRuleEntries keys the entries of a rule tree collection by their names. Repeated names get a #n suffix
+func nameRuleEntries(list []interface{}) ([]string, map[string]interface{}) {
+	keys := make([]string, 0, len(list))
+	entries := make(map[string]interface{}, len(list))
+	for i, entry := range list {
+		name := fmt.Sprint(i)
+		if m, ok := entry.(map[string]interface{}); ok {
+			if n, ok := m["name"].(string); ok {
+				name = n
+			}
+		}
+		key := name
+		for n := 2; ; n++ {
+			if _, ok := entries[key]; !ok {
+				break
+			}
+			key = fmt.Sprintf("%s#%d", name, n)
+		}
+		keys = append(keys, key)
+		entries[key] = entry
+	}
+	return keys, entries
+}
+
+func addedRuleValue(path string, value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return fmt.Sprintf("%s: added", path)
+	}
+	return fmt.Sprintf("%s: <none> → %s", path, formatRuleValue(value))
+}
+
+func removedRuleValue(path string, value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return fmt.Sprintf("%s: removed", path)
+	}
+	return fmt.Sprintf("%s: %s → <none>", path, formatRuleValue(value))
+}
+
+// formatRuleValue prints strings as they are and other values in their JSON form
+func formatRuleValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(valueJSON)
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
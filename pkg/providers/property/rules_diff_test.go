@@ -0,0 +1,81 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffRulesJSON(t *testing.T) {
+	tests := map[string]struct {
+		old, new string
+		expected []string
+	}{
+		"equal rules": {
+			old: `{"rules":{"name":"default","behaviors":[{"name":"origin","options":{"hostname":"a"}}]}}`,
+			new: `{"rules":{"name":"default","behaviors":[{"name":"origin","options":{"hostname":"a"}}]}}`,
+		},
+		"behavior option changed in child rule": {
+			old: `{"rules":{"name":"default","children":[{"name":"Origin","behaviors":[{"name":"caching","options":{"behavior":"NO_STORE"}},{"name":"origin","options":{"hostname":"a","httpPort":80}}]}]}}`,
+			new: `{"rules":{"name":"default","children":[{"name":"Origin","behaviors":[{"name":"origin","options":{"hostname":"b","httpPort":80}},{"name":"caching","options":{"behavior":"NO_STORE"}}]}]}}`,
+			expected: []string{
+				"/rules/children[Origin]/behaviors[origin].options.hostname: a → b",
+			},
+		},
+		"behaviors added and removed": {
+			old: `{"rules":{"name":"default","behaviors":[{"name":"gzip","options":{}},{"name":"origin","options":{"httpPort":80}}]}}`,
+			new: `{"rules":{"name":"default","behaviors":[{"name":"origin","options":{"httpPort":8080,"httpsPort":443}},{"name":"cpCode","options":{"value":{"id":1}}}]}}`,
+			expected: []string{
+				"/rules/behaviors[gzip]: removed",
+				"/rules/behaviors[origin].options.httpPort: 80 → 8080",
+				"/rules/behaviors[origin].options.httpsPort: <none> → 443",
+				"/rules/behaviors[cpCode]: added",
+			},
+		},
+		"repeated behaviors": {
+			old: `{"rules":{"name":"default","behaviors":[{"name":"modifyOutgoingResponseHeader","options":{"name":"A"}},{"name":"modifyOutgoingResponseHeader","options":{"name":"B"}}]}}`,
+			new: `{"rules":{"name":"default","behaviors":[{"name":"modifyOutgoingResponseHeader","options":{"name":"A"}},{"name":"modifyOutgoingResponseHeader","options":{"name":"C"}}]}}`,
+			expected: []string{
+				"/rules/behaviors[modifyOutgoingResponseHeader#2].options.name: B → C",
+			},
+		},
+		"child rules reordered": {
+			old: `{"rules":{"name":"default","children":[{"name":"A"},{"name":"B"}]}}`,
+			new: `{"rules":{"name":"default","children":[{"name":"B"},{"name":"A"}]}}`,
+			expected: []string{
+				"/rules/children: order A, B → B, A",
+			},
+		},
+		"criteria and comments changed": {
+			old: `{"rules":{"name":"default","children":[{"name":"A","criteriaMustSatisfy":"all","criteria":[{"name":"path","options":{"values":["/a"]}}]}]}}`,
+			new: `{"comments":"new","rules":{"name":"default","children":[{"name":"A","criteriaMustSatisfy":"any","criteria":[{"name":"path","options":{"values":["/a","/b"]}}]}]}}`,
+			expected: []string{
+				"/comments:  → new",
+				"/rules/children[A]/criteria[path].options.values[1]: <none> → /b",
+				"/rules/children[A].criteriaMustSatisfy: <none> → any",
+			},
+		},
+		"variable changed": {
+			old: `{"rules":{"name":"default","variables":[{"name":"PMUSER_A","value":"1","hidden":false,"sensitive":false}]}}`,
+			new: `{"rules":{"name":"default","variables":[{"name":"PMUSER_A","value":"2","hidden":true,"sensitive":false}]}}`,
+			expected: []string{
+				"/rules/variables[PMUSER_A].hidden: false → true",
+				"/rules/variables[PMUSER_A].value: 1 → 2",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff, err := diffRulesJSON(test.old, test.new)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, diff)
+		})
+	}
+}
+
+func TestDiffRulesJSONInvalid(t *testing.T) {
+	_, err := diffRulesJSON(`{"rules":`, `{"rules":{"name":"default"}}`)
+	assert.Error(t, err)
+}
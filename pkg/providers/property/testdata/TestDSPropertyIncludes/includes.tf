@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_includes" "test" {
+  contract_id = "ctr_1"
+  group_id    = "grp_2"
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_includes" "test" {
+  contract_id = "1"
+  group_id    = "2"
+  type        = "COMMON_SETTINGS"
+}
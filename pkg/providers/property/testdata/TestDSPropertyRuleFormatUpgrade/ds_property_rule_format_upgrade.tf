@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_rule_format_upgrade" "upgrade" {
+  contract_id        = "ctr_2"
+  group_id           = "grp_2"
+  property_id        = "prp_2"
+  target_rule_format = "v2021-09-22"
+}
@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_rule_format_upgrade" "upgrade" {
+  contract_id        = "ctr_2"
+  group_id           = "grp_2"
+  property_id        = "prp_2"
+  version            = 3
+  target_rule_format = "v2015-08-17"
+}
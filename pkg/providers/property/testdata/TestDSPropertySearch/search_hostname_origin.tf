@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_search" "search" {
+  hostname        = "www.example.com"
+  origin_hostname = "origin.example.com"
+}
@@ -0,0 +1,17 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_variables" "variables" {
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      variables = [{
+        name      = "ORIGIN"
+        value     = "origin.example.com"
+        hidden    = false
+        sensitive = false
+      }]
+    }
+  })
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_variables" "variables" {
+  contract_id = "ctr_2"
+  group_id    = "grp_2"
+  property_id = "prp_2"
+}
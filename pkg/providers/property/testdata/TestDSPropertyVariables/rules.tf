@@ -0,0 +1,22 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_variables" "variables" {
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      variables = [{
+        name        = "PMUSER_ORIGIN"
+        value       = "origin.example.com"
+        description = "Origin hostname"
+        hidden      = false
+        sensitive   = false
+      }]
+      behaviors = [{
+        name    = "origin"
+        options = { hostname = "{{user.PMUSER_ORIGIN}}" }
+      }]
+    }
+  })
+}
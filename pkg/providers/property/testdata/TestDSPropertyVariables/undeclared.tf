@@ -0,0 +1,27 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_variables" "variables" {
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      variables = [{
+        name      = "PMUSER_ORIGIN"
+        value     = "origin.example.com"
+        hidden    = false
+        sensitive = false
+      }]
+      behaviors = [
+        {
+          name    = "origin"
+          options = { hostname = "{{user.PMUSER_ORIGIN}}" }
+        },
+        {
+          name    = "setVariable"
+          options = { variableName = "PMUSER_PATH", valueSource = "EXPRESSION", variableValue = "{{user.PMUSER_HOST}}" }
+        },
+      ]
+    }
+  })
+}
@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_activation" "test" {
+  property_id = "test"
+  contact     = ["user@example.com"]
+  version     = 1
+  note        = "property activation note for creating"
+  compliance_record {
+    noncompliance_reason = "NONE"
+    ticket_id            = "JIRA-1234"
+    peer_reviewed_by     = "reviewer@example.com"
+    unit_tested          = true
+  }
+}
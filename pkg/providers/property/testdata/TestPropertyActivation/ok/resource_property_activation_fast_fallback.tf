@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_activation" "test" {
+  property_id       = "test"
+  contact           = ["user@example.com"]
+  version           = 1
+  note              = "property activation note for creating"
+  use_fast_fallback = true
+}
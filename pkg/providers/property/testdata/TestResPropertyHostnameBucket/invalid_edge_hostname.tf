@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_hostname_bucket" "test" {
+  property_id = "prp_1"
+  contract_id = "ctr_1"
+  group_id    = "grp_2"
+  hostnames = {
+    "www.example.com" = "example.com.edgekey.net"
+  }
+}
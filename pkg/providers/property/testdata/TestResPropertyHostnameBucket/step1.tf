@@ -0,0 +1,15 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_hostname_bucket" "test" {
+  property_id   = "prp_1"
+  contract_id   = "ctr_1"
+  group_id      = "grp_2"
+  network       = "STAGING"
+  notify_emails = ["user@example.com"]
+  hostnames = {
+    "www.example.com"  = "ehn_1"
+    "blog.example.com" = "ehn_2"
+  }
+}
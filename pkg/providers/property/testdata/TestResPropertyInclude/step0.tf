@@ -0,0 +1,22 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_include" "test" {
+  name        = "test_include"
+  contract_id = "ctr_1"
+  group_id    = "grp_2"
+  product_id  = "prd_Web_App_Accel"
+  type        = "MICROSERVICES"
+  rule_format = "v2020-11-02"
+  rules = jsonencode({
+    rules = {
+      name    = "default"
+      options = {}
+      behaviors = [{
+        name    = "caching"
+        options = { behavior = "NO_STORE" }
+      }]
+    }
+  })
+}
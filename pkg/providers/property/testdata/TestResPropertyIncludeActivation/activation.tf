@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_include_activation" "test" {
+  include_id    = "inc_123"
+  contract_id   = "ctr_1"
+  group_id      = "grp_2"
+  version       = 1
+  network       = "STAGING"
+  notify_emails = ["user@example.com"]
+}
@@ -0,0 +1,13 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_edge_hostname" "edgehostname" {
+  contract      = "ctr_2"
+  group         = "grp_2"
+  product       = "prd_2"
+  edge_hostname = "test.akamaized.net"
+  ip_behavior   = "IPV6_COMPLIANCE"
+  certificate   = 456
+  ttl           = 300
+}
@@ -0,0 +1,18 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_bulk_activation" "test" {
+  contact = ["user@example.com"]
+  note    = "release"
+
+  property {
+    property_id = "prp_1"
+    version     = 1
+  }
+
+  property {
+    property_id = "prp_3"
+    version     = 1
+  }
+}
@@ -283,3 +283,27 @@ func GetExactlyOneOf(rd ResourceDataFetcher, keys []string) (foundKey string, va
 	}
 	return "", nil, ErrNotFound
 }
+
+// ComputedSchema returns a copy of the given resource schema with all attributes, including the nested ones, marked as computed.
+// Sensitive attributes are not copied
+func ComputedSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(resourceSchema))
+	for name, attr := range resourceSchema {
+		if attr.Sensitive {
+			continue
+		}
+		computed := &schema.Schema{
+			Type:        attr.Type,
+			Computed:    true,
+			Description: attr.Description,
+		}
+		switch elem := attr.Elem.(type) {
+		case *schema.Resource:
+			computed.Elem = &schema.Resource{Schema: ComputedSchema(elem.Schema)}
+		case *schema.Schema:
+			computed.Elem = &schema.Schema{Type: elem.Type}
+		}
+		result[name] = computed
+	}
+	return result
+}